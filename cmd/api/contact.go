@@ -0,0 +1,30 @@
+package main
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/npmulder/resume-api/internal/config"
+	"github.com/npmulder/resume-api/internal/middleware"
+)
+
+// registerContactRoute serves the contact form at POST /contact on routes.
+// On top of the global rate limiter, a client IP must wait cfg.MinInterval
+// after an accepted submission and may send cfg.RateLimit per
+// cfg.RateLimitWindow; either check is skipped when 0. Submissions rejected by
+// the rate limit or by validation don't start the minimum interval.
+func registerContactRoute(routes gin.IRoutes, cfg *config.ContactConfig, submit gin.HandlerFunc) {
+	var chain []gin.HandlerFunc
+	if cfg.MinInterval > 0 {
+		chain = append(chain, middleware.MinIntervalMiddleware(cfg.MinInterval))
+	}
+	if cfg.RateLimit > 0 {
+		chain = append(chain, middleware.RateLimiterMiddleware(middleware.RateLimiterConfig{
+			RequestsPerSecond: cfg.RateLimit,
+			BurstSize:         cfg.RateLimit,
+			TTL:               cfg.RateLimitWindow,
+			Period:            cfg.RateLimitWindow,
+		}))
+	}
+	chain = append(chain, middleware.RequireJSONMiddleware(), submit)
+	routes.POST("/contact", chain...)
+}
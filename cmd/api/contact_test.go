@@ -0,0 +1,63 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/npmulder/resume-api/internal/config"
+)
+
+func TestRegisterContactRoute(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	// newRouter builds a router with only the contact route, answering 200
+	newRouter := func(cfg config.ContactConfig) *gin.Engine {
+		router := gin.New()
+		registerContactRoute(router, &cfg, func(c *gin.Context) {
+			c.Status(http.StatusOK)
+		})
+		return router
+	}
+
+	submit := func(router *gin.Engine, contentType string) int {
+		req := httptest.NewRequest(http.MethodPost, "/contact", strings.NewReader(`{}`))
+		req.Header.Set("Content-Type", contentType)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w.Code
+	}
+
+	t.Run("limits submissions per client", func(t *testing.T) {
+		router := newRouter(config.ContactConfig{RateLimit: 3, RateLimitWindow: time.Hour})
+
+		for i := 0; i < 3; i++ {
+			assert.Equal(t, http.StatusOK, submit(router, "application/json"))
+		}
+		assert.Equal(t, http.StatusTooManyRequests, submit(router, "application/json"))
+	})
+
+	t.Run("rejects a too-fast repeat", func(t *testing.T) {
+		router := newRouter(config.ContactConfig{RateLimit: 10, RateLimitWindow: time.Hour, MinInterval: time.Minute})
+
+		assert.Equal(t, http.StatusOK, submit(router, "application/json"))
+		assert.Equal(t, http.StatusTooManyRequests, submit(router, "application/json"))
+	})
+
+	t.Run("unlimited when both checks are 0", func(t *testing.T) {
+		router := newRouter(config.ContactConfig{})
+
+		for i := 0; i < 10; i++ {
+			assert.Equal(t, http.StatusOK, submit(router, "application/json"))
+		}
+	})
+
+	t.Run("requires a JSON body", func(t *testing.T) {
+		assert.Equal(t, http.StatusUnsupportedMediaType, submit(newRouter(config.ContactConfig{}), "text/plain"))
+	})
+}
@@ -0,0 +1,27 @@
+package main
+
+import (
+	"github.com/gin-gonic/gin"
+	swaggerFiles "github.com/swaggo/files"
+	ginSwagger "github.com/swaggo/gin-swagger"
+
+	"github.com/npmulder/resume-api/internal/config"
+	"github.com/npmulder/resume-api/internal/handlers"
+	"github.com/npmulder/resume-api/internal/middleware"
+)
+
+// docsRealm is the basic auth realm of the API documentation
+const docsRealm = "Resume API docs"
+
+// registerDocsRoutes adds the Swagger UI and OpenAPI spec to router. In
+// production they require the basic auth credentials in cfg.Auth when set;
+// elsewhere they are open.
+func registerDocsRoutes(router *gin.Engine, cfg *config.Config) {
+	docs := router.Group("")
+	if cfg.IsProduction() {
+		docs.Use(middleware.BasicAuthMiddleware(cfg.Auth.DocsUsername, cfg.Auth.DocsPassword, docsRealm))
+	}
+
+	docs.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
+	docs.GET("/openapi.json", handlers.OpenAPIHandler())
+}
@@ -0,0 +1,56 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/npmulder/resume-api/internal/config"
+)
+
+func TestRegisterDocsRoutes(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	// newRouter builds a router with only the documentation routes
+	newRouter := func(environment string) *gin.Engine {
+		cfg := &config.Config{
+			Environment: environment,
+			Auth:        config.AuthConfig{DocsUsername: "docs", DocsPassword: "secret"},
+		}
+
+		router := gin.New()
+		registerDocsRoutes(router, cfg)
+		return router
+	}
+
+	// request fetches the Swagger UI, with basic auth when user is set
+	request := func(router *gin.Engine, path, user, pass string) int {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		if user != "" {
+			req.SetBasicAuth(user, pass)
+		}
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w.Code
+	}
+
+	t.Run("open in development", func(t *testing.T) {
+		router := newRouter("development")
+
+		assert.Equal(t, http.StatusOK, request(router, "/swagger/index.html", "", ""))
+		// The spec itself is only served once `make swagger` has run
+		assert.NotEqual(t, http.StatusUnauthorized, request(router, "/openapi.json", "", ""))
+	})
+
+	t.Run("requires auth in production", func(t *testing.T) {
+		router := newRouter("production")
+
+		assert.Equal(t, http.StatusUnauthorized, request(router, "/swagger/index.html", "", ""))
+		assert.Equal(t, http.StatusUnauthorized, request(router, "/swagger/index.html", "docs", "wrong"))
+		assert.Equal(t, http.StatusUnauthorized, request(router, "/openapi.json", "", ""))
+		assert.Equal(t, http.StatusOK, request(router, "/swagger/index.html", "docs", "secret"))
+	})
+}
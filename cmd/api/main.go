@@ -20,30 +20,43 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
+	"strconv"
 	"syscall"
+	"time"
 
 	"github.com/gin-gonic/gin"
-	swaggerFiles "github.com/swaggo/files"
-	ginSwagger "github.com/swaggo/gin-swagger"
+	googlegrpc "google.golang.org/grpc"
 
 	// Import generated docs
 	_ "github.com/npmulder/resume-api/docs"
 	"github.com/npmulder/resume-api/internal/cache"
 	"github.com/npmulder/resume-api/internal/config"
+	"github.com/npmulder/resume-api/internal/contact"
 	"github.com/npmulder/resume-api/internal/database"
+	"github.com/npmulder/resume-api/internal/features"
+	resumegrpc "github.com/npmulder/resume-api/internal/grpc"
 	"github.com/npmulder/resume-api/internal/handlers"
 	"github.com/npmulder/resume-api/internal/middleware"
+	"github.com/npmulder/resume-api/internal/models"
 	"github.com/npmulder/resume-api/internal/repository"
 	"github.com/npmulder/resume-api/internal/repository/postgres"
 	"github.com/npmulder/resume-api/internal/services"
 	"github.com/npmulder/resume-api/internal/tracing"
-	"github.com/npmulder/resume-api/internal/versioning"
+	"github.com/npmulder/resume-api/internal/webhook"
 )
 
+// version is the build version reported by /health, set at build time with
+// -ldflags "-X main.version=<version>"
+var version = "dev"
+
 func main() {
+	// Uptime reported by /health counts from here
+	startedAt := time.Now()
+
 	// Load configuration
 	cfg, err := config.Load()
 	if err != nil {
@@ -60,35 +73,46 @@ func main() {
 	logger := slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{Level: logLevel}))
 	slog.SetDefault(logger)
 
+	config.PrintConfig(cfg, logger)
+
 	// Initialize tracing
 	tracer, err := tracing.NewTracer(context.Background(), &cfg.Telemetry, logger)
 	if err != nil {
 		logger.Error("failed to initialize tracer", "error", err)
 		os.Exit(1)
 	}
-	defer func() {
-		if err := tracer.Shutdown(context.Background()); err != nil {
-			logger.Error("failed to shutdown tracer", "error", err)
-		}
-	}()
 
 	// Establish database connection
-	db, err := database.New(context.Background(), &cfg.Database, logger)
+	db, err := database.New(context.Background(), &cfg.Database, logger,
+		database.WithQueryLogSampleRate(cfg.Logging.QueryLogSampleRate))
 	if err != nil {
 		logger.Error("failed to connect to database", "error", err)
 		os.Exit(1)
 	}
-	defer db.Close()
-
 	logger.Info("database connection established")
 
-	// Initialize repositories
-	profileRepo := postgres.NewProfileRepository(db.Pool())
-	experienceRepo := postgres.NewExperienceRepository(db.Pool())
-	skillRepo := postgres.NewSkillRepository(db.Pool())
-	achievementRepo := postgres.NewAchievementRepository(db.Pool())
-	educationRepo := postgres.NewEducationRepository(db.Pool())
-	projectRepo := postgres.NewProjectRepository(db.Pool())
+	if err := migrateOnStartup(&cfg.Database, logger); err != nil {
+		logger.Error("failed to apply database migrations", "error", err)
+		os.Exit(1)
+	}
+
+	// Initialize repositories; reads go to the replica when one is configured
+	readPool := postgres.WithReadPool(db.ReadPool())
+	listLimits := postgres.WithListLimits(models.ListLimits{
+		Highlights:   cfg.Server.MaxHighlights,
+		Technologies: cfg.Server.MaxTechnologies,
+		KeyFeatures:  cfg.Server.MaxKeyFeatures,
+	})
+	profileRepo := postgres.NewProfileRepository(db.WritePool(), readPool)
+	experienceRepo := postgres.NewExperienceRepository(db.WritePool(), readPool, postgres.WithDefaultSort(cfg.Server.DefaultSort["experiences"]), listLimits)
+	skillRepo := postgres.NewSkillRepository(db.WritePool(), readPool, postgres.WithDefaultSort(cfg.Server.DefaultSort["skills"]),
+		postgres.WithSkillCategoryOrder(cfg.Server.SkillCategoryOrder))
+	achievementRepo := postgres.NewAchievementRepository(db.WritePool(), readPool, postgres.WithDefaultSort(cfg.Server.DefaultSort["achievements"]))
+	educationRepo := postgres.NewEducationRepository(db.WritePool(), readPool, postgres.WithDefaultSort(cfg.Server.DefaultSort["education"]))
+	projectRepo := postgres.NewProjectRepository(db.WritePool(), readPool, postgres.WithDefaultSort(cfg.Server.DefaultSort["projects"]), listLimits)
+	searchRepo := postgres.NewSearchRepository(db.WritePool(), cfg.Search.MaxResults, readPool)
+	webhookFailureRepo := postgres.NewWebhookFailureRepository(db.WritePool())
+	contactRepo := postgres.NewContactRepository(db.WritePool())
 
 	repos := repository.Repositories{
 		Profile:     profileRepo,
@@ -97,6 +121,7 @@ func main() {
 		Achievement: achievementRepo,
 		Education:   educationRepo,
 		Project:     projectRepo,
+		Search:      searchRepo,
 	}
 
 	// Initialize cache
@@ -109,59 +134,101 @@ func main() {
 		logger.Info("Redis cache is disabled, using no-op cache")
 	} else {
 		logger.Info("Redis cache initialized successfully")
-		defer cacheClient.Close()
 	}
 
 	// Initialize services
 	baseResumeService := services.NewResumeService(repos)
-	resumeService := services.NewCachedResumeService(baseResumeService, cacheClient, cfg.Redis.TTL)
+	cachedResumeService := services.NewCachedResumeService(baseResumeService, cacheClient, cfg.Redis.TTL, cfg.Redis.NegativeTTL)
+
+	// Notify webhooks after writes, once the cache has been invalidated;
+	// deliveries that exhaust their retries are kept for replay
+	dispatcher := webhook.New(&cfg.Webhooks, logger, webhook.WithFailureStore(webhookFailureRepo))
+	resumeService := services.NewNotifyingResumeService(cachedResumeService, dispatcher)
+
+	// Initialize handlers; the resume snapshot keeps /resume.html up while
+	// the database is unavailable
+	resumeHandlerOpts := []handlers.ResumeHandlerOption{
+		handlers.WithMaxPageSize(cfg.Server.MaxPageSize),
+		handlers.WithPublicBaseURL(cfg.Server.PublicBaseURL),
+	}
+	if cfg.Server.ResumeSnapshotPath != "" {
+		snapshot, err := handlers.LoadResumeSnapshot(cfg.Server.ResumeSnapshotPath)
+		if err != nil {
+			logger.Warn("failed to load resume snapshot; starting empty until the first successful read", "error", err)
+			snapshot = handlers.NewResumeSnapshot()
+		}
+		resumeHandlerOpts = append(resumeHandlerOpts, handlers.WithResumeSnapshot(snapshot))
+	}
+	if cfg.Server.MaskContactDetails {
+		resumeHandlerOpts = append(resumeHandlerOpts, handlers.WithContactMasking(cfg.Auth.APIKey))
+	}
+	resumeHandler := handlers.NewResumeHandler(resumeService, resumeHandlerOpts...)
+	healthHandler := handlers.NewHealthHandler(handlers.NewCachedHealthChecker(db, cfg.Server.HealthCacheTTL), cacheClient,
+		handlers.WithBuildInfo(version, startedAt))
+	adminHandler := handlers.NewAdminHandler(cacheClient)
+	webhookHandler := handlers.NewWebhookHandler(webhookFailureRepo, dispatcher)
+	contactSender, err := contact.New(&cfg.Contact, logger)
+	if err != nil {
+		logger.Error("failed to initialize contact sender", "error", err)
+		os.Exit(1)
+	}
+	contactHandler := handlers.NewContactHandler(contactRepo, contactSender)
 
-	// Initialize handlers
-	resumeHandler := handlers.NewResumeHandler(resumeService)
+	// Metrics are optional unless configured as required
+	metricsMiddleware, err := middleware.MetricsMiddleware(cfg.Telemetry.MetricsPath)
+	if err != nil {
+		if cfg.Telemetry.MetricsRequired {
+			logger.Error("failed to initialize metrics", "error", err)
+			os.Exit(1)
+		}
+		logger.Warn("metrics disabled", "error", err)
+		metricsMiddleware = middleware.NoopMetricsMiddleware()
+	}
 
 	// Set up Gin router
 	router := gin.New()
 
+	// Answer unknown paths with a structured 404, and unsupported methods on
+	// known paths with a structured 405 and an Allow header
+	router.HandleMethodNotAllowed = true
+	router.NoMethod(handlers.MethodNotAllowed)
+	router.NoRoute(handlers.RouteNotFound)
+
 	// Register middleware
-	router.Use(middleware.RequestIDMiddleware())
-	router.Use(middleware.ErrorHandlerMiddleware(logger))
-	router.Use(middleware.LoggingMiddleware(logger))
-	router.Use(middleware.CORSMiddleware(&cfg.CORS))
-	router.Use(middleware.TimeoutMiddleware(cfg.Server.RequestTimeout, logger))
-	router.Use(middleware.MetricsMiddleware())
-	router.Use(middleware.SecurityHeadersMiddleware())
-	router.Use(middleware.InputValidationMiddleware())
-	router.Use(middleware.RateLimiterMiddleware(middleware.DefaultRateLimiterConfig()))
-	router.Use(middleware.TracingMiddleware(tracer))
-
-	// Add version negotiation middleware
-	router.Use(versioning.VersionNegotiationMiddleware(versioning.DefaultVersionNegotiationOptions()))
+	registerMiddleware(router, cfg, logger, metricsMiddleware, middleware.TracingMiddleware(tracer))
 
 	// Define routes
-	router.GET("/health", handlers.HealthCheck)
-	router.GET("/metrics", handlers.MetricsHandler())
+	router.GET("/health", healthHandler.HealthCheck)
+	router.GET(cfg.Telemetry.MetricsPath, middleware.BearerTokenMiddleware(cfg.Telemetry.MetricsAuthToken), handlers.MetricsHandler())
 
-	// Swagger documentation endpoint
-	router.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
+	// Swagger documentation endpoints, behind basic auth in production
+	registerDocsRoutes(router, cfg)
 
-	// Create versioned router
-	versionedRouter := versioning.NewRouter(router)
+	// Runtime profiler, only when enabled and behind the API key
+	registerPprofRoutes(router, cfg)
 
-	// Register API routes for v1
-	v1 := versionedRouter.Group(versioning.V1)
-	{
-		v1.GET("/profile", resumeHandler.GetProfile)
-		v1.GET("/experiences", resumeHandler.GetExperiences)
-		v1.GET("/skills", resumeHandler.GetSkills)
-		v1.GET("/achievements", resumeHandler.GetAchievements)
-		v1.GET("/education", resumeHandler.GetEducation)
-		v1.GET("/projects", resumeHandler.GetProjects)
-	}
+	// Experimental endpoints are only registered when their feature is enabled
+	flags := features.Flags(cfg.Features)
+
+	// GraphQL reads sit beside the versioned REST API and share its handler
+	flags.Handle(router, features.GraphQL, http.MethodPost, "/graphql", middleware.RequireJSONMiddleware(), resumeHandler.GraphQL)
+
+	// Register the versioned API
+	registerV1Routes(router, cfg, apiHandlers{
+		resume:  resumeHandler,
+		contact: contactHandler,
+		webhook: webhookHandler,
+		admin:   adminHandler,
+	})
 
 	// Create and start HTTP server
+	// Redirect to the canonical host first, except for probes and scrapes
+	// addressing the server directly, then normalize trailing slashes
+	handler := middleware.TrailingSlashHandler(router, cfg.Server.TrailingSlash, "/api/")
+	handler = middleware.CanonicalHostHandler(handler, cfg.Server.CanonicalHost, "/health", cfg.Telemetry.MetricsPath)
 	srv := &http.Server{
 		Addr:         cfg.Server.ServerAddress(),
-		Handler:      router,
+		Handler:      handler,
 		ReadTimeout:  cfg.Server.ReadTimeout,
 		WriteTimeout: cfg.Server.WriteTimeout,
 		IdleTimeout:  cfg.Server.IdleTimeout,
@@ -175,6 +242,35 @@ func main() {
 		}
 	}()
 
+	// Serve the read API over gRPC alongside HTTP when a port is configured
+	grpcOpts := []resumegrpc.Option{resumegrpc.WithMaxPageSize(cfg.Server.MaxPageSize)}
+	if cfg.Server.MaskContactDetails {
+		grpcOpts = append(grpcOpts, resumegrpc.WithContactMasking())
+	}
+	if cfg.Middleware.RateLimiter {
+		// Calls are limited per client IP like REST requests, in separate buckets
+		limiter := middleware.NewRateLimiter(middleware.DefaultRateLimiterConfig())
+		grpcOpts = append(grpcOpts, resumegrpc.WithServerOptions(
+			googlegrpc.ChainUnaryInterceptor(resumegrpc.RateLimitInterceptor(limiter))))
+	}
+	grpcServer := resumegrpc.NewServer(resumeService, grpcOpts...)
+	if cfg.Server.GRPCPort > 0 {
+		grpcAddr := net.JoinHostPort(cfg.Server.Host, strconv.Itoa(cfg.Server.GRPCPort))
+		listener, err := net.Listen("tcp", grpcAddr)
+		if err != nil {
+			logger.Error("failed to listen for grpc", "address", grpcAddr, "error", err)
+			os.Exit(1)
+		}
+
+		go func() {
+			logger.Info("starting grpc server", "address", grpcAddr)
+			if err := grpcServer.Serve(listener); err != nil {
+				logger.Error("grpc server error", "error", err)
+				os.Exit(1)
+			}
+		}()
+	}
+
 	// Implement graceful shutdown
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
@@ -184,8 +280,41 @@ func main() {
 	ctx, cancel := context.WithTimeout(context.Background(), cfg.Server.GracefulStop)
 	defer cancel()
 
-	if err := srv.Shutdown(ctx); err != nil {
-		logger.Error("server shutdown failed", "error", err)
+	// Stop in dependency order so in-flight requests can still trace, cache
+	// and query while draining, and spans are flushed before exporters go away
+	err = shutdown(ctx, logger,
+		// Shutdown stops accepting connections and drains in-flight requests
+		shutdownComponent{name: "http", share: 0.4, stop: srv.Shutdown},
+		// GracefulStop waits for in-flight RPCs; force them closed once the share runs out
+		shutdownComponent{name: "grpc", share: 0.1, stop: func(ctx context.Context) error {
+			stopped := make(chan struct{})
+			go func() {
+				grpcServer.GracefulStop()
+				close(stopped)
+			}()
+			select {
+			case <-stopped:
+				return nil
+			case <-ctx.Done():
+				grpcServer.Stop()
+				return ctx.Err()
+			}
+		}},
+		shutdownComponent{name: "webhooks", share: 0.1, stop: dispatcher.Close},
+		shutdownComponent{name: "tracer", share: 0.2, stop: tracer.Shutdown},
+		shutdownComponent{name: "cache", share: 0.1, stop: func(context.Context) error {
+			if cacheClient == nil {
+				return nil
+			}
+			return cacheClient.Close()
+		}},
+		shutdownComponent{name: "database", share: 0.1, stop: func(context.Context) error {
+			db.Close()
+			return nil
+		}},
+	)
+	if err != nil {
+		logger.Error("graceful shutdown failed", "error", err)
 		os.Exit(1)
 	}
 
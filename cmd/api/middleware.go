@@ -0,0 +1,64 @@
+package main
+
+import (
+	"io"
+	"log/slog"
+	"os"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/npmulder/resume-api/internal/config"
+	"github.com/npmulder/resume-api/internal/handlers"
+	"github.com/npmulder/resume-api/internal/middleware"
+	"github.com/npmulder/resume-api/internal/utils"
+	"github.com/npmulder/resume-api/internal/versioning"
+)
+
+// registerMiddleware adds the global middleware to router in order. The
+// security headers, input validation and rate limiter are skipped when
+// disabled in cfg.Middleware, the in-flight and query length limits when
+// cfg.Server.MaxInFlight and cfg.Server.MaxQueryLength are 0 and indented JSON
+// unless cfg.Server.PrettyJSON is set; metrics and tracing are built by the
+// caller.
+func registerMiddleware(router *gin.Engine, cfg *config.Config, logger *slog.Logger, metrics, tracing gin.HandlerFunc) {
+	router.Use(middleware.RequestIDMiddleware())
+	if cfg.Server.PrettyJSON {
+		router.Use(middleware.PrettyJSONMiddleware())
+	}
+	router.Use(middleware.ErrorHandlerMiddleware(logger))
+	var accessLog io.Writer
+	if cfg.Logging.AccessLogFormat == middleware.AccessLogCombined {
+		accessLog = os.Stdout
+	}
+	router.Use(middleware.LoggingMiddleware(logger, cfg.Logging.SlowRequestThreshold, cfg.Logging.RedactHeaders, accessLog))
+	if cfg.Server.MaxQueryLength > 0 {
+		router.Use(middleware.QueryLengthLimitMiddleware(cfg.Server.MaxQueryLength))
+	}
+	if cfg.Server.MaxInFlight > 0 {
+		router.Use(middleware.ConcurrencyLimitMiddleware(cfg.Server.MaxInFlight))
+	}
+	router.Use(middleware.CORSMiddleware(&cfg.CORS))
+	router.Use(middleware.TimeoutMiddleware(cfg.Server.RequestTimeout, logger))
+	router.Use(metrics)
+	if cfg.Middleware.SecurityHeaders {
+		router.Use(middleware.SecurityHeadersMiddleware())
+	}
+	router.Use(middleware.CacheControlMiddleware(cfg.Server.CacheControl))
+	if cfg.Middleware.InputValidation {
+		router.Use(middleware.InputValidationMiddleware())
+	}
+	if cfg.Middleware.RateLimiter {
+		router.Use(middleware.RateLimiterMiddleware(middleware.DefaultRateLimiterConfig()))
+	}
+	router.Use(tracing)
+
+	// Add version negotiation middleware
+	versionOptions := versioning.DefaultVersionNegotiationOptions()
+	router.Use(versioning.VersionNegotiationMiddleware(versionOptions))
+
+	// Reject unknown query parameters; the version and pretty parameters are accepted everywhere
+	if cfg.Server.StrictQuery {
+		router.Use(middleware.StrictQueryMiddleware(
+			handlers.QueryParams(versioning.GetPathPrefix(versioning.V1)), versionOptions.QueryParamName, utils.PrettyQueryParam))
+	}
+}
@@ -0,0 +1,80 @@
+package main
+
+import (
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/npmulder/resume-api/internal/config"
+	"github.com/npmulder/resume-api/internal/middleware"
+)
+
+func TestRegisterMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	// newRouter builds a router with the global middleware and one route
+	newRouter := func(toggles config.MiddlewareConfig) *gin.Engine {
+		cfg := &config.Config{
+			Server:     config.ServerConfig{RequestTimeout: 10 * time.Second},
+			CORS:       config.CORSConfig{AllowOrigins: []string{"*"}},
+			Middleware: toggles,
+		}
+
+		router := gin.New()
+		registerMiddleware(router, cfg, logger, middleware.NoopMetricsMiddleware(), func(c *gin.Context) { c.Next() })
+		router.GET("/health", func(c *gin.Context) {
+			c.JSON(http.StatusOK, gin.H{"status": "healthy"})
+		})
+		return router
+	}
+
+	// countStatus sends n requests and counts the responses with status
+	countStatus := func(router *gin.Engine, n, status int) int {
+		count := 0
+		for i := 0; i < n; i++ {
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/health", nil))
+			if w.Code == status {
+				count++
+			}
+		}
+		return count
+	}
+
+	enabled := config.MiddlewareConfig{RateLimiter: true, InputValidation: true, SecurityHeaders: true}
+
+	t.Run("rate limiter enabled", func(t *testing.T) {
+		router := newRouter(enabled)
+
+		assert.Positive(t, countStatus(router, 50, http.StatusTooManyRequests))
+	})
+
+	t.Run("rate limiter disabled", func(t *testing.T) {
+		toggles := enabled
+		toggles.RateLimiter = false
+		router := newRouter(toggles)
+
+		assert.Zero(t, countStatus(router, 50, http.StatusTooManyRequests))
+	})
+
+	t.Run("security headers disabled", func(t *testing.T) {
+		request := func(router *gin.Engine) http.Header {
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/health", nil))
+			return w.Header()
+		}
+
+		assert.Equal(t, "nosniff", request(newRouter(enabled)).Get("X-Content-Type-Options"))
+
+		toggles := enabled
+		toggles.SecurityHeaders = false
+		assert.Empty(t, request(newRouter(toggles)).Get("X-Content-Type-Options"))
+	})
+}
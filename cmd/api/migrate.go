@@ -0,0 +1,18 @@
+package main
+
+import (
+	"log/slog"
+
+	"github.com/npmulder/resume-api/internal/config"
+	"github.com/npmulder/resume-api/internal/database"
+)
+
+// migrateOnStartup applies pending migrations when auto-migration is enabled.
+// A dirty migration state is returned as an error so startup fails until it
+// is repaired by hand.
+func migrateOnStartup(cfg *config.DatabaseConfig, logger *slog.Logger) error {
+	if !cfg.AutoMigrate {
+		return nil
+	}
+	return database.EnsureMigrations(cfg, logger)
+}
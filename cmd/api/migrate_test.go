@@ -0,0 +1,113 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-migrate/migrate/v4"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/npmulder/resume-api/internal/config"
+	"github.com/npmulder/resume-api/internal/database"
+)
+
+func TestMigrateOnStartup(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping database tests in short mode")
+	}
+
+	// Migrate a database of its own so the shared test database is untouched
+	cfg := &config.DatabaseConfig{
+		Host:     getTestEnv("TEST_DB_HOST", "localhost"),
+		Port:     5432,
+		Name:     getTestEnv("TEST_DB_NAME", "resume_api_test"),
+		User:     getTestEnv("TEST_DB_USER", "dev"),
+		Password: getTestEnv("TEST_DB_PASSWORD", "devpass"),
+		SSLMode:  "disable",
+	}
+	cfg.Name = createTestDatabase(t, cfg, "resume_api_automigrate_test")
+
+	// Migrations are read relative to the working directory
+	wd, err := os.Getwd()
+	require.NoError(t, err)
+	require.NoError(t, os.Chdir("../.."))
+	defer os.Chdir(wd)
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	t.Run("disabled leaves the database alone", func(t *testing.T) {
+		require.NoError(t, migrateOnStartup(cfg, logger))
+
+		_, _, err := database.MigrateVersion(cfg)
+		assert.ErrorIs(t, err, migrate.ErrNilVersion)
+	})
+
+	t.Run("enabled applies every migration", func(t *testing.T) {
+		enabled := *cfg
+		enabled.AutoMigrate = true
+		require.NoError(t, migrateOnStartup(&enabled, logger))
+
+		migrations, err := filepath.Glob("migrations/*.up.sql")
+		require.NoError(t, err)
+
+		version, dirty, err := database.MigrateVersion(cfg)
+		require.NoError(t, err)
+		assert.Equal(t, uint(len(migrations)), version)
+		assert.False(t, dirty)
+
+		// Running again with nothing pending succeeds
+		require.NoError(t, migrateOnStartup(&enabled, logger))
+	})
+}
+
+// createTestDatabase creates an empty database named name next to the one in
+// cfg, drops it when the test ends and returns its name
+func createTestDatabase(t *testing.T, cfg *config.DatabaseConfig, name string) string {
+	t.Helper()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	conn, err := pgx.Connect(ctx, cfg.DatabaseURL())
+	require.NoError(t, err, "Failed to connect to test database")
+	defer conn.Close(context.Background())
+
+	_, err = conn.Exec(ctx, fmt.Sprintf("DROP DATABASE IF EXISTS %s", name))
+	require.NoError(t, err)
+	_, err = conn.Exec(ctx, fmt.Sprintf("CREATE DATABASE %s", name))
+	require.NoError(t, err)
+
+	adminURL := cfg.DatabaseURL()
+	t.Cleanup(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		conn, err := pgx.Connect(ctx, adminURL)
+		if err != nil {
+			t.Logf("failed to drop %s: %v", name, err)
+			return
+		}
+		defer conn.Close(context.Background())
+		if _, err := conn.Exec(ctx, fmt.Sprintf("DROP DATABASE IF EXISTS %s WITH (FORCE)", name)); err != nil {
+			t.Logf("failed to drop %s: %v", name, err)
+		}
+	})
+
+	return name
+}
+
+// getTestEnv gets an environment variable for tests with a fallback
+func getTestEnv(key, fallback string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return fallback
+}
@@ -0,0 +1,37 @@
+package main
+
+import (
+	"net/http/pprof"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/npmulder/resume-api/internal/config"
+	"github.com/npmulder/resume-api/internal/middleware"
+)
+
+// registerPprofRoutes serves the net/http/pprof handlers under /debug/pprof
+// when cfg.Server.EnablePprof is set. The routes require the API key, so
+// they stay closed when no key is configured.
+func registerPprofRoutes(router *gin.Engine, cfg *config.Config) {
+	if !cfg.Server.EnablePprof {
+		return
+	}
+
+	debug := router.Group("/debug/pprof", middleware.APIKeyMiddleware(cfg.Auth.APIKey))
+	debug.GET("/*profile", func(c *gin.Context) {
+		switch c.Param("profile") {
+		case "/cmdline":
+			pprof.Cmdline(c.Writer, c.Request)
+		case "/profile":
+			pprof.Profile(c.Writer, c.Request)
+		case "/symbol":
+			pprof.Symbol(c.Writer, c.Request)
+		case "/trace":
+			pprof.Trace(c.Writer, c.Request)
+		default:
+			// Index also serves the named profiles, e.g. /debug/pprof/heap
+			pprof.Index(c.Writer, c.Request)
+		}
+	})
+	debug.POST("/symbol", gin.WrapF(pprof.Symbol))
+}
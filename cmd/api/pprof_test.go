@@ -0,0 +1,65 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/npmulder/resume-api/internal/config"
+	"github.com/npmulder/resume-api/internal/middleware"
+)
+
+func TestRegisterPprofRoutes(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	// newRouter builds a router with only the profiler routes
+	newRouter := func(enabled bool) *gin.Engine {
+		cfg := &config.Config{
+			Server: config.ServerConfig{EnablePprof: enabled},
+			Auth:   config.AuthConfig{APIKey: "secret"},
+		}
+
+		router := gin.New()
+		registerPprofRoutes(router, cfg)
+		return router
+	}
+
+	// request fetches path, with the API key when key is set
+	request := func(router *gin.Engine, path, key string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		if key != "" {
+			req.Header.Set(middleware.APIKeyHeader, key)
+		}
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	t.Run("not found when disabled", func(t *testing.T) {
+		router := newRouter(false)
+
+		assert.Equal(t, http.StatusNotFound, request(router, "/debug/pprof/", "secret").Code)
+		assert.Equal(t, http.StatusNotFound, request(router, "/debug/pprof/heap", "secret").Code)
+	})
+
+	t.Run("requires the API key when enabled", func(t *testing.T) {
+		router := newRouter(true)
+
+		assert.Equal(t, http.StatusUnauthorized, request(router, "/debug/pprof/", "").Code)
+		assert.Equal(t, http.StatusUnauthorized, request(router, "/debug/pprof/heap", "wrong").Code)
+	})
+
+	t.Run("serves profiles when enabled and authenticated", func(t *testing.T) {
+		router := newRouter(true)
+
+		index := request(router, "/debug/pprof/", "secret")
+		assert.Equal(t, http.StatusOK, index.Code)
+		assert.Contains(t, index.Body.String(), "goroutine")
+
+		assert.Equal(t, http.StatusOK, request(router, "/debug/pprof/heap", "secret").Code)
+		assert.Equal(t, http.StatusOK, request(router, "/debug/pprof/cmdline", "secret").Code)
+	})
+}
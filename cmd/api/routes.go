@@ -0,0 +1,76 @@
+package main
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/npmulder/resume-api/internal/config"
+	"github.com/npmulder/resume-api/internal/features"
+	"github.com/npmulder/resume-api/internal/handlers"
+	"github.com/npmulder/resume-api/internal/middleware"
+	"github.com/npmulder/resume-api/internal/versioning"
+)
+
+// apiHandlers holds the handlers serving the versioned API
+type apiHandlers struct {
+	resume  *handlers.ResumeHandler
+	contact *handlers.ContactHandler
+	webhook *handlers.WebhookHandler
+	admin   *handlers.AdminHandler
+}
+
+// registerV1Routes registers the v1 API on router. Reads are public, writes
+// and admin routes require cfg.Auth.APIKey, and routes behind a feature flag
+// are only registered when it's enabled in cfg.Features.
+func registerV1Routes(router *gin.Engine, cfg *config.Config, h apiHandlers) {
+	flags := features.Flags(cfg.Features)
+
+	// Create versioned router
+	versionedRouter := versioning.NewRouter(router)
+
+	// Register API routes for v1
+	v1 := versionedRouter.Group(versioning.V1)
+	{
+		v1.GET("", handlers.RouteIndex(router, versioning.GetPathPrefix(versioning.V1)))
+		v1.GET("/profile", h.resume.GetProfile)
+		v1.GET("/profile/summary", h.resume.GetProfileSummary)
+		v1.GET("/profile/completeness", h.resume.GetCompleteness)
+		v1.GET("/experiences", h.resume.GetExperiences)
+		v1.GET("/experiences/tenure", h.resume.GetTenure)
+		v1.GET("/skills", h.resume.GetSkills)
+		v1.GET("/skills/levels", h.resume.GetSkillLevels)
+		v1.GET("/skills/:name/projects", h.resume.GetSkillProjects)
+		v1.GET("/achievements", h.resume.GetAchievements)
+		v1.GET("/education", h.resume.GetEducation)
+		v1.GET("/education/institutions", h.resume.GetInstitutions)
+		v1.GET("/education/credential/:id", h.resume.GetEducationByCredentialID)
+		v1.GET("/projects", h.resume.GetProjects)
+		v1.GET("/projects/:id/similar", h.resume.GetSimilarProjects)
+		v1.GET("/recent", h.resume.GetRecent)
+		v1.GET("/stats", h.resume.GetStats)
+		v1.GET("/meta", h.resume.GetMeta)
+		v1.GET("/search", h.resume.Search)
+		v1.GET("/export", h.resume.Export)
+		v1.GET("/resume.html", h.resume.GetResumeHTML)
+		v1.POST("/validate", middleware.RequireJSONMiddleware(), handlers.ValidateResume)
+		registerContactRoute(v1, &cfg.Contact, h.contact.Submit)
+
+		// Batched operations are replayed against the full router, so they
+		// pass through the same middleware as individual requests
+		flags.Handle(v1, features.Batch, http.MethodPost, "/batch", handlers.NewBatchHandler(router, cfg.Server.BatchMaxSize).Batch)
+	}
+
+	// Register protected write routes for v1
+	v1Write := v1.Group("", middleware.APIKeyMiddleware(cfg.Auth.APIKey), middleware.RequireJSONMiddleware())
+	{
+		v1Write.PUT("/profile", h.resume.UpdateProfile)
+		v1Write.GET("/profile/history", h.resume.GetProfileHistory)
+		v1Write.GET("/profile/diff", h.resume.GetProfileDiff)
+		v1Write.PUT("/skills/category", h.resume.RenameSkillCategory)
+		v1Write.DELETE("/projects", h.resume.DeleteProjects)
+		v1Write.DELETE("/admin/cache/:entity", h.admin.InvalidateCache)
+		v1Write.GET("/admin/webhooks/failures", h.webhook.GetFailures)
+		v1Write.POST("/admin/webhooks/failures/:id/replay", h.webhook.ReplayFailure)
+	}
+}
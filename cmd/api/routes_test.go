@@ -0,0 +1,42 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/npmulder/resume-api/internal/config"
+	"github.com/npmulder/resume-api/internal/features"
+	"github.com/npmulder/resume-api/internal/handlers"
+	"github.com/npmulder/resume-api/internal/versioning"
+)
+
+func TestRegisterV1RoutesListsQueryParams(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	// Register every route, including those behind feature flags
+	cfg := &config.Config{Features: map[string]bool{features.Batch: true, features.GraphQL: true}}
+	router := gin.New()
+	registerV1Routes(router, cfg, apiHandlers{
+		resume:  handlers.NewResumeHandler(nil),
+		contact: handlers.NewContactHandler(nil, nil),
+		webhook: handlers.NewWebhookHandler(nil, nil),
+		admin:   handlers.NewAdminHandler(nil),
+	})
+
+	// Strict query checking skips routes missing from the registry, so each
+	// one needs an entry, even when it takes no parameters
+	prefix := versioning.GetPathPrefix(versioning.V1)
+	params := handlers.QueryParams(prefix)
+	var checked int
+	for _, route := range router.Routes() {
+		if !strings.HasPrefix(route.Path, prefix) {
+			continue
+		}
+		checked++
+		assert.Contains(t, params, route.Method+" "+route.Path)
+	}
+	assert.Equal(t, len(params), checked, "query params listed for routes that aren't registered")
+}
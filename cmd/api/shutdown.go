@@ -0,0 +1,48 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+)
+
+// shutdownComponent is a dependency stopped during graceful shutdown
+type shutdownComponent struct {
+	name string
+	// share is the fraction of the overall shutdown budget this component may use
+	share float64
+	stop  func(ctx context.Context) error
+}
+
+// shutdown stops components in the given order, giving each its share of the
+// time left until ctx's deadline. A failing or slow component does not prevent
+// the remaining ones from being stopped; all errors are returned joined.
+func shutdown(ctx context.Context, logger *slog.Logger, components ...shutdownComponent) error {
+	var budget time.Duration
+	if deadline, ok := ctx.Deadline(); ok {
+		budget = time.Until(deadline)
+	}
+
+	var errs []error
+	for _, component := range components {
+		stepCtx, cancel := ctx, context.CancelFunc(func() {})
+		if budget > 0 {
+			stepCtx, cancel = context.WithTimeout(ctx, time.Duration(float64(budget)*component.share))
+		}
+
+		start := time.Now()
+		err := component.stop(stepCtx)
+		cancel()
+
+		if err != nil {
+			logger.Error("shutdown step failed", "component", component.name, "duration", time.Since(start), "error", err)
+			errs = append(errs, fmt.Errorf("%s: %w", component.name, err))
+			continue
+		}
+		logger.Info("shutdown step completed", "component", component.name, "duration", time.Since(start))
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,78 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShutdown(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	t.Run("stops components in order", func(t *testing.T) {
+		var order []string
+		fake := func(name string) shutdownComponent {
+			return shutdownComponent{name: name, share: 0.25, stop: func(context.Context) error {
+				order = append(order, name)
+				return nil
+			}}
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+
+		err := shutdown(ctx, logger, fake("http"), fake("tracer"), fake("cache"), fake("database"))
+		require.NoError(t, err)
+		assert.Equal(t, []string{"http", "tracer", "cache", "database"}, order)
+	})
+
+	t.Run("carves each budget from the deadline", func(t *testing.T) {
+		budgets := map[string]time.Duration{}
+		fake := func(name string, share float64) shutdownComponent {
+			return shutdownComponent{name: name, share: share, stop: func(ctx context.Context) error {
+				deadline, ok := ctx.Deadline()
+				require.True(t, ok)
+				budgets[name] = time.Until(deadline)
+				return nil
+			}}
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		require.NoError(t, shutdown(ctx, logger, fake("http", 0.5), fake("database", 0.1)))
+		assert.InDelta(t, float64(5*time.Second), float64(budgets["http"]), float64(100*time.Millisecond))
+		assert.InDelta(t, float64(time.Second), float64(budgets["database"]), float64(100*time.Millisecond))
+	})
+
+	t.Run("slow component does not block the rest", func(t *testing.T) {
+		var stopped []string
+		slow := shutdownComponent{name: "http", share: 0.1, stop: func(ctx context.Context) error {
+			<-ctx.Done()
+			return ctx.Err()
+		}}
+		failing := shutdownComponent{name: "tracer", share: 0.1, stop: func(context.Context) error {
+			stopped = append(stopped, "tracer")
+			return errors.New("flush failed")
+		}}
+		db := shutdownComponent{name: "database", share: 0.1, stop: func(context.Context) error {
+			stopped = append(stopped, "database")
+			return nil
+		}}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+		defer cancel()
+
+		err := shutdown(ctx, logger, slow, failing, db)
+		require.Error(t, err)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Contains(t, err.Error(), "tracer: flush failed")
+		assert.Equal(t, []string{"tracer", "database"}, stopped)
+	})
+}
@@ -17,6 +17,13 @@ type Cache interface {
 	// Delete removes a value from the cache
 	Delete(ctx context.Context, key string) error
 
+	// DeletePrefix removes every value whose key starts with prefix and
+	// returns the number of keys removed
+	DeletePrefix(ctx context.Context, prefix string) (int64, error)
+
+	// Ping checks that the cache backend is reachable
+	Ping(ctx context.Context) error
+
 	// Close closes the cache connection
 	Close() error
 }
@@ -91,6 +91,50 @@ func (c *RedisCache) Delete(ctx context.Context, key string) error {
 	return nil
 }
 
+// deletePrefixBatchSize is the SCAN page size used by DeletePrefix
+const deletePrefixBatchSize = 100
+
+// DeletePrefix removes every key starting with prefix. Keys are found with
+// SCAN rather than KEYS so Redis isn't blocked on large keyspaces.
+func (c *RedisCache) DeletePrefix(ctx context.Context, prefix string) (int64, error) {
+	var deleted int64
+	iter := c.client.Scan(ctx, 0, prefix+"*", deletePrefixBatchSize).Iterator()
+
+	keys := make([]string, 0, deletePrefixBatchSize)
+	for iter.Next(ctx) {
+		keys = append(keys, iter.Val())
+		if len(keys) == deletePrefixBatchSize {
+			n, err := c.client.Del(ctx, keys...).Result()
+			if err != nil {
+				return deleted, fmt.Errorf("failed to delete from cache: %w", err)
+			}
+			deleted += n
+			keys = keys[:0]
+		}
+	}
+	if err := iter.Err(); err != nil {
+		return deleted, fmt.Errorf("failed to scan cache keys: %w", err)
+	}
+
+	if len(keys) > 0 {
+		n, err := c.client.Del(ctx, keys...).Result()
+		if err != nil {
+			return deleted, fmt.Errorf("failed to delete from cache: %w", err)
+		}
+		deleted += n
+	}
+
+	return deleted, nil
+}
+
+// Ping checks the connection to Redis
+func (c *RedisCache) Ping(ctx context.Context) error {
+	if err := c.client.Ping(ctx).Err(); err != nil {
+		return fmt.Errorf("failed to ping Redis: %w", err)
+	}
+	return nil
+}
+
 // Close closes the Redis client connection
 func (c *RedisCache) Close() error {
 	return c.client.Close()
@@ -120,6 +164,16 @@ func (c *NoOpCache) Delete(ctx context.Context, key string) error {
 	return nil
 }
 
+// DeletePrefix does nothing and returns 0
+func (c *NoOpCache) DeletePrefix(ctx context.Context, prefix string) (int64, error) {
+	return 0, nil
+}
+
+// Ping does nothing and returns nil
+func (c *NoOpCache) Ping(ctx context.Context) error {
+	return nil
+}
+
 // Close does nothing and returns nil
 func (c *NoOpCache) Close() error {
 	return nil
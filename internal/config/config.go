@@ -3,22 +3,32 @@ package config
 
 import (
 	"fmt"
+	"net/url"
 	"os"
 	"strings"
 	"time"
 
 	"github.com/spf13/viper"
+
+	"github.com/npmulder/resume-api/internal/repository"
 )
 
 // Config represents the complete application configuration
 type Config struct {
-	Environment string          `mapstructure:"environment" validate:"required,oneof=development production test"`
-	Server      ServerConfig    `mapstructure:"server"`
-	Database    DatabaseConfig  `mapstructure:"database"`
-	Logging     LoggingConfig   `mapstructure:"logging"`
-	Redis       RedisConfig     `mapstructure:"redis"`
-	Telemetry   TelemetryConfig `mapstructure:"telemetry"`
-	CORS        CORSConfig      `mapstructure:"cors"`
+	Environment string           `mapstructure:"environment" validate:"required,oneof=development production test"`
+	Server      ServerConfig     `mapstructure:"server"`
+	Database    DatabaseConfig   `mapstructure:"database"`
+	Logging     LoggingConfig    `mapstructure:"logging"`
+	Redis       RedisConfig      `mapstructure:"redis"`
+	Telemetry   TelemetryConfig  `mapstructure:"telemetry"`
+	CORS        CORSConfig       `mapstructure:"cors"`
+	Auth        AuthConfig       `mapstructure:"auth"`
+	Search      SearchConfig     `mapstructure:"search"`
+	Webhooks    WebhookConfig    `mapstructure:"webhooks"`
+	Contact     ContactConfig    `mapstructure:"contact"`
+	Middleware  MiddlewareConfig `mapstructure:"middleware"`
+	// Features toggles experimental endpoints by name; see the features package
+	Features map[string]bool `mapstructure:"features"`
 }
 
 // ServerConfig contains HTTP server configuration
@@ -30,6 +40,61 @@ type ServerConfig struct {
 	IdleTimeout    time.Duration `mapstructure:"idle_timeout"`
 	GracefulStop   time.Duration `mapstructure:"graceful_stop"`
 	RequestTimeout time.Duration `mapstructure:"request_timeout"`
+	PublicBaseURL  string        `mapstructure:"public_base_url"` // External base URL used for absolute links (e.g. https://api.example.com)
+	// BatchMaxSize caps the number of operations in a batch request
+	BatchMaxSize int `mapstructure:"batch_max_size" validate:"min=1"`
+	// CacheControl sets the Cache-Control max-age of GET responses per path
+	// prefix; the longest matching prefix wins and a zero age sends no-store
+	CacheControl map[string]time.Duration `mapstructure:"cache_control"`
+	// StrictQuery rejects requests to API routes carrying unknown query parameters
+	StrictQuery bool `mapstructure:"strict_query"`
+	// GRPCPort serves the read API over gRPC on Host when set; 0 disables it
+	GRPCPort int `mapstructure:"grpc_port" validate:"min=0,max=65535"`
+	// MaxInFlight caps the number of requests handled at once; requests over
+	// the limit get 503. 0 disables the limit
+	MaxInFlight int `mapstructure:"max_in_flight" validate:"min=0"`
+	// MaxQueryLength caps the raw query string in bytes; longer requests get
+	// 414. 0 disables the limit
+	MaxQueryLength int `mapstructure:"max_query_length" validate:"min=0"`
+	// MaxPageSize caps the limit of list requests; larger limits are lowered
+	// to it and flagged with a Warning header. 0 disables the cap
+	MaxPageSize int `mapstructure:"max_page_size" validate:"min=0"`
+	// MaxHighlights, MaxTechnologies and MaxKeyFeatures cap the list fields
+	// of experiences and projects on create and update; 0 disables a cap
+	MaxHighlights   int `mapstructure:"max_highlights" validate:"min=0"`
+	MaxTechnologies int `mapstructure:"max_technologies" validate:"min=0"`
+	MaxKeyFeatures  int `mapstructure:"max_key_features" validate:"min=0"`
+	// HealthCacheTTL reuses a database health check for this long, so
+	// frequent probes don't each query the database; 0 checks every time
+	HealthCacheTTL time.Duration `mapstructure:"health_cache_ttl"`
+	// TrailingSlash handles API paths ending in a slash before routing:
+	// 'rewrite' serves the route without the slash, 'redirect' answers with a
+	// 308 to it and 'off' leaves the path as requested
+	TrailingSlash string `mapstructure:"trailing_slash" validate:"oneof=rewrite redirect off"`
+	// CanonicalHost redirects requests for any other host, e.g. www. or the
+	// bare IP, to this host name (with an optional port); /health and the
+	// metrics path are exempt. Empty disables the redirect
+	CanonicalHost string `mapstructure:"canonical_host"`
+	// EnablePprof serves the runtime profiler under /debug/pprof behind the
+	// API key; keep profile durations below RequestTimeout
+	EnablePprof bool `mapstructure:"enable_pprof"`
+	// PrettyJSON indents JSON responses unless a request asks for compact
+	// output with ?pretty=false; compact is the default
+	PrettyJSON bool `mapstructure:"pretty_json"`
+	// MaskContactDetails partly hides the profile's email and phone, e.g.
+	// j***@example.com, from requests without the API key and from every
+	// gRPC caller
+	MaskContactDetails bool `mapstructure:"mask_contact_details"`
+	// ResumeSnapshotPath is a resume JSON file served by /resume.html while
+	// the database is unavailable; empty disables the fallback
+	ResumeSnapshotPath string `mapstructure:"resume_snapshot_path"`
+	// DefaultSort overrides the built-in list order per entity as "column [asc|desc]",
+	// e.g. skills: "years_experience desc"
+	DefaultSort map[string]string `mapstructure:"default_sort"`
+	// SkillCategoryOrder lists skill categories in display order, e.g.
+	// Languages,Frameworks; unlisted categories follow alphabetically and a
+	// default_sort for skills takes precedence
+	SkillCategoryOrder []string `mapstructure:"skill_category_order"`
 }
 
 // DatabaseConfig contains database connection configuration
@@ -42,14 +107,41 @@ type DatabaseConfig struct {
 	SSLMode            string        `mapstructure:"ssl_mode" validate:"oneof=disable require verify-ca verify-full"`
 	MaxConnections     int           `mapstructure:"max_connections" validate:"min=1"`
 	MaxIdleConnections int           `mapstructure:"max_idle_connections" validate:"min=1"`
+	MinConnections     int           `mapstructure:"min_connections" validate:"min=0"` // Opened up front and kept open to avoid first-request latency
 	ConnMaxLifetime    time.Duration `mapstructure:"conn_max_lifetime"`
 	ConnMaxIdleTime    time.Duration `mapstructure:"conn_max_idle_time"`
+	// ReadReplicaURL is the connection URL of a read replica used for read
+	// queries; empty sends every query to the primary
+	ReadReplicaURL string `mapstructure:"read_replica_url"`
+	// StatementCacheMode selects how queries are prepared: 'prepare' caches
+	// prepared statements, 'describe' caches only their descriptions and
+	// 'disable' describes every query
+	StatementCacheMode string `mapstructure:"statement_cache_mode" validate:"oneof=prepare describe disable"`
+	// ApplicationName identifies the API's connections in pg_stat_activity;
+	// empty derives it from the service name and environment, e.g.
+	// resume-api-production
+	ApplicationName string `mapstructure:"application_name"`
+	// AutoMigrate applies pending migrations on startup; startup fails when
+	// the migration state is dirty
+	AutoMigrate bool `mapstructure:"auto_migrate"`
 }
 
 // LoggingConfig contains logging configuration
 type LoggingConfig struct {
 	Level  string `mapstructure:"level" validate:"oneof=debug info warn error"`
 	Format string `mapstructure:"format" validate:"oneof=json text"`
+	// QueryLogSampleRate logs 1 in N successful queries at debug level;
+	// failed and slow queries are always logged
+	QueryLogSampleRate int `mapstructure:"query_log_sample_rate"`
+	// SlowRequestThreshold logs requests taking longer at warn level; zero
+	// disables the slow request log
+	SlowRequestThreshold time.Duration `mapstructure:"slow_request_threshold"`
+	// RedactHeaders lists request headers whose values are logged as "***";
+	// headers are only logged at debug level
+	RedactHeaders []string `mapstructure:"redact_headers"`
+	// AccessLogFormat writes each request as a structured "json" entry or an
+	// Apache "combined" Log Format line for pipelines that expect one
+	AccessLogFormat string `mapstructure:"access_log_format" validate:"oneof=json combined"`
 }
 
 // RedisConfig contains Redis connection configuration
@@ -59,7 +151,9 @@ type RedisConfig struct {
 	Password string        `mapstructure:"password"`
 	DB       int           `mapstructure:"db" validate:"min=0"`
 	TTL      time.Duration `mapstructure:"ttl"`
-	Enabled  bool          `mapstructure:"enabled"`
+	// NegativeTTL is how long not-found results are cached; zero disables it
+	NegativeTTL time.Duration `mapstructure:"negative_ttl"`
+	Enabled     bool          `mapstructure:"enabled"`
 }
 
 // TelemetryConfig contains OpenTelemetry configuration
@@ -69,6 +163,12 @@ type TelemetryConfig struct {
 	ExporterType     string  `mapstructure:"exporter_type" validate:"required_if=Enabled true,oneof=stdout otlp"`
 	ExporterEndpoint string  `mapstructure:"exporter_endpoint"`
 	SamplingRate     float64 `mapstructure:"sampling_rate" validate:"min=0,max=1"`
+	MetricsPath      string  `mapstructure:"metrics_path"`
+	// MetricsAuthToken, when set, is required as a bearer token to scrape metrics
+	MetricsAuthToken string `mapstructure:"metrics_auth_token"`
+	// MetricsRequired stops startup when metrics fail to initialize; otherwise
+	// the server logs the failure and runs without HTTP metrics
+	MetricsRequired bool `mapstructure:"metrics_required"`
 }
 
 // CORSConfig contains CORS configuration
@@ -81,6 +181,61 @@ type CORSConfig struct {
 	MaxAge           time.Duration `mapstructure:"max_age"`
 }
 
+// AuthConfig contains authentication configuration for write endpoints
+type AuthConfig struct {
+	// APIKey is required in the X-API-Key header for write endpoints.
+	// Write endpoints are disabled when no key is configured.
+	APIKey string `mapstructure:"api_key"`
+	// DocsUsername and DocsPassword protect the Swagger UI and OpenAPI spec
+	// with basic auth in production; the docs stay open when either is empty
+	DocsUsername string `mapstructure:"docs_username"`
+	DocsPassword string `mapstructure:"docs_password"`
+}
+
+// SearchConfig contains search configuration
+type SearchConfig struct {
+	// MaxResults caps the number of rows a search returns
+	MaxResults int `mapstructure:"max_results" validate:"min=1"`
+}
+
+// MiddlewareConfig switches optional middleware on and off, e.g. to
+// benchmark or debug the API without them
+type MiddlewareConfig struct {
+	RateLimiter     bool `mapstructure:"rate_limiter"`
+	InputValidation bool `mapstructure:"input_validation"`
+	SecurityHeaders bool `mapstructure:"security_headers"`
+}
+
+// WebhookConfig contains configuration for change notification webhooks
+type WebhookConfig struct {
+	// URLs receive a POST for every successful write; none disables webhooks
+	URLs         []string      `mapstructure:"urls"`
+	Timeout      time.Duration `mapstructure:"timeout"`
+	MaxRetries   int           `mapstructure:"max_retries"`   // Attempts after the first failed delivery
+	RetryBackoff time.Duration `mapstructure:"retry_backoff"` // Delay before the first retry, doubled on each attempt
+	QueueSize    int           `mapstructure:"queue_size"`    // Events buffered before new ones are dropped
+	Workers      int           `mapstructure:"workers"`
+}
+
+// ContactConfig contains configuration for the contact form relay
+type ContactConfig struct {
+	// Sender relays submissions: 'log' only logs them, 'smtp' emails them to To
+	Sender       string `mapstructure:"sender" validate:"oneof=log smtp"`
+	SMTPHost     string `mapstructure:"smtp_host"`
+	SMTPPort     int    `mapstructure:"smtp_port" validate:"min=1,max=65535"`
+	SMTPUsername string `mapstructure:"smtp_username"` // Empty sends without authentication
+	SMTPPassword string `mapstructure:"smtp_password"`
+	From         string `mapstructure:"from"` // Sender address of relayed emails
+	To           string `mapstructure:"to"`   // Address receiving relayed emails
+	// RateLimit caps submissions per client IP within RateLimitWindow; 0
+	// disables the limit
+	RateLimit       int           `mapstructure:"rate_limit" validate:"min=0"`
+	RateLimitWindow time.Duration `mapstructure:"rate_limit_window"`
+	// MinInterval is the least time after an accepted submission before the
+	// same client IP may submit again; 0 disables the check
+	MinInterval time.Duration `mapstructure:"min_interval"`
+}
+
 // Load loads configuration from environment variables and config files
 func Load() (*Config, error) {
 	// Set up Viper
@@ -115,12 +270,23 @@ func Load() (*Config, error) {
 	// This ensures that environment variables are properly mapped to the configuration struct
 	bindEnvVariables(v)
 
+	// Layer the environment-specific config file (config.<environment>.yaml)
+	// under environment variables
+	if err := mergeEnvironmentConfig(v); err != nil {
+		return nil, err
+	}
+
 	// Unmarshal configuration
 	var config Config
 	if err := v.Unmarshal(&config); err != nil {
 		return nil, fmt.Errorf("failed to unmarshal config: %w", err)
 	}
 
+	// Tell environments apart in pg_stat_activity unless a name is configured
+	if config.Database.ApplicationName == "" {
+		config.Database.ApplicationName = config.Telemetry.ServiceName + "-" + config.Environment
+	}
+
 	// Validate configuration
 	if err := validateConfig(&config); err != nil {
 		return nil, fmt.Errorf("config validation failed: %w", err)
@@ -129,6 +295,34 @@ func Load() (*Config, error) {
 	return &config, nil
 }
 
+// mergeEnvironmentConfig merges config.<environment>.yaml into v when it exists.
+// Values from the file override defaults but not environment variables.
+func mergeEnvironmentConfig(v *viper.Viper) error {
+	environment := v.GetString("environment")
+	if environment == "" {
+		return nil
+	}
+
+	fileConfig := viper.New()
+	fileConfig.SetConfigName("config." + environment)
+	fileConfig.SetConfigType("yaml")
+	fileConfig.AddConfigPath(".")
+	fileConfig.AddConfigPath("./config")
+
+	if err := fileConfig.ReadInConfig(); err != nil {
+		if _, ok := err.(viper.ConfigFileNotFoundError); ok {
+			return nil
+		}
+		return fmt.Errorf("failed to read %s config file: %w", environment, err)
+	}
+
+	if err := v.MergeConfigMap(fileConfig.AllSettings()); err != nil {
+		return fmt.Errorf("failed to merge %s config file: %w", environment, err)
+	}
+
+	return nil
+}
+
 // loadEnvFromFile loads environment variables from a .env file
 func loadEnvFromFile(filePath string) {
 	// Read the .env file
@@ -172,6 +366,9 @@ func bindEnvVariables(v *viper.Viper) {
 	_ = v.BindEnv("telemetry.exporter_type", "RESUME_API_TELEMETRY_EXPORTER_TYPE")
 	_ = v.BindEnv("telemetry.exporter_endpoint", "RESUME_API_TELEMETRY_EXPORTER_ENDPOINT")
 	_ = v.BindEnv("telemetry.sampling_rate", "RESUME_API_TELEMETRY_SAMPLING_RATE")
+	_ = v.BindEnv("telemetry.metrics_path", "RESUME_API_TELEMETRY_METRICS_PATH")
+	_ = v.BindEnv("telemetry.metrics_auth_token", "RESUME_API_TELEMETRY_METRICS_AUTH_TOKEN")
+	_ = v.BindEnv("telemetry.metrics_required", "RESUME_API_TELEMETRY_METRICS_REQUIRED")
 
 	// Bind CORS environment variables
 	_ = v.BindEnv("cors.allow_origins", "RESUME_API_CORS_ALLOW_ORIGINS")
@@ -180,6 +377,9 @@ func bindEnvVariables(v *viper.Viper) {
 	_ = v.BindEnv("cors.expose_headers", "RESUME_API_CORS_EXPOSE_HEADERS")
 	_ = v.BindEnv("cors.allow_credentials", "RESUME_API_CORS_ALLOW_CREDENTIALS")
 	_ = v.BindEnv("cors.max_age", "RESUME_API_CORS_MAX_AGE")
+
+	// Bind webhook environment variables
+	_ = v.BindEnv("webhooks.urls", "RESUME_API_WEBHOOKS_URLS")
 }
 
 // setDefaults sets default configuration values
@@ -195,6 +395,30 @@ func setDefaults(v *viper.Viper) {
 	v.SetDefault("server.idle_timeout", "60s")
 	v.SetDefault("server.graceful_stop", "30s")
 	v.SetDefault("server.request_timeout", "10s")
+	v.SetDefault("server.public_base_url", "")
+	v.SetDefault("server.batch_max_size", 10)
+	v.SetDefault("server.grpc_port", 0)
+	v.SetDefault("server.strict_query", false)
+	v.SetDefault("server.max_in_flight", 100)
+	v.SetDefault("server.max_query_length", 2048)
+	v.SetDefault("server.max_page_size", 100)
+	v.SetDefault("server.max_highlights", 20)
+	v.SetDefault("server.max_technologies", 30)
+	v.SetDefault("server.max_key_features", 20)
+	v.SetDefault("server.resume_snapshot_path", "")
+	v.SetDefault("server.health_cache_ttl", "2s")
+	v.SetDefault("server.trailing_slash", "rewrite")
+	v.SetDefault("server.canonical_host", "")
+	v.SetDefault("server.enable_pprof", false)
+	v.SetDefault("server.pretty_json", false)
+	v.SetDefault("server.mask_contact_details", false)
+	v.SetDefault("server.skill_category_order", []string{})
+	v.SetDefault("server.cache_control", map[string]string{
+		"/api/v1":                 "60s",
+		"/api/v1/profile/history": "0s", // Requires an API key, so never cache it publicly
+		"/api/v1/profile/diff":    "0s", // Built from the history, so likewise never cached
+		"/api/v1/admin":           "0s", // Admin endpoints require an API key too
+	})
 
 	// Database defaults
 	v.SetDefault("database.host", "localhost")
@@ -205,12 +429,21 @@ func setDefaults(v *viper.Viper) {
 	v.SetDefault("database.ssl_mode", "disable")
 	v.SetDefault("database.max_connections", 25)
 	v.SetDefault("database.max_idle_connections", 5)
+	v.SetDefault("database.min_connections", 1)
 	v.SetDefault("database.conn_max_lifetime", "1h")
 	v.SetDefault("database.conn_max_idle_time", "30m")
+	v.SetDefault("database.read_replica_url", "")
+	v.SetDefault("database.statement_cache_mode", "prepare")
+	v.SetDefault("database.application_name", "")
+	v.SetDefault("database.auto_migrate", false)
 
 	// Logging defaults
 	v.SetDefault("logging.level", "info")
 	v.SetDefault("logging.format", "json")
+	v.SetDefault("logging.query_log_sample_rate", 1)
+	v.SetDefault("logging.slow_request_threshold", "1s")
+	v.SetDefault("logging.redact_headers", []string{"Authorization", "Proxy-Authorization", "X-API-Key", "Cookie"})
+	v.SetDefault("logging.access_log_format", "json")
 
 	// Redis defaults
 	v.SetDefault("redis.host", "localhost")
@@ -218,6 +451,7 @@ func setDefaults(v *viper.Viper) {
 	v.SetDefault("redis.password", "")
 	v.SetDefault("redis.db", 0)
 	v.SetDefault("redis.ttl", "15m")
+	v.SetDefault("redis.negative_ttl", "30s")
 	v.SetDefault("redis.enabled", true)
 
 	// Telemetry defaults
@@ -226,14 +460,54 @@ func setDefaults(v *viper.Viper) {
 	v.SetDefault("telemetry.exporter_type", "stdout")
 	v.SetDefault("telemetry.exporter_endpoint", "")
 	v.SetDefault("telemetry.sampling_rate", 1.0) // 100% sampling by default
+	v.SetDefault("telemetry.metrics_path", "/metrics")
+	v.SetDefault("telemetry.metrics_auth_token", "")
+	v.SetDefault("telemetry.metrics_required", false)
 
 	// CORS defaults
 	v.SetDefault("cors.allow_origins", []string{"http://localhost:3000", "http://127.0.0.1:3000"})
 	v.SetDefault("cors.allow_methods", []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"})
-	v.SetDefault("cors.allow_headers", []string{"Origin", "Content-Type", "Accept", "Authorization"})
-	v.SetDefault("cors.expose_headers", []string{"Content-Length"})
+	v.SetDefault("cors.allow_headers", []string{"Origin", "Content-Type", "Accept", "Authorization", "X-API-Key", "If-Match"})
+	v.SetDefault("cors.expose_headers", []string{"Content-Length", "ETag"})
 	v.SetDefault("cors.allow_credentials", true)
 	v.SetDefault("cors.max_age", "12h")
+
+	// Auth defaults
+	v.SetDefault("auth.api_key", "")
+	v.SetDefault("auth.docs_username", "")
+	v.SetDefault("auth.docs_password", "")
+
+	// Search defaults
+	v.SetDefault("search.max_results", 100)
+
+	// Webhook defaults
+	v.SetDefault("webhooks.urls", []string{})
+	v.SetDefault("webhooks.timeout", "5s")
+	v.SetDefault("webhooks.max_retries", 3)
+	v.SetDefault("webhooks.retry_backoff", "1s")
+	v.SetDefault("webhooks.queue_size", 100)
+	v.SetDefault("webhooks.workers", 2)
+
+	// Contact form defaults
+	v.SetDefault("contact.sender", "log")
+	v.SetDefault("contact.smtp_host", "")
+	v.SetDefault("contact.smtp_port", 587)
+	v.SetDefault("contact.smtp_username", "")
+	v.SetDefault("contact.smtp_password", "")
+	v.SetDefault("contact.from", "")
+	v.SetDefault("contact.to", "")
+	v.SetDefault("contact.rate_limit", 3)
+	v.SetDefault("contact.rate_limit_window", "1h")
+	v.SetDefault("contact.min_interval", "30s")
+
+	// Feature flag defaults
+	v.SetDefault("features.batch", true)
+	v.SetDefault("features.graphql", true)
+
+	// Middleware defaults
+	v.SetDefault("middleware.rate_limiter", true)
+	v.SetDefault("middleware.input_validation", true)
+	v.SetDefault("middleware.security_headers", true)
 }
 
 // validateConfig performs basic validation on the configuration
@@ -253,6 +527,58 @@ func validateConfig(config *Config) error {
 		return fmt.Errorf("invalid server port: %d (must be between 1 and 65535)", config.Server.Port)
 	}
 
+	if config.Server.GRPCPort < 0 || config.Server.GRPCPort > 65535 {
+		return fmt.Errorf("invalid server grpc_port: %d (must be between 0 and 65535)", config.Server.GRPCPort)
+	}
+	if config.Server.GRPCPort != 0 && config.Server.GRPCPort == config.Server.Port {
+		return fmt.Errorf("invalid server grpc_port: %d (must differ from the HTTP port)", config.Server.GRPCPort)
+	}
+
+	if config.Server.MaxInFlight < 0 {
+		return fmt.Errorf("server max_in_flight cannot be negative")
+	}
+
+	if config.Server.MaxQueryLength < 0 {
+		return fmt.Errorf("server max_query_length cannot be negative")
+	}
+
+	if config.Server.MaxPageSize < 0 {
+		return fmt.Errorf("server max_page_size cannot be negative")
+	}
+
+	if config.Server.MaxHighlights < 0 || config.Server.MaxTechnologies < 0 || config.Server.MaxKeyFeatures < 0 {
+		return fmt.Errorf("server max_highlights, max_technologies and max_key_features cannot be negative")
+	}
+
+	if config.Server.HealthCacheTTL < 0 {
+		return fmt.Errorf("server health_cache_ttl cannot be negative")
+	}
+
+	validTrailingSlashModes := map[string]bool{
+		"rewrite":  true,
+		"redirect": true,
+		"off":      true,
+	}
+	if config.Server.TrailingSlash != "" && !validTrailingSlashModes[config.Server.TrailingSlash] {
+		return fmt.Errorf("invalid server trailing_slash: %s (must be one of: rewrite, redirect, off)", config.Server.TrailingSlash)
+	}
+
+	if strings.ContainsAny(config.Server.CanonicalHost, "/?# ") {
+		return fmt.Errorf("invalid server canonical_host: %s (must be a host name without scheme or path)", config.Server.CanonicalHost)
+	}
+
+	for prefix, maxAge := range config.Server.CacheControl {
+		if !strings.HasPrefix(prefix, "/") || maxAge < 0 {
+			return fmt.Errorf("invalid server cache_control: %s: %s (prefix must start with / and max-age must not be negative)", prefix, maxAge)
+		}
+	}
+
+	for entity, spec := range config.Server.DefaultSort {
+		if _, err := repository.ParseSort(entity, spec); err != nil {
+			return fmt.Errorf("invalid server default_sort: %w", err)
+		}
+	}
+
 	// Validate database port
 	if config.Database.Port < 1 || config.Database.Port > 65535 {
 		return fmt.Errorf("invalid database port: %d (must be between 1 and 65535)", config.Database.Port)
@@ -289,6 +615,22 @@ func validateConfig(config *Config) error {
 		return fmt.Errorf("invalid log format: %s", config.Logging.Format)
 	}
 
+	validAccessLogFormats := map[string]bool{
+		"json":     true,
+		"combined": true,
+	}
+	if config.Logging.AccessLogFormat != "" && !validAccessLogFormats[config.Logging.AccessLogFormat] {
+		return fmt.Errorf("invalid logging access_log_format: %s (must be one of: json, combined)", config.Logging.AccessLogFormat)
+	}
+
+	if config.Logging.QueryLogSampleRate < 0 {
+		return fmt.Errorf("logging query_log_sample_rate cannot be negative")
+	}
+
+	if config.Logging.SlowRequestThreshold < 0 {
+		return fmt.Errorf("logging slow_request_threshold cannot be negative")
+	}
+
 	// Validate database connection settings
 	if config.Database.MaxConnections < 1 {
 		return fmt.Errorf("max_connections must be at least 1")
@@ -299,6 +641,26 @@ func validateConfig(config *Config) error {
 	if config.Database.MaxIdleConnections > config.Database.MaxConnections {
 		return fmt.Errorf("max_idle_connections cannot be greater than max_connections")
 	}
+	if config.Database.MinConnections < 0 {
+		return fmt.Errorf("min_connections cannot be negative")
+	}
+	if config.Database.MinConnections > config.Database.MaxConnections {
+		return fmt.Errorf("min_connections cannot be greater than max_connections")
+	}
+	validStatementCacheModes := map[string]bool{
+		"prepare":  true,
+		"describe": true,
+		"disable":  true,
+	}
+	if config.Database.StatementCacheMode != "" && !validStatementCacheModes[config.Database.StatementCacheMode] {
+		return fmt.Errorf("invalid database statement_cache_mode: %s (must be one of: prepare, describe, disable)", config.Database.StatementCacheMode)
+	}
+	if config.Database.ReadReplicaURL != "" {
+		u, err := url.Parse(config.Database.ReadReplicaURL)
+		if err != nil || (u.Scheme != "postgres" && u.Scheme != "postgresql") || u.Host == "" {
+			return fmt.Errorf("invalid database read_replica_url (must be a postgres:// URL)")
+		}
+	}
 
 	// Validate Redis configuration if enabled
 	if config.Redis.Enabled {
@@ -311,6 +673,9 @@ func validateConfig(config *Config) error {
 		if config.Redis.TTL < time.Second {
 			return fmt.Errorf("redis ttl must be at least 1 second")
 		}
+		if config.Redis.NegativeTTL < 0 {
+			return fmt.Errorf("redis negative ttl must be non-negative")
+		}
 	}
 
 	// Validate Telemetry configuration if enabled
@@ -337,6 +702,51 @@ func validateConfig(config *Config) error {
 		}
 	}
 
+	// Validate contact form relay
+	switch config.Contact.Sender {
+	case "", "log":
+	case "smtp":
+		if config.Contact.SMTPHost == "" || config.Contact.From == "" || config.Contact.To == "" {
+			return fmt.Errorf("contact smtp_host, from and to are required when the sender is smtp")
+		}
+		if config.Contact.SMTPPort < 1 || config.Contact.SMTPPort > 65535 {
+			return fmt.Errorf("invalid contact smtp_port: %d (must be between 1 and 65535)", config.Contact.SMTPPort)
+		}
+	default:
+		return fmt.Errorf("invalid contact sender: %s (must be one of: log, smtp)", config.Contact.Sender)
+	}
+	if config.Contact.RateLimit < 0 {
+		return fmt.Errorf("contact rate_limit cannot be negative")
+	}
+	if config.Contact.RateLimit > 0 && config.Contact.RateLimitWindow <= 0 {
+		return fmt.Errorf("contact rate_limit_window must be positive")
+	}
+	if config.Contact.MinInterval < 0 {
+		return fmt.Errorf("contact min_interval cannot be negative")
+	}
+
+	if config.Telemetry.MetricsPath != "" && !strings.HasPrefix(config.Telemetry.MetricsPath, "/") {
+		return fmt.Errorf("telemetry metrics_path must start with /, got: %s", config.Telemetry.MetricsPath)
+	}
+
+	// Validate webhook configuration if any endpoints are set
+	if len(config.Webhooks.URLs) > 0 {
+		for _, raw := range config.Webhooks.URLs {
+			u, err := url.Parse(raw)
+			if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+				return fmt.Errorf("invalid webhook url: %q (must be an absolute http or https URL)", raw)
+			}
+		}
+		if config.Webhooks.MaxRetries < 0 {
+			return fmt.Errorf("webhooks max_retries cannot be negative")
+		}
+		if config.Webhooks.QueueSize < 1 {
+			return fmt.Errorf("webhooks queue_size must be at least 1")
+		}
+		if config.Webhooks.Workers < 1 {
+			return fmt.Errorf("webhooks workers must be at least 1")
+		}
+	}
 	return nil
 }
 
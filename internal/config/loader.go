@@ -49,29 +49,7 @@ func LoadForTesting() *Config {
 
 // PrintConfig logs the current configuration (with sensitive data masked)
 func PrintConfig(config *Config, logger *slog.Logger) {
-	logger.Info("Configuration loaded",
-		slog.String("environment", config.Environment),
-		slog.String("server_address", config.Server.ServerAddress()),
-		slog.Duration("server_read_timeout", config.Server.ReadTimeout),
-		slog.Duration("server_write_timeout", config.Server.WriteTimeout),
-		slog.String("database_host", config.Database.Host),
-		slog.Int("database_port", config.Database.Port),
-		slog.String("database_name", config.Database.Name),
-		slog.String("database_user", config.Database.User),
-		slog.String("database_password", maskPassword(config.Database.Password)),
-		slog.String("database_ssl_mode", config.Database.SSLMode),
-		slog.Int("database_max_connections", config.Database.MaxConnections),
-		slog.String("logging_level", config.Logging.Level),
-		slog.String("logging_format", config.Logging.Format),
-	)
-}
-
-// maskPassword masks a password for logging
-func maskPassword(password string) string {
-	if len(password) <= 2 {
-		return "***"
-	}
-	return password[:1] + "***" + password[len(password)-1:]
+	logger.Info("Configuration loaded", slog.Any("config", config))
 }
 
 // ValidateForProduction performs additional validation for production environment
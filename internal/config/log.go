@@ -0,0 +1,139 @@
+package config
+
+import (
+	"log/slog"
+)
+
+// redacted replaces secret values in logged configuration
+const redacted = "***"
+
+// redact hides a secret while still showing whether it is set
+func redact(secret string) string {
+	if secret == "" {
+		return ""
+	}
+	return redacted
+}
+
+// LogValue implements slog.LogValuer so the effective configuration can be
+// logged without leaking passwords or API keys.
+func (c *Config) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("environment", c.Environment),
+		slog.Group("server",
+			slog.String("host", c.Server.Host),
+			slog.Int("port", c.Server.Port),
+			slog.Duration("read_timeout", c.Server.ReadTimeout),
+			slog.Duration("write_timeout", c.Server.WriteTimeout),
+			slog.Duration("idle_timeout", c.Server.IdleTimeout),
+			slog.Duration("graceful_stop", c.Server.GracefulStop),
+			slog.Duration("request_timeout", c.Server.RequestTimeout),
+			slog.String("public_base_url", c.Server.PublicBaseURL),
+			slog.Int("batch_max_size", c.Server.BatchMaxSize),
+			slog.Bool("strict_query", c.Server.StrictQuery),
+			slog.Int("grpc_port", c.Server.GRPCPort),
+			slog.Int("max_in_flight", c.Server.MaxInFlight),
+			slog.Int("max_query_length", c.Server.MaxQueryLength),
+			slog.Int("max_page_size", c.Server.MaxPageSize),
+			slog.Int("max_highlights", c.Server.MaxHighlights),
+			slog.Int("max_technologies", c.Server.MaxTechnologies),
+			slog.Int("max_key_features", c.Server.MaxKeyFeatures),
+			slog.Duration("health_cache_ttl", c.Server.HealthCacheTTL),
+			slog.String("trailing_slash", c.Server.TrailingSlash),
+			slog.String("canonical_host", c.Server.CanonicalHost),
+			slog.Bool("enable_pprof", c.Server.EnablePprof),
+			slog.Bool("pretty_json", c.Server.PrettyJSON),
+			slog.Bool("mask_contact_details", c.Server.MaskContactDetails),
+			slog.String("resume_snapshot_path", c.Server.ResumeSnapshotPath),
+			slog.Any("cache_control", c.Server.CacheControl),
+			slog.Any("skill_category_order", c.Server.SkillCategoryOrder),
+		),
+		slog.Group("database",
+			slog.String("host", c.Database.Host),
+			slog.Int("port", c.Database.Port),
+			slog.String("name", c.Database.Name),
+			slog.String("user", c.Database.User),
+			slog.String("password", redact(c.Database.Password)),
+			slog.String("ssl_mode", c.Database.SSLMode),
+			slog.Int("max_connections", c.Database.MaxConnections),
+			slog.Int("max_idle_connections", c.Database.MaxIdleConnections),
+			slog.Int("min_connections", c.Database.MinConnections),
+			slog.Duration("conn_max_lifetime", c.Database.ConnMaxLifetime),
+			slog.Duration("conn_max_idle_time", c.Database.ConnMaxIdleTime),
+			slog.String("read_replica_url", redact(c.Database.ReadReplicaURL)),
+			slog.String("statement_cache_mode", c.Database.StatementCacheMode),
+			slog.String("application_name", c.Database.ApplicationName),
+			slog.Bool("auto_migrate", c.Database.AutoMigrate),
+		),
+		slog.Group("logging",
+			slog.String("level", c.Logging.Level),
+			slog.String("format", c.Logging.Format),
+			slog.Int("query_log_sample_rate", c.Logging.QueryLogSampleRate),
+			slog.Duration("slow_request_threshold", c.Logging.SlowRequestThreshold),
+			slog.Any("redact_headers", c.Logging.RedactHeaders),
+			slog.String("access_log_format", c.Logging.AccessLogFormat),
+		),
+		slog.Group("redis",
+			slog.String("host", c.Redis.Host),
+			slog.Int("port", c.Redis.Port),
+			slog.String("password", redact(c.Redis.Password)),
+			slog.Int("db", c.Redis.DB),
+			slog.Duration("ttl", c.Redis.TTL),
+			slog.Duration("negative_ttl", c.Redis.NegativeTTL),
+			slog.Bool("enabled", c.Redis.Enabled),
+		),
+		slog.Group("telemetry",
+			slog.Bool("enabled", c.Telemetry.Enabled),
+			slog.String("service_name", c.Telemetry.ServiceName),
+			slog.String("exporter_type", c.Telemetry.ExporterType),
+			slog.String("exporter_endpoint", c.Telemetry.ExporterEndpoint),
+			slog.Float64("sampling_rate", c.Telemetry.SamplingRate),
+			slog.String("metrics_path", c.Telemetry.MetricsPath),
+			slog.String("metrics_auth_token", redact(c.Telemetry.MetricsAuthToken)),
+			slog.Bool("metrics_required", c.Telemetry.MetricsRequired),
+		),
+		slog.Group("cors",
+			slog.Any("allow_origins", c.CORS.AllowOrigins),
+			slog.Any("allow_methods", c.CORS.AllowMethods),
+			slog.Any("allow_headers", c.CORS.AllowHeaders),
+			slog.Any("expose_headers", c.CORS.ExposeHeaders),
+			slog.Bool("allow_credentials", c.CORS.AllowCredentials),
+			slog.Duration("max_age", c.CORS.MaxAge),
+		),
+		slog.Group("auth",
+			slog.String("api_key", redact(c.Auth.APIKey)),
+			slog.String("docs_username", c.Auth.DocsUsername),
+			slog.String("docs_password", redact(c.Auth.DocsPassword)),
+		),
+		slog.Group("search",
+			slog.Int("max_results", c.Search.MaxResults),
+		),
+		// Webhook URLs may embed credentials, so only their number is logged
+		slog.Group("webhooks",
+			slog.Int("urls", len(c.Webhooks.URLs)),
+			slog.Duration("timeout", c.Webhooks.Timeout),
+			slog.Int("max_retries", c.Webhooks.MaxRetries),
+			slog.Duration("retry_backoff", c.Webhooks.RetryBackoff),
+			slog.Int("queue_size", c.Webhooks.QueueSize),
+			slog.Int("workers", c.Webhooks.Workers),
+		),
+		slog.Group("contact",
+			slog.String("sender", c.Contact.Sender),
+			slog.String("smtp_host", c.Contact.SMTPHost),
+			slog.Int("smtp_port", c.Contact.SMTPPort),
+			slog.String("smtp_username", c.Contact.SMTPUsername),
+			slog.String("smtp_password", redact(c.Contact.SMTPPassword)),
+			slog.String("from", c.Contact.From),
+			slog.String("to", c.Contact.To),
+			slog.Int("rate_limit", c.Contact.RateLimit),
+			slog.Duration("rate_limit_window", c.Contact.RateLimitWindow),
+			slog.Duration("min_interval", c.Contact.MinInterval),
+		),
+		slog.Group("middleware",
+			slog.Bool("rate_limiter", c.Middleware.RateLimiter),
+			slog.Bool("input_validation", c.Middleware.InputValidation),
+			slog.Bool("security_headers", c.Middleware.SecurityHeaders),
+		),
+		slog.Any("features", c.Features),
+	)
+}
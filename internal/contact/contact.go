@@ -0,0 +1,118 @@
+// Package contact relays contact form submissions to the site owner
+package contact
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log/slog"
+	"mime"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/npmulder/resume-api/internal/config"
+	"github.com/npmulder/resume-api/internal/models"
+)
+
+// Sender relays a stored contact form submission
+type Sender interface {
+	Send(ctx context.Context, message *models.ContactMessage) error
+}
+
+// New returns the sender selected by cfg.Sender
+func New(cfg *config.ContactConfig, logger *slog.Logger) (Sender, error) {
+	switch cfg.Sender {
+	case "", "log":
+		return NewLogSender(logger), nil
+	case "smtp":
+		return NewSMTPSender(cfg), nil
+	default:
+		return nil, fmt.Errorf("unknown contact sender: %s", cfg.Sender)
+	}
+}
+
+// LogSender logs submissions instead of relaying them, for development and
+// for sites that read submissions from the database
+type LogSender struct {
+	logger *slog.Logger
+}
+
+// NewLogSender creates a sender that logs submissions to logger
+func NewLogSender(logger *slog.Logger) *LogSender {
+	return &LogSender{logger: logger}
+}
+
+// Send logs the submission; the message body is left out of the log
+func (s *LogSender) Send(ctx context.Context, message *models.ContactMessage) error {
+	s.logger.InfoContext(ctx, "contact form submission received",
+		"id", message.ID, "name", message.Name, "email", message.Email, "length", len(message.Message))
+	return nil
+}
+
+// SMTPSender emails submissions through an SMTP server
+type SMTPSender struct {
+	addr string
+	auth smtp.Auth
+	from string
+	to   string
+
+	// sendMail is smtp.SendMail, replaced in tests
+	sendMail func(addr string, a smtp.Auth, from string, to []string, msg []byte) error
+}
+
+// NewSMTPSender creates a sender emailing submissions to cfg.To. The server
+// is only authenticated against when a username is configured.
+func NewSMTPSender(cfg *config.ContactConfig) *SMTPSender {
+	s := &SMTPSender{
+		addr:     net.JoinHostPort(cfg.SMTPHost, strconv.Itoa(cfg.SMTPPort)),
+		from:     cfg.From,
+		to:       cfg.To,
+		sendMail: smtp.SendMail,
+	}
+	if cfg.SMTPUsername != "" {
+		s.auth = smtp.PlainAuth("", cfg.SMTPUsername, cfg.SMTPPassword, cfg.SMTPHost)
+	}
+	return s
+}
+
+// Send emails the submission, with Reply-To set to the submitter so the owner
+// can answer directly. net/smtp takes no context, so ctx only stops sends
+// that haven't started.
+func (s *SMTPSender) Send(ctx context.Context, message *models.ContactMessage) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if err := s.sendMail(s.addr, s.auth, s.from, []string{s.to}, s.buildMessage(message, time.Now())); err != nil {
+		return fmt.Errorf("failed to send contact message: %w", err)
+	}
+	return nil
+}
+
+// buildMessage formats message as an RFC 5322 email. Submitted values are
+// encoded so they can't inject headers.
+func (s *SMTPSender) buildMessage(message *models.ContactMessage, now time.Time) []byte {
+	replyTo := mail.Address{Name: message.Name, Address: message.Email}
+	subject := mime.QEncoding.Encode("utf-8", "Contact form: "+stripNewlines(message.Name))
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "From: %s\r\n", s.from)
+	fmt.Fprintf(&buf, "To: %s\r\n", s.to)
+	fmt.Fprintf(&buf, "Reply-To: %s\r\n", replyTo.String())
+	fmt.Fprintf(&buf, "Subject: %s\r\n", subject)
+	fmt.Fprintf(&buf, "Date: %s\r\n", now.Format(time.RFC1123Z))
+	buf.WriteString("MIME-Version: 1.0\r\n")
+	buf.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	buf.WriteString("\r\n")
+	buf.WriteString(strings.ReplaceAll(strings.ReplaceAll(message.Message, "\r\n", "\n"), "\n", "\r\n"))
+	buf.WriteString("\r\n")
+	return buf.Bytes()
+}
+
+// stripNewlines replaces line breaks with spaces
+func stripNewlines(s string) string {
+	return strings.NewReplacer("\r", " ", "\n", " ").Replace(s)
+}
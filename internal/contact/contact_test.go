@@ -0,0 +1,100 @@
+package contact
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"net/smtp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/npmulder/resume-api/internal/config"
+	"github.com/npmulder/resume-api/internal/models"
+)
+
+func testMessage() *models.ContactMessage {
+	return &models.ContactMessage{
+		ID:      7,
+		Name:    "Jane Doe",
+		Email:   "jane@example.com",
+		Message: "Hello,\nare you available?",
+	}
+}
+
+func TestNew(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	sender, err := New(&config.ContactConfig{Sender: "log"}, logger)
+	require.NoError(t, err)
+	assert.IsType(t, &LogSender{}, sender)
+
+	sender, err = New(&config.ContactConfig{Sender: "smtp", SMTPHost: "smtp.example.com", SMTPPort: 587}, logger)
+	require.NoError(t, err)
+	assert.IsType(t, &SMTPSender{}, sender)
+
+	_, err = New(&config.ContactConfig{Sender: "fax"}, logger)
+	assert.Error(t, err)
+}
+
+func TestLogSender(t *testing.T) {
+	var buf bytes.Buffer
+	sender := NewLogSender(slog.New(slog.NewTextHandler(&buf, nil)))
+
+	require.NoError(t, sender.Send(context.Background(), testMessage()))
+	assert.Contains(t, buf.String(), "jane@example.com")
+	assert.NotContains(t, buf.String(), "are you available")
+}
+
+func TestSMTPSender(t *testing.T) {
+	sender := NewSMTPSender(&config.ContactConfig{
+		SMTPHost:     "smtp.example.com",
+		SMTPPort:     587,
+		SMTPUsername: "relay",
+		SMTPPassword: "secret",
+		From:         "noreply@example.com",
+		To:           "me@example.com",
+	})
+
+	var gotAddr, gotFrom string
+	var gotTo []string
+	var gotMsg []byte
+	sender.sendMail = func(addr string, a smtp.Auth, from string, to []string, msg []byte) error {
+		gotAddr, gotFrom, gotTo, gotMsg = addr, from, to, msg
+		assert.NotNil(t, a)
+		return nil
+	}
+
+	require.NoError(t, sender.Send(context.Background(), testMessage()))
+	assert.Equal(t, "smtp.example.com:587", gotAddr)
+	assert.Equal(t, "noreply@example.com", gotFrom)
+	assert.Equal(t, []string{"me@example.com"}, gotTo)
+
+	msg := string(gotMsg)
+	assert.Contains(t, msg, "Reply-To: \"Jane Doe\" <jane@example.com>\r\n")
+	assert.Contains(t, msg, "Subject: Contact form: Jane Doe\r\n")
+	assert.True(t, strings.HasSuffix(msg, "\r\n\r\nHello,\r\nare you available?\r\n"))
+
+	sender.sendMail = func(string, smtp.Auth, string, []string, []byte) error {
+		return errors.New("connection refused")
+	}
+	assert.ErrorContains(t, sender.Send(context.Background(), testMessage()), "connection refused")
+}
+
+func TestSMTPSenderEscapesHeaders(t *testing.T) {
+	sender := NewSMTPSender(&config.ContactConfig{SMTPHost: "smtp.example.com", SMTPPort: 25, From: "a@example.com", To: "b@example.com"})
+	message := testMessage()
+	message.Name = "Mallory\r\nBcc: victim@example.com"
+
+	msg := string(sender.buildMessage(message, time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)))
+	headers, _, _ := strings.Cut(msg, "\r\n\r\n")
+	for _, line := range strings.Split(headers, "\r\n") {
+		assert.False(t, strings.HasPrefix(line, "Bcc:"), "header injected: %q", line)
+	}
+	assert.Contains(t, headers, "Date: Wed, 01 May 2024 12:00:00 +0000")
+}
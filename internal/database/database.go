@@ -5,12 +5,15 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"sync/atomic"
 	"time"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 
 	"github.com/npmulder/resume-api/internal/config"
+	"github.com/npmulder/resume-api/internal/middleware"
+	"github.com/npmulder/resume-api/internal/models"
 )
 
 // Define custom context key type to avoid collisions
@@ -18,68 +21,74 @@ type contextKey string
 
 const queryStartKey contextKey = "query_start"
 
+// slowQueryThreshold is the duration above which a query is logged as slow
+const slowQueryThreshold = 100 * time.Millisecond
+
 // DB wraps a pgx connection pool with additional functionality
 type DB struct {
-	pool   *TracedPool
-	config *config.DatabaseConfig
-	logger *slog.Logger
+	pool *TracedPool
+	// readPool connects to the read replica; nil when none is configured
+	readPool *TracedPool
+	config   *config.DatabaseConfig
+	logger   *slog.Logger
+}
+
+// Option is a function that configures a DB created by New
+type Option func(*queryTracer)
+
+// WithQueryLogSampleRate logs only 1 in n successful queries at debug level.
+// Failed and slow queries are always logged. Values below 2 log every query.
+func WithQueryLogSampleRate(n int) Option {
+	return func(t *queryTracer) {
+		t.sampleRate = n
+	}
 }
 
 // New creates a new database connection with the given configuration
-func New(ctx context.Context, cfg *config.DatabaseConfig, logger *slog.Logger) (*DB, error) {
+func New(ctx context.Context, cfg *config.DatabaseConfig, logger *slog.Logger, opts ...Option) (*DB, error) {
 	if logger == nil {
 		logger = slog.Default()
 	}
 
-	// Configure connection pool
-	poolConfig, err := pgxpool.ParseConfig(cfg.DatabaseURL())
-	if err != nil {
-		return nil, fmt.Errorf("failed to parse database URL: %w", err)
+	tracer := &queryTracer{logger: logger}
+	for _, opt := range opts {
+		opt(tracer)
 	}
 
-	// Set connection pool settings
-	poolConfig.MaxConns = int32(cfg.MaxConnections)
-	poolConfig.MinConns = 1 // Always keep at least one connection
-	poolConfig.MaxConnLifetime = cfg.ConnMaxLifetime
-	poolConfig.MaxConnIdleTime = cfg.ConnMaxIdleTime
-	poolConfig.HealthCheckPeriod = 1 * time.Minute
-
-	// Configure connection settings
-	poolConfig.ConnConfig.ConnectTimeout = 10 * time.Second
-	poolConfig.ConnConfig.RuntimeParams = map[string]string{
-		"application_name": "resume-api",
-	}
-
-	// Set up logging for database connections
-	poolConfig.ConnConfig.Tracer = &queryTracer{logger: logger}
-
 	logger.Info("Connecting to database",
 		slog.String("host", cfg.Host),
 		slog.Int("port", cfg.Port),
 		slog.String("database", cfg.Name),
 		slog.String("user", cfg.User),
 		slog.Int("max_connections", cfg.MaxConnections),
+		slog.Int("min_connections", cfg.MinConnections),
 		slog.Duration("max_lifetime", cfg.ConnMaxLifetime),
 	)
 
-	// Create connection pool
-	pool, err := pgxpool.NewWithConfig(ctx, poolConfig)
+	pool, err := newPool(ctx, cfg.DatabaseURL(), cfg, tracer)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create connection pool: %w", err)
+		return nil, err
 	}
 
-	// Wrap the pool with tracing
-	tracedPool := NewTracedPool(pool)
-
 	db := &DB{
-		pool:   tracedPool,
+		pool:   pool,
 		config: cfg,
 		logger: logger,
 	}
 
-	// Test the connection
+	if cfg.ReadReplicaURL != "" {
+		readPool, err := newPool(ctx, cfg.ReadReplicaURL, cfg, tracer)
+		if err != nil {
+			pool.Close()
+			return nil, fmt.Errorf("read replica: %w", err)
+		}
+		db.readPool = readPool
+		logger.Info("Read replica configured for read queries")
+	}
+
+	// Test the connections
 	if err := db.Ping(ctx); err != nil {
-		pool.Close()
+		db.Close()
 		return nil, fmt.Errorf("failed to ping database: %w", err)
 	}
 
@@ -87,23 +96,119 @@ func New(ctx context.Context, cfg *config.DatabaseConfig, logger *slog.Logger) (
 	return db, nil
 }
 
-// Pool returns the underlying pgx connection pool
+// defaultApplicationName is reported in pg_stat_activity when the config
+// doesn't name the application
+const defaultApplicationName = "resume-api"
+
+// newPool creates a traced connection pool for databaseURL using the pool
+// settings from cfg
+func newPool(ctx context.Context, databaseURL string, cfg *config.DatabaseConfig, tracer *queryTracer) (*TracedPool, error) {
+	poolConfig, err := newPoolConfig(databaseURL, cfg, tracer)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create connection pool
+	pool, err := pgxpool.NewWithConfig(ctx, poolConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create connection pool: %w", err)
+	}
+
+	// Wrap the pool with tracing
+	return NewTracedPool(pool), nil
+}
+
+// newPoolConfig builds the pool configuration for databaseURL from cfg
+func newPoolConfig(databaseURL string, cfg *config.DatabaseConfig, tracer *queryTracer) (*pgxpool.Config, error) {
+	// Configure connection pool
+	poolConfig, err := pgxpool.ParseConfig(databaseURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse database URL: %w", err)
+	}
+
+	// Set connection pool settings
+	poolConfig.MaxConns = int32(cfg.MaxConnections)
+	poolConfig.MinConns = int32(cfg.MinConnections)
+	poolConfig.MaxConnLifetime = cfg.ConnMaxLifetime
+	poolConfig.MaxConnIdleTime = cfg.ConnMaxIdleTime
+	poolConfig.HealthCheckPeriod = 1 * time.Minute
+
+	// Configure connection settings
+	poolConfig.ConnConfig.ConnectTimeout = 10 * time.Second
+	applicationName := cfg.ApplicationName
+	if applicationName == "" {
+		applicationName = defaultApplicationName
+	}
+	poolConfig.ConnConfig.RuntimeParams = map[string]string{
+		"application_name": applicationName,
+	}
+
+	poolConfig.ConnConfig.DefaultQueryExecMode = queryExecMode(cfg.StatementCacheMode)
+
+	// Set up logging for database connections
+	poolConfig.ConnConfig.Tracer = tracer
+
+	return poolConfig, nil
+}
+
+// queryExecMode maps a configured statement cache mode to the pgx query
+// execution mode. Unknown and empty modes keep the pgx default of caching
+// prepared statements.
+func queryExecMode(mode string) pgx.QueryExecMode {
+	switch mode {
+	case "describe":
+		return pgx.QueryExecModeCacheDescribe
+	case "disable":
+		return pgx.QueryExecModeDescribeExec
+	default:
+		return pgx.QueryExecModeCacheStatement
+	}
+}
+
+// Pool returns the underlying pgx connection pool of the primary database.
+// It is equivalent to WritePool.
 func (db *DB) Pool() *pgxpool.Pool {
+	return db.WritePool()
+}
+
+// WritePool returns the connection pool of the primary database, used for
+// writes and anything that must read its own writes
+func (db *DB) WritePool() *pgxpool.Pool {
 	return db.pool.Pool()
 }
 
-// Ping tests the database connection
+// ReadPool returns the connection pool of the read replica, or the primary
+// pool when no replica is configured
+func (db *DB) ReadPool() *pgxpool.Pool {
+	if db.readPool == nil {
+		return db.WritePool()
+	}
+	return db.readPool.Pool()
+}
+
+// Ping tests the database connection, including the read replica when configured
 func (db *DB) Ping(ctx context.Context) error {
 	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
 
-	return db.pool.Ping(ctx)
+	if err := db.pool.Ping(ctx); err != nil {
+		return err
+	}
+	if db.readPool != nil {
+		if err := db.readPool.Ping(ctx); err != nil {
+			return fmt.Errorf("read replica: %w", err)
+		}
+	}
+	return nil
 }
 
-// Close closes all connections in the pool
+// Close closes all connections in the pools
 func (db *DB) Close() {
 	db.logger.Info("Closing database connections")
 	db.pool.Close()
+	if db.readPool != nil {
+		db.readPool.Close()
+	}
 }
 
 // Stats returns connection pool statistics
@@ -171,23 +276,10 @@ func (db *DB) Health(ctx context.Context) (*HealthStatus, error) {
 }
 
 // HealthStatus represents the health status of the database
-type HealthStatus struct {
-	Status        string            `json:"status"`
-	Timestamp     time.Time         `json:"timestamp"`
-	ResponseTime  time.Duration     `json:"response_time"`
-	Version       string            `json:"version,omitempty"`
-	Connections   ConnectionStats   `json:"connections"`
-	Error         string            `json:"error,omitempty"`
-}
+type HealthStatus = models.DatabaseHealth
 
 // ConnectionStats represents connection pool statistics
-type ConnectionStats struct {
-	Total     int `json:"total"`
-	Idle      int `json:"idle"`
-	Used      int `json:"used"`
-	Maximum   int `json:"maximum"`
-	Acquiring int `json:"acquiring"`
-}
+type ConnectionStats = models.ConnectionStats
 
 // BeginTx starts a new transaction
 func (db *DB) BeginTx(ctx context.Context) (pgx.Tx, error) {
@@ -226,7 +318,9 @@ func (db *DB) WithTx(ctx context.Context, fn func(pgx.Tx) error) error {
 
 // queryTracer implements pgx.QueryTracer for logging database queries
 type queryTracer struct {
-	logger *slog.Logger
+	logger     *slog.Logger
+	sampleRate int
+	executed   atomic.Uint64
 }
 
 func (t *queryTracer) TraceQueryStart(ctx context.Context, conn *pgx.Conn, data pgx.TraceQueryStartData) context.Context {
@@ -241,27 +335,37 @@ func (t *queryTracer) TraceQueryEnd(ctx context.Context, conn *pgx.Conn, data pg
 		return // No start time available
 	}
 	duration := time.Since(startTime)
+	middleware.TrackDatabaseTime(ctx, duration)
 
 	if data.Err != nil {
 		t.logger.Error("Database query failed",
 			slog.Duration("duration", duration),
 			slog.String("error", data.Err.Error()),
 		)
-	} else if duration > 100*time.Millisecond {
+	} else if duration > slowQueryThreshold {
 		t.logger.Warn("Slow database query",
 			slog.Duration("duration", duration),
 		)
-	} else {
+	} else if t.sampled() {
 		t.logger.Debug("Database query executed",
 			slog.Duration("duration", duration),
 		)
 	}
 }
 
+// sampled reports whether the current successful query should be logged,
+// selecting every sampleRate-th query
+func (t *queryTracer) sampled() bool {
+	if t.sampleRate <= 1 {
+		return true
+	}
+	return (t.executed.Add(1)-1)%uint64(t.sampleRate) == 0
+}
+
 // MustNew creates a new database connection and panics if it fails
 // Use this in main.go where database failure should stop the application
-func MustNew(ctx context.Context, cfg *config.DatabaseConfig, logger *slog.Logger) *DB {
-	db, err := New(ctx, cfg, logger)
+func MustNew(ctx context.Context, cfg *config.DatabaseConfig, logger *slog.Logger, opts ...Option) *DB {
+	db, err := New(ctx, cfg, logger, opts...)
 	if err != nil {
 		panic(fmt.Sprintf("Failed to connect to database: %v", err))
 	}
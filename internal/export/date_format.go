@@ -0,0 +1,60 @@
+// Package export renders the resume into downloadable and printable formats.
+package export
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// DateFormatQueryParam is the query parameter exports read the date format from
+const DateFormatQueryParam = "date_format"
+
+// DefaultDateFormat is the ISO date layout used when no format is requested
+const DefaultDateFormat = "2006-01-02"
+
+// ErrUnknownDateFormat is returned for a date format outside the whitelist
+var ErrUnknownDateFormat = errors.New("unknown date format")
+
+// allowedDateFormats whitelists the Go time layouts clients may request
+var allowedDateFormats = map[string]struct{}{
+	DefaultDateFormat: {},
+	"2006-01":         {},
+	"01/2006":         {},
+	"Jan 2006":        {},
+	"January 2006":    {},
+}
+
+// DateFormatter formats resume dates with a whitelisted layout so every
+// export renderer presents dates the same way
+type DateFormatter struct {
+	layout string
+}
+
+// NewDateFormatter returns a formatter for the given layout. An empty layout
+// selects DefaultDateFormat; layouts outside the whitelist return ErrUnknownDateFormat.
+func NewDateFormatter(layout string) (DateFormatter, error) {
+	if layout == "" {
+		layout = DefaultDateFormat
+	}
+	if _, ok := allowedDateFormats[layout]; !ok {
+		return DateFormatter{}, fmt.Errorf("%w: %q", ErrUnknownDateFormat, layout)
+	}
+	return DateFormatter{layout: layout}, nil
+}
+
+// Layout returns the layout used by the formatter
+func (f DateFormatter) Layout() string {
+	if f.layout == "" {
+		return DefaultDateFormat
+	}
+	return f.layout
+}
+
+// Format formats an optional date, returning an empty string for nil
+func (f DateFormatter) Format(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format(f.Layout())
+}
@@ -0,0 +1,43 @@
+package export
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewDateFormatter(t *testing.T) {
+	date := time.Date(2023, time.March, 15, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		layout   string
+		expected string
+	}{
+		{layout: "", expected: "2023-03-15"},
+		{layout: "2006-01-02", expected: "2023-03-15"},
+		{layout: "2006-01", expected: "2023-03"},
+		{layout: "01/2006", expected: "03/2023"},
+		{layout: "Jan 2006", expected: "Mar 2023"},
+		{layout: "January 2006", expected: "March 2023"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.layout, func(t *testing.T) {
+			formatter, err := NewDateFormatter(tt.layout)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, formatter.Format(&date))
+		})
+	}
+
+	t.Run("unknown format", func(t *testing.T) {
+		_, err := NewDateFormatter("2006/01/02 15:04")
+		assert.ErrorIs(t, err, ErrUnknownDateFormat)
+	})
+
+	t.Run("nil date", func(t *testing.T) {
+		var formatter DateFormatter
+		assert.Equal(t, "", formatter.Format(nil))
+	})
+}
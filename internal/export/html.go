@@ -0,0 +1,48 @@
+package export
+
+import (
+	"embed"
+	"fmt"
+	"html/template"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/npmulder/resume-api/internal/models"
+)
+
+//go:embed templates/resume.html.tmpl
+var templateFS embed.FS
+
+// htmlTemplate is the print-ready HTML resume template
+var htmlTemplate = template.Must(template.New("resume.html.tmpl").Funcs(template.FuncMap{
+	// date is replaced per render with the requested DateFormatter
+	"date": func(interface{}) string { return "" },
+	"join": strings.Join,
+}).ParseFS(templateFS, "templates/resume.html.tmpl"))
+
+// RenderHTML renders the resume as a standalone, print-ready HTML document
+func RenderHTML(w io.Writer, resume *models.Resume, dates DateFormatter) error {
+	tmpl, err := htmlTemplate.Clone()
+	if err != nil {
+		return fmt.Errorf("failed to clone HTML template: %w", err)
+	}
+
+	tmpl.Funcs(template.FuncMap{
+		"date": func(v interface{}) string {
+			switch t := v.(type) {
+			case time.Time:
+				return dates.Format(&t)
+			case *time.Time:
+				return dates.Format(t)
+			default:
+				return ""
+			}
+		},
+	})
+
+	if err := tmpl.Execute(w, resume); err != nil {
+		return fmt.Errorf("failed to render HTML resume: %w", err)
+	}
+	return nil
+}
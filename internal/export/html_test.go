@@ -0,0 +1,46 @@
+package export
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/npmulder/resume-api/internal/models"
+)
+
+func testResume() *models.Resume {
+	summary := "Backend engineer <building> APIs"
+	level := "expert"
+	year := 2023
+	return &models.Resume{
+		Profile: &models.Profile{Name: "John Doe", Title: "Software Engineer", Email: "john@example.com", Summary: &summary},
+		Experiences: []*models.Experience{
+			{Company: "Acme", Position: "Senior Engineer", StartDate: time.Date(2021, time.February, 1, 0, 0, 0, 0, time.UTC), Highlights: []string{"Led the API rewrite"}},
+		},
+		Skills:       []*models.Skill{{Category: "Languages", Name: "Go", Level: &level}},
+		Achievements: []*models.Achievement{{Title: "Performance Optimization", YearAchieved: &year}},
+		Education:    []*models.Education{{Institution: "University of Example", DegreeOrCertification: "BSc Computer Science"}},
+		Projects:     []*models.Project{{Name: "Resume API", Technologies: []string{"Go", "PostgreSQL"}}},
+	}
+}
+
+func TestRenderHTML(t *testing.T) {
+	dates, err := NewDateFormatter("Jan 2006")
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, RenderHTML(&buf, testResume(), dates))
+	html := buf.String()
+
+	assert.Contains(t, html, "<h1>John Doe</h1>")
+	for _, section := range []string{"experience", "skills", "achievements", "education", "projects"} {
+		assert.Contains(t, html, `<section id="`+section+`">`)
+	}
+	assert.Contains(t, html, "Feb 2021 &ndash; Present")
+	assert.Contains(t, html, "Led the API rewrite")
+	assert.Contains(t, html, "Go, PostgreSQL")
+	assert.Contains(t, html, "Backend engineer &lt;building&gt; APIs")
+}
@@ -0,0 +1,32 @@
+// Package features toggles experimental endpoints through configuration
+package features
+
+import (
+	"github.com/gin-gonic/gin"
+)
+
+// Names of the feature flags gating experimental endpoints
+const (
+	// Batch enables POST /api/v1/batch
+	Batch = "batch"
+	// GraphQL enables the read-only POST /graphql
+	GraphQL = "graphql"
+)
+
+// Flags reports which features are enabled. Features missing from the map
+// are disabled.
+type Flags map[string]bool
+
+// Enabled returns whether the named feature is enabled
+func (f Flags) Enabled(name string) bool {
+	return f[name]
+}
+
+// Handle registers a route only when the named feature is enabled, so the
+// route of a disabled feature answers like any unknown path
+func (f Flags) Handle(routes gin.IRoutes, name, method, path string, handlers ...gin.HandlerFunc) {
+	if !f.Enabled(name) {
+		return
+	}
+	routes.Handle(method, path, handlers...)
+}
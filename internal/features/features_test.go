@@ -0,0 +1,50 @@
+package features
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlagsEnabled(t *testing.T) {
+	flags := Flags{"on": true, "off": false}
+
+	assert.True(t, flags.Enabled("on"))
+	assert.False(t, flags.Enabled("off"))
+	assert.False(t, flags.Enabled("unknown"))
+	assert.False(t, Flags(nil).Enabled("on"))
+}
+
+func TestFlagsHandle(t *testing.T) {
+	// Set Gin to test mode
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name       string
+		flags      Flags
+		wantStatus int
+	}{
+		{name: "flagged on", flags: Flags{Batch: true}, wantStatus: http.StatusOK},
+		{name: "flagged off", flags: Flags{Batch: false}, wantStatus: http.StatusNotFound},
+		{name: "not configured", flags: Flags{}, wantStatus: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := gin.New()
+			tt.flags.Handle(router.Group("/api/v1"), Batch, http.MethodPost, "/batch", func(c *gin.Context) {
+				c.Status(http.StatusOK)
+			})
+
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/batch", nil)
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+		})
+	}
+}
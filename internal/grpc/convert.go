@@ -0,0 +1,142 @@
+package grpc
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/npmulder/resume-api/internal/grpc/resumepb"
+	"github.com/npmulder/resume-api/internal/models"
+)
+
+func profileToProto(p *models.Profile) *resumepb.Profile {
+	return &resumepb.Profile{
+		Id:        int32(p.ID),
+		Name:      p.Name,
+		Title:     p.Title,
+		Email:     p.Email,
+		Phone:     p.Phone,
+		Location:  p.Location,
+		Linkedin:  p.LinkedIn,
+		Github:    p.GitHub,
+		Summary:   p.Summary,
+		CreatedAt: timestamppb.New(p.CreatedAt),
+		UpdatedAt: timestamppb.New(p.UpdatedAt),
+	}
+}
+
+func experienceToProto(e *models.Experience) *resumepb.Experience {
+	return &resumepb.Experience{
+		Id:          int32(e.ID),
+		Company:     e.Company,
+		Position:    e.Position,
+		StartDate:   e.StartDate.Format(dateLayout),
+		EndDate:     datePtr(e.EndDate),
+		Description: e.Description,
+		Highlights:  e.Highlights,
+		OrderIndex:  int32(e.OrderIndex),
+		IsCurrent:   e.IsCurrent,
+		Location:    e.Location,
+		CreatedAt:   timestamppb.New(e.CreatedAt),
+		UpdatedAt:   timestamppb.New(e.UpdatedAt),
+	}
+}
+
+func skillToProto(s *models.Skill) *resumepb.Skill {
+	return &resumepb.Skill{
+		Id:              int32(s.ID),
+		Category:        s.Category,
+		Name:            s.Name,
+		Level:           s.Level,
+		YearsExperience: int32Ptr(s.YearsExperience),
+		OrderIndex:      int32(s.OrderIndex),
+		IsFeatured:      s.IsFeatured,
+		Description:     s.Description,
+		CreatedAt:       timestamppb.New(s.CreatedAt),
+		UpdatedAt:       timestamppb.New(s.UpdatedAt),
+	}
+}
+
+func achievementToProto(a *models.Achievement) *resumepb.Achievement {
+	return &resumepb.Achievement{
+		Id:           int32(a.ID),
+		Title:        a.Title,
+		Description:  a.Description,
+		Category:     a.Category,
+		ImpactMetric: a.ImpactMetric,
+		YearAchieved: int32Ptr(a.YearAchieved),
+		OrderIndex:   int32(a.OrderIndex),
+		IsFeatured:   a.IsFeatured,
+		CreatedAt:    timestamppb.New(a.CreatedAt),
+		UpdatedAt:    timestamppb.New(a.UpdatedAt),
+	}
+}
+
+func educationToProto(e *models.Education) *resumepb.Education {
+	return &resumepb.Education{
+		Id:                    int32(e.ID),
+		Institution:           e.Institution,
+		DegreeOrCertification: e.DegreeOrCertification,
+		FieldOfStudy:          e.FieldOfStudy,
+		YearCompleted:         int32Ptr(e.YearCompleted),
+		YearStarted:           int32Ptr(e.YearStarted),
+		Description:           e.Description,
+		Type:                  e.Type,
+		Status:                e.Status,
+		CredentialId:          e.CredentialID,
+		CredentialUrl:         e.CredentialURL,
+		ExpiryDate:            datePtr(e.ExpiryDate),
+		OrderIndex:            int32(e.OrderIndex),
+		IsFeatured:            e.IsFeatured,
+		CreatedAt:             timestamppb.New(e.CreatedAt),
+		UpdatedAt:             timestamppb.New(e.UpdatedAt),
+	}
+}
+
+func projectToProto(p *models.Project) *resumepb.Project {
+	return &resumepb.Project{
+		Id:               int32(p.ID),
+		Name:             p.Name,
+		Slug:             p.Slug,
+		Description:      p.Description,
+		ShortDescription: p.ShortDescription,
+		Technologies:     p.Technologies,
+		GithubUrl:        p.GitHubURL,
+		DemoUrl:          p.DemoURL,
+		StartDate:        datePtr(p.StartDate),
+		EndDate:          datePtr(p.EndDate),
+		Status:           p.Status,
+		IsFeatured:       p.IsFeatured,
+		OrderIndex:       int32(p.OrderIndex),
+		KeyFeatures:      p.KeyFeatures,
+		CreatedAt:        timestamppb.New(p.CreatedAt),
+		UpdatedAt:        timestamppb.New(p.UpdatedAt),
+	}
+}
+
+// datePtr formats an optional date as YYYY-MM-DD
+func datePtr(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+	s := t.Format(dateLayout)
+	return &s
+}
+
+// int32Ptr converts an optional int to the proto representation
+func int32Ptr(v *int) *int32 {
+	if v == nil {
+		return nil
+	}
+	i := int32(*v)
+	return &i
+}
+
+// intPtr converts an optional proto int32 to an int filter value
+func intPtr(v *int32) *int {
+	if v == nil {
+		return nil
+	}
+	i := int(*v)
+	return &i
+}
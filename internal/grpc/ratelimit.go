@@ -0,0 +1,42 @@
+package grpc
+
+import (
+	"context"
+	"net"
+	"strconv"
+
+	googlegrpc "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/npmulder/resume-api/internal/middleware"
+)
+
+// RateLimitInterceptor returns a unary interceptor that limits calls per
+// client IP with limiter, the gRPC counterpart of the REST rate limiter.
+// Rejected calls fail with ResourceExhausted and a retry-after header giving
+// the seconds until the next call is allowed.
+func RateLimitInterceptor(limiter *middleware.RateLimiter) googlegrpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, _ *googlegrpc.UnaryServerInfo, handler googlegrpc.UnaryHandler) (interface{}, error) {
+		if ok, retryAfter := limiter.Allow(ctx, peerIP(ctx)); !ok {
+			_ = googlegrpc.SetHeader(ctx, metadata.Pairs("retry-after", strconv.Itoa(retryAfter)))
+			return nil, status.Error(codes.ResourceExhausted, "rate limit exceeded")
+		}
+		return handler(ctx, req)
+	}
+}
+
+// peerIP returns the IP of the client that made the call, or its full
+// address when it has no port, e.g. for in-memory connections
+func peerIP(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+	if host, _, err := net.SplitHostPort(p.Addr.String()); err == nil {
+		return host
+	}
+	return p.Addr.String()
+}
@@ -0,0 +1,43 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	googlegrpc "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/npmulder/resume-api/internal/grpc/resumepb"
+	"github.com/npmulder/resume-api/internal/middleware"
+	"github.com/npmulder/resume-api/internal/models"
+)
+
+func TestRateLimitInterceptor(t *testing.T) {
+	// Setup
+	limiter := middleware.NewRateLimiter(middleware.RateLimiterConfig{
+		RequestsPerSecond: 2,
+		BurstSize:         2,
+		TTL:               time.Hour,
+		Period:            time.Hour,
+	})
+	client := newTestClient(t, &fakeResumeService{profile: &models.Profile{ID: 1, Name: "John Doe"}},
+		WithServerOptions(googlegrpc.ChainUnaryInterceptor(RateLimitInterceptor(limiter))))
+	ctx := context.Background()
+
+	// Calls within the burst are served
+	for i := 0; i < 2; i++ {
+		_, err := client.GetProfile(ctx, &resumepb.GetProfileRequest{})
+		require.NoError(t, err)
+	}
+
+	// The next call is rejected until a token is added
+	var header metadata.MD
+	_, err := client.GetProfile(ctx, &resumepb.GetProfileRequest{}, googlegrpc.Header(&header))
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	assert.Equal(t, []string{"1800"}, header.Get("retry-after"))
+}
@@ -0,0 +1,1939 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: resume/v1/resume.proto
+
+package resumepb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetProfileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProfileRequest) Reset() {
+	*x = GetProfileRequest{}
+	mi := &file_resume_v1_resume_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProfileRequest) ProtoMessage() {}
+
+func (x *GetProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resume_v1_resume_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProfileRequest.ProtoReflect.Descriptor instead.
+func (*GetProfileRequest) Descriptor() ([]byte, []int) {
+	return file_resume_v1_resume_proto_rawDescGZIP(), []int{0}
+}
+
+// Profile is the resume owner's personal and contact information.
+type Profile struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Email         string                 `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
+	Phone         *string                `protobuf:"bytes,5,opt,name=phone,proto3,oneof" json:"phone,omitempty"`
+	Location      *string                `protobuf:"bytes,6,opt,name=location,proto3,oneof" json:"location,omitempty"`
+	Linkedin      *string                `protobuf:"bytes,7,opt,name=linkedin,proto3,oneof" json:"linkedin,omitempty"`
+	Github        *string                `protobuf:"bytes,8,opt,name=github,proto3,oneof" json:"github,omitempty"`
+	Summary       *string                `protobuf:"bytes,9,opt,name=summary,proto3,oneof" json:"summary,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Profile) Reset() {
+	*x = Profile{}
+	mi := &file_resume_v1_resume_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Profile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Profile) ProtoMessage() {}
+
+func (x *Profile) ProtoReflect() protoreflect.Message {
+	mi := &file_resume_v1_resume_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Profile.ProtoReflect.Descriptor instead.
+func (*Profile) Descriptor() ([]byte, []int) {
+	return file_resume_v1_resume_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Profile) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Profile) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Profile) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Profile) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *Profile) GetPhone() string {
+	if x != nil && x.Phone != nil {
+		return *x.Phone
+	}
+	return ""
+}
+
+func (x *Profile) GetLocation() string {
+	if x != nil && x.Location != nil {
+		return *x.Location
+	}
+	return ""
+}
+
+func (x *Profile) GetLinkedin() string {
+	if x != nil && x.Linkedin != nil {
+		return *x.Linkedin
+	}
+	return ""
+}
+
+func (x *Profile) GetGithub() string {
+	if x != nil && x.Github != nil {
+		return *x.Github
+	}
+	return ""
+}
+
+func (x *Profile) GetSummary() string {
+	if x != nil && x.Summary != nil {
+		return *x.Summary
+	}
+	return ""
+}
+
+func (x *Profile) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Profile) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// GetExperiencesRequest mirrors the query parameters of GET /api/v1/experiences.
+type GetExperiencesRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Company  string                 `protobuf:"bytes,1,opt,name=company,proto3" json:"company,omitempty"`
+	Position string                 `protobuf:"bytes,2,opt,name=position,proto3" json:"position,omitempty"`
+	// Inclusive bounds on the start date (YYYY-MM-DD).
+	DateFrom      *string `protobuf:"bytes,3,opt,name=date_from,json=dateFrom,proto3,oneof" json:"date_from,omitempty"`
+	DateTo        *string `protobuf:"bytes,4,opt,name=date_to,json=dateTo,proto3,oneof" json:"date_to,omitempty"`
+	IsCurrent     *bool   `protobuf:"varint,5,opt,name=is_current,json=isCurrent,proto3,oneof" json:"is_current,omitempty"`
+	Limit         int32   `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32   `protobuf:"varint,7,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetExperiencesRequest) Reset() {
+	*x = GetExperiencesRequest{}
+	mi := &file_resume_v1_resume_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetExperiencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExperiencesRequest) ProtoMessage() {}
+
+func (x *GetExperiencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resume_v1_resume_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetExperiencesRequest.ProtoReflect.Descriptor instead.
+func (*GetExperiencesRequest) Descriptor() ([]byte, []int) {
+	return file_resume_v1_resume_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetExperiencesRequest) GetCompany() string {
+	if x != nil {
+		return x.Company
+	}
+	return ""
+}
+
+func (x *GetExperiencesRequest) GetPosition() string {
+	if x != nil {
+		return x.Position
+	}
+	return ""
+}
+
+func (x *GetExperiencesRequest) GetDateFrom() string {
+	if x != nil && x.DateFrom != nil {
+		return *x.DateFrom
+	}
+	return ""
+}
+
+func (x *GetExperiencesRequest) GetDateTo() string {
+	if x != nil && x.DateTo != nil {
+		return *x.DateTo
+	}
+	return ""
+}
+
+func (x *GetExperiencesRequest) GetIsCurrent() bool {
+	if x != nil && x.IsCurrent != nil {
+		return *x.IsCurrent
+	}
+	return false
+}
+
+func (x *GetExperiencesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetExperiencesRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type GetExperiencesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Experiences   []*Experience          `protobuf:"bytes,1,rep,name=experiences,proto3" json:"experiences,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetExperiencesResponse) Reset() {
+	*x = GetExperiencesResponse{}
+	mi := &file_resume_v1_resume_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetExperiencesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExperiencesResponse) ProtoMessage() {}
+
+func (x *GetExperiencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_resume_v1_resume_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetExperiencesResponse.ProtoReflect.Descriptor instead.
+func (*GetExperiencesResponse) Descriptor() ([]byte, []int) {
+	return file_resume_v1_resume_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetExperiencesResponse) GetExperiences() []*Experience {
+	if x != nil {
+		return x.Experiences
+	}
+	return nil
+}
+
+// Experience is a position held at a company.
+type Experience struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Id       int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Company  string                 `protobuf:"bytes,2,opt,name=company,proto3" json:"company,omitempty"`
+	Position string                 `protobuf:"bytes,3,opt,name=position,proto3" json:"position,omitempty"`
+	// Dates are formatted as YYYY-MM-DD.
+	StartDate     string                 `protobuf:"bytes,4,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       *string                `protobuf:"bytes,5,opt,name=end_date,json=endDate,proto3,oneof" json:"end_date,omitempty"`
+	Description   *string                `protobuf:"bytes,6,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Highlights    []string               `protobuf:"bytes,7,rep,name=highlights,proto3" json:"highlights,omitempty"`
+	OrderIndex    int32                  `protobuf:"varint,8,opt,name=order_index,json=orderIndex,proto3" json:"order_index,omitempty"`
+	IsCurrent     bool                   `protobuf:"varint,9,opt,name=is_current,json=isCurrent,proto3" json:"is_current,omitempty"`
+	Location      *string                `protobuf:"bytes,10,opt,name=location,proto3,oneof" json:"location,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Experience) Reset() {
+	*x = Experience{}
+	mi := &file_resume_v1_resume_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Experience) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Experience) ProtoMessage() {}
+
+func (x *Experience) ProtoReflect() protoreflect.Message {
+	mi := &file_resume_v1_resume_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Experience.ProtoReflect.Descriptor instead.
+func (*Experience) Descriptor() ([]byte, []int) {
+	return file_resume_v1_resume_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Experience) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Experience) GetCompany() string {
+	if x != nil {
+		return x.Company
+	}
+	return ""
+}
+
+func (x *Experience) GetPosition() string {
+	if x != nil {
+		return x.Position
+	}
+	return ""
+}
+
+func (x *Experience) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *Experience) GetEndDate() string {
+	if x != nil && x.EndDate != nil {
+		return *x.EndDate
+	}
+	return ""
+}
+
+func (x *Experience) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *Experience) GetHighlights() []string {
+	if x != nil {
+		return x.Highlights
+	}
+	return nil
+}
+
+func (x *Experience) GetOrderIndex() int32 {
+	if x != nil {
+		return x.OrderIndex
+	}
+	return 0
+}
+
+func (x *Experience) GetIsCurrent() bool {
+	if x != nil {
+		return x.IsCurrent
+	}
+	return false
+}
+
+func (x *Experience) GetLocation() string {
+	if x != nil && x.Location != nil {
+		return *x.Location
+	}
+	return ""
+}
+
+func (x *Experience) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Experience) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// GetSkillsRequest mirrors the query parameters of GET /api/v1/skills.
+type GetSkillsRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Category string                 `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	Level    string                 `protobuf:"bytes,2,opt,name=level,proto3" json:"level,omitempty"`
+	Featured *bool                  `protobuf:"varint,3,opt,name=featured,proto3,oneof" json:"featured,omitempty"`
+	// 'recent' returns the most recent skills when none are featured.
+	Fallback      string `protobuf:"bytes,4,opt,name=fallback,proto3" json:"fallback,omitempty"`
+	Limit         int32  `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32  `protobuf:"varint,6,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSkillsRequest) Reset() {
+	*x = GetSkillsRequest{}
+	mi := &file_resume_v1_resume_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSkillsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSkillsRequest) ProtoMessage() {}
+
+func (x *GetSkillsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resume_v1_resume_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSkillsRequest.ProtoReflect.Descriptor instead.
+func (*GetSkillsRequest) Descriptor() ([]byte, []int) {
+	return file_resume_v1_resume_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetSkillsRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *GetSkillsRequest) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
+}
+
+func (x *GetSkillsRequest) GetFeatured() bool {
+	if x != nil && x.Featured != nil {
+		return *x.Featured
+	}
+	return false
+}
+
+func (x *GetSkillsRequest) GetFallback() string {
+	if x != nil {
+		return x.Fallback
+	}
+	return ""
+}
+
+func (x *GetSkillsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetSkillsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type GetSkillsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Skills        []*Skill               `protobuf:"bytes,1,rep,name=skills,proto3" json:"skills,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSkillsResponse) Reset() {
+	*x = GetSkillsResponse{}
+	mi := &file_resume_v1_resume_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSkillsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSkillsResponse) ProtoMessage() {}
+
+func (x *GetSkillsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_resume_v1_resume_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSkillsResponse.ProtoReflect.Descriptor instead.
+func (*GetSkillsResponse) Descriptor() ([]byte, []int) {
+	return file_resume_v1_resume_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetSkillsResponse) GetSkills() []*Skill {
+	if x != nil {
+		return x.Skills
+	}
+	return nil
+}
+
+// Skill is a technical or professional skill.
+type Skill struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Category        string                 `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"`
+	Name            string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Level           *string                `protobuf:"bytes,4,opt,name=level,proto3,oneof" json:"level,omitempty"`
+	YearsExperience *int32                 `protobuf:"varint,5,opt,name=years_experience,json=yearsExperience,proto3,oneof" json:"years_experience,omitempty"`
+	OrderIndex      int32                  `protobuf:"varint,6,opt,name=order_index,json=orderIndex,proto3" json:"order_index,omitempty"`
+	IsFeatured      bool                   `protobuf:"varint,7,opt,name=is_featured,json=isFeatured,proto3" json:"is_featured,omitempty"`
+	Description     *string                `protobuf:"bytes,8,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Skill) Reset() {
+	*x = Skill{}
+	mi := &file_resume_v1_resume_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Skill) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Skill) ProtoMessage() {}
+
+func (x *Skill) ProtoReflect() protoreflect.Message {
+	mi := &file_resume_v1_resume_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Skill.ProtoReflect.Descriptor instead.
+func (*Skill) Descriptor() ([]byte, []int) {
+	return file_resume_v1_resume_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Skill) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Skill) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *Skill) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Skill) GetLevel() string {
+	if x != nil && x.Level != nil {
+		return *x.Level
+	}
+	return ""
+}
+
+func (x *Skill) GetYearsExperience() int32 {
+	if x != nil && x.YearsExperience != nil {
+		return *x.YearsExperience
+	}
+	return 0
+}
+
+func (x *Skill) GetOrderIndex() int32 {
+	if x != nil {
+		return x.OrderIndex
+	}
+	return 0
+}
+
+func (x *Skill) GetIsFeatured() bool {
+	if x != nil {
+		return x.IsFeatured
+	}
+	return false
+}
+
+func (x *Skill) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *Skill) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Skill) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// GetAchievementsRequest mirrors the query parameters of GET /api/v1/achievements.
+type GetAchievementsRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Category string                 `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	Year     *int32                 `protobuf:"varint,2,opt,name=year,proto3,oneof" json:"year,omitempty"`
+	// Inclusive bounds on the year achieved.
+	YearFrom      *int32 `protobuf:"varint,3,opt,name=year_from,json=yearFrom,proto3,oneof" json:"year_from,omitempty"`
+	YearTo        *int32 `protobuf:"varint,4,opt,name=year_to,json=yearTo,proto3,oneof" json:"year_to,omitempty"`
+	Featured      *bool  `protobuf:"varint,5,opt,name=featured,proto3,oneof" json:"featured,omitempty"`
+	Fallback      string `protobuf:"bytes,6,opt,name=fallback,proto3" json:"fallback,omitempty"`
+	Limit         int32  `protobuf:"varint,7,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32  `protobuf:"varint,8,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAchievementsRequest) Reset() {
+	*x = GetAchievementsRequest{}
+	mi := &file_resume_v1_resume_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAchievementsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAchievementsRequest) ProtoMessage() {}
+
+func (x *GetAchievementsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resume_v1_resume_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAchievementsRequest.ProtoReflect.Descriptor instead.
+func (*GetAchievementsRequest) Descriptor() ([]byte, []int) {
+	return file_resume_v1_resume_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetAchievementsRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *GetAchievementsRequest) GetYear() int32 {
+	if x != nil && x.Year != nil {
+		return *x.Year
+	}
+	return 0
+}
+
+func (x *GetAchievementsRequest) GetYearFrom() int32 {
+	if x != nil && x.YearFrom != nil {
+		return *x.YearFrom
+	}
+	return 0
+}
+
+func (x *GetAchievementsRequest) GetYearTo() int32 {
+	if x != nil && x.YearTo != nil {
+		return *x.YearTo
+	}
+	return 0
+}
+
+func (x *GetAchievementsRequest) GetFeatured() bool {
+	if x != nil && x.Featured != nil {
+		return *x.Featured
+	}
+	return false
+}
+
+func (x *GetAchievementsRequest) GetFallback() string {
+	if x != nil {
+		return x.Fallback
+	}
+	return ""
+}
+
+func (x *GetAchievementsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetAchievementsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type GetAchievementsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Achievements  []*Achievement         `protobuf:"bytes,1,rep,name=achievements,proto3" json:"achievements,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAchievementsResponse) Reset() {
+	*x = GetAchievementsResponse{}
+	mi := &file_resume_v1_resume_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAchievementsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAchievementsResponse) ProtoMessage() {}
+
+func (x *GetAchievementsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_resume_v1_resume_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAchievementsResponse.ProtoReflect.Descriptor instead.
+func (*GetAchievementsResponse) Descriptor() ([]byte, []int) {
+	return file_resume_v1_resume_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetAchievementsResponse) GetAchievements() []*Achievement {
+	if x != nil {
+		return x.Achievements
+	}
+	return nil
+}
+
+// Achievement is a notable accomplishment.
+type Achievement struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description   *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Category      *string                `protobuf:"bytes,4,opt,name=category,proto3,oneof" json:"category,omitempty"`
+	ImpactMetric  *string                `protobuf:"bytes,5,opt,name=impact_metric,json=impactMetric,proto3,oneof" json:"impact_metric,omitempty"`
+	YearAchieved  *int32                 `protobuf:"varint,6,opt,name=year_achieved,json=yearAchieved,proto3,oneof" json:"year_achieved,omitempty"`
+	OrderIndex    int32                  `protobuf:"varint,7,opt,name=order_index,json=orderIndex,proto3" json:"order_index,omitempty"`
+	IsFeatured    bool                   `protobuf:"varint,8,opt,name=is_featured,json=isFeatured,proto3" json:"is_featured,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Achievement) Reset() {
+	*x = Achievement{}
+	mi := &file_resume_v1_resume_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Achievement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Achievement) ProtoMessage() {}
+
+func (x *Achievement) ProtoReflect() protoreflect.Message {
+	mi := &file_resume_v1_resume_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Achievement.ProtoReflect.Descriptor instead.
+func (*Achievement) Descriptor() ([]byte, []int) {
+	return file_resume_v1_resume_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *Achievement) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Achievement) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Achievement) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *Achievement) GetCategory() string {
+	if x != nil && x.Category != nil {
+		return *x.Category
+	}
+	return ""
+}
+
+func (x *Achievement) GetImpactMetric() string {
+	if x != nil && x.ImpactMetric != nil {
+		return *x.ImpactMetric
+	}
+	return ""
+}
+
+func (x *Achievement) GetYearAchieved() int32 {
+	if x != nil && x.YearAchieved != nil {
+		return *x.YearAchieved
+	}
+	return 0
+}
+
+func (x *Achievement) GetOrderIndex() int32 {
+	if x != nil {
+		return x.OrderIndex
+	}
+	return 0
+}
+
+func (x *Achievement) GetIsFeatured() bool {
+	if x != nil {
+		return x.IsFeatured
+	}
+	return false
+}
+
+func (x *Achievement) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Achievement) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// GetEducationRequest mirrors the query parameters of GET /api/v1/education.
+type GetEducationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 'education' or 'certification'.
+	Type          string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Institution   string `protobuf:"bytes,2,opt,name=institution,proto3" json:"institution,omitempty"`
+	Status        string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Featured      *bool  `protobuf:"varint,4,opt,name=featured,proto3,oneof" json:"featured,omitempty"`
+	Fallback      string `protobuf:"bytes,5,opt,name=fallback,proto3" json:"fallback,omitempty"`
+	Limit         int32  `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32  `protobuf:"varint,7,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEducationRequest) Reset() {
+	*x = GetEducationRequest{}
+	mi := &file_resume_v1_resume_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEducationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEducationRequest) ProtoMessage() {}
+
+func (x *GetEducationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resume_v1_resume_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEducationRequest.ProtoReflect.Descriptor instead.
+func (*GetEducationRequest) Descriptor() ([]byte, []int) {
+	return file_resume_v1_resume_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetEducationRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *GetEducationRequest) GetInstitution() string {
+	if x != nil {
+		return x.Institution
+	}
+	return ""
+}
+
+func (x *GetEducationRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *GetEducationRequest) GetFeatured() bool {
+	if x != nil && x.Featured != nil {
+		return *x.Featured
+	}
+	return false
+}
+
+func (x *GetEducationRequest) GetFallback() string {
+	if x != nil {
+		return x.Fallback
+	}
+	return ""
+}
+
+func (x *GetEducationRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetEducationRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type GetEducationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Education     []*Education           `protobuf:"bytes,1,rep,name=education,proto3" json:"education,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEducationResponse) Reset() {
+	*x = GetEducationResponse{}
+	mi := &file_resume_v1_resume_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEducationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEducationResponse) ProtoMessage() {}
+
+func (x *GetEducationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_resume_v1_resume_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEducationResponse.ProtoReflect.Descriptor instead.
+func (*GetEducationResponse) Descriptor() ([]byte, []int) {
+	return file_resume_v1_resume_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetEducationResponse) GetEducation() []*Education {
+	if x != nil {
+		return x.Education
+	}
+	return nil
+}
+
+// Education is a degree or certification.
+type Education struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Id                    int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Institution           string                 `protobuf:"bytes,2,opt,name=institution,proto3" json:"institution,omitempty"`
+	DegreeOrCertification string                 `protobuf:"bytes,3,opt,name=degree_or_certification,json=degreeOrCertification,proto3" json:"degree_or_certification,omitempty"`
+	FieldOfStudy          *string                `protobuf:"bytes,4,opt,name=field_of_study,json=fieldOfStudy,proto3,oneof" json:"field_of_study,omitempty"`
+	YearCompleted         *int32                 `protobuf:"varint,5,opt,name=year_completed,json=yearCompleted,proto3,oneof" json:"year_completed,omitempty"`
+	YearStarted           *int32                 `protobuf:"varint,6,opt,name=year_started,json=yearStarted,proto3,oneof" json:"year_started,omitempty"`
+	Description           *string                `protobuf:"bytes,7,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Type                  string                 `protobuf:"bytes,8,opt,name=type,proto3" json:"type,omitempty"`
+	Status                string                 `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"`
+	CredentialId          *string                `protobuf:"bytes,10,opt,name=credential_id,json=credentialId,proto3,oneof" json:"credential_id,omitempty"`
+	CredentialUrl         *string                `protobuf:"bytes,11,opt,name=credential_url,json=credentialUrl,proto3,oneof" json:"credential_url,omitempty"`
+	// Formatted as YYYY-MM-DD.
+	ExpiryDate    *string                `protobuf:"bytes,12,opt,name=expiry_date,json=expiryDate,proto3,oneof" json:"expiry_date,omitempty"`
+	OrderIndex    int32                  `protobuf:"varint,13,opt,name=order_index,json=orderIndex,proto3" json:"order_index,omitempty"`
+	IsFeatured    bool                   `protobuf:"varint,14,opt,name=is_featured,json=isFeatured,proto3" json:"is_featured,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Education) Reset() {
+	*x = Education{}
+	mi := &file_resume_v1_resume_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Education) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Education) ProtoMessage() {}
+
+func (x *Education) ProtoReflect() protoreflect.Message {
+	mi := &file_resume_v1_resume_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Education.ProtoReflect.Descriptor instead.
+func (*Education) Descriptor() ([]byte, []int) {
+	return file_resume_v1_resume_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *Education) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Education) GetInstitution() string {
+	if x != nil {
+		return x.Institution
+	}
+	return ""
+}
+
+func (x *Education) GetDegreeOrCertification() string {
+	if x != nil {
+		return x.DegreeOrCertification
+	}
+	return ""
+}
+
+func (x *Education) GetFieldOfStudy() string {
+	if x != nil && x.FieldOfStudy != nil {
+		return *x.FieldOfStudy
+	}
+	return ""
+}
+
+func (x *Education) GetYearCompleted() int32 {
+	if x != nil && x.YearCompleted != nil {
+		return *x.YearCompleted
+	}
+	return 0
+}
+
+func (x *Education) GetYearStarted() int32 {
+	if x != nil && x.YearStarted != nil {
+		return *x.YearStarted
+	}
+	return 0
+}
+
+func (x *Education) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *Education) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Education) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Education) GetCredentialId() string {
+	if x != nil && x.CredentialId != nil {
+		return *x.CredentialId
+	}
+	return ""
+}
+
+func (x *Education) GetCredentialUrl() string {
+	if x != nil && x.CredentialUrl != nil {
+		return *x.CredentialUrl
+	}
+	return ""
+}
+
+func (x *Education) GetExpiryDate() string {
+	if x != nil && x.ExpiryDate != nil {
+		return *x.ExpiryDate
+	}
+	return ""
+}
+
+func (x *Education) GetOrderIndex() int32 {
+	if x != nil {
+		return x.OrderIndex
+	}
+	return 0
+}
+
+func (x *Education) GetIsFeatured() bool {
+	if x != nil {
+		return x.IsFeatured
+	}
+	return false
+}
+
+func (x *Education) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Education) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// GetProjectsRequest mirrors the query parameters of GET /api/v1/projects.
+type GetProjectsRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Status     string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Technology string                 `protobuf:"bytes,2,opt,name=technology,proto3" json:"technology,omitempty"`
+	Featured   *bool                  `protobuf:"varint,3,opt,name=featured,proto3,oneof" json:"featured,omitempty"`
+	Ongoing    *bool                  `protobuf:"varint,4,opt,name=ongoing,proto3,oneof" json:"ongoing,omitempty"`
+	// Inclusive bounds on the start date (YYYY-MM-DD).
+	StartedAfter  *string `protobuf:"bytes,5,opt,name=started_after,json=startedAfter,proto3,oneof" json:"started_after,omitempty"`
+	StartedBefore *string `protobuf:"bytes,6,opt,name=started_before,json=startedBefore,proto3,oneof" json:"started_before,omitempty"`
+	ActiveDuring  *string `protobuf:"bytes,7,opt,name=active_during,json=activeDuring,proto3,oneof" json:"active_during,omitempty"`
+	Fallback      string  `protobuf:"bytes,8,opt,name=fallback,proto3" json:"fallback,omitempty"`
+	Limit         int32   `protobuf:"varint,9,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32   `protobuf:"varint,10,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProjectsRequest) Reset() {
+	*x = GetProjectsRequest{}
+	mi := &file_resume_v1_resume_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProjectsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProjectsRequest) ProtoMessage() {}
+
+func (x *GetProjectsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resume_v1_resume_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProjectsRequest.ProtoReflect.Descriptor instead.
+func (*GetProjectsRequest) Descriptor() ([]byte, []int) {
+	return file_resume_v1_resume_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetProjectsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *GetProjectsRequest) GetTechnology() string {
+	if x != nil {
+		return x.Technology
+	}
+	return ""
+}
+
+func (x *GetProjectsRequest) GetFeatured() bool {
+	if x != nil && x.Featured != nil {
+		return *x.Featured
+	}
+	return false
+}
+
+func (x *GetProjectsRequest) GetOngoing() bool {
+	if x != nil && x.Ongoing != nil {
+		return *x.Ongoing
+	}
+	return false
+}
+
+func (x *GetProjectsRequest) GetStartedAfter() string {
+	if x != nil && x.StartedAfter != nil {
+		return *x.StartedAfter
+	}
+	return ""
+}
+
+func (x *GetProjectsRequest) GetStartedBefore() string {
+	if x != nil && x.StartedBefore != nil {
+		return *x.StartedBefore
+	}
+	return ""
+}
+
+func (x *GetProjectsRequest) GetActiveDuring() string {
+	if x != nil && x.ActiveDuring != nil {
+		return *x.ActiveDuring
+	}
+	return ""
+}
+
+func (x *GetProjectsRequest) GetFallback() string {
+	if x != nil {
+		return x.Fallback
+	}
+	return ""
+}
+
+func (x *GetProjectsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetProjectsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type GetProjectsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Projects      []*Project             `protobuf:"bytes,1,rep,name=projects,proto3" json:"projects,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProjectsResponse) Reset() {
+	*x = GetProjectsResponse{}
+	mi := &file_resume_v1_resume_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProjectsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProjectsResponse) ProtoMessage() {}
+
+func (x *GetProjectsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_resume_v1_resume_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProjectsResponse.ProtoReflect.Descriptor instead.
+func (*GetProjectsResponse) Descriptor() ([]byte, []int) {
+	return file_resume_v1_resume_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetProjectsResponse) GetProjects() []*Project {
+	if x != nil {
+		return x.Projects
+	}
+	return nil
+}
+
+// Project is a personal or professional project.
+type Project struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name             string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Slug             string                 `protobuf:"bytes,3,opt,name=slug,proto3" json:"slug,omitempty"`
+	Description      *string                `protobuf:"bytes,4,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	ShortDescription *string                `protobuf:"bytes,5,opt,name=short_description,json=shortDescription,proto3,oneof" json:"short_description,omitempty"`
+	Technologies     []string               `protobuf:"bytes,6,rep,name=technologies,proto3" json:"technologies,omitempty"`
+	GithubUrl        *string                `protobuf:"bytes,7,opt,name=github_url,json=githubUrl,proto3,oneof" json:"github_url,omitempty"`
+	DemoUrl          *string                `protobuf:"bytes,8,opt,name=demo_url,json=demoUrl,proto3,oneof" json:"demo_url,omitempty"`
+	// Dates are formatted as YYYY-MM-DD.
+	StartDate     *string                `protobuf:"bytes,9,opt,name=start_date,json=startDate,proto3,oneof" json:"start_date,omitempty"`
+	EndDate       *string                `protobuf:"bytes,10,opt,name=end_date,json=endDate,proto3,oneof" json:"end_date,omitempty"`
+	Status        string                 `protobuf:"bytes,11,opt,name=status,proto3" json:"status,omitempty"`
+	IsFeatured    bool                   `protobuf:"varint,12,opt,name=is_featured,json=isFeatured,proto3" json:"is_featured,omitempty"`
+	OrderIndex    int32                  `protobuf:"varint,13,opt,name=order_index,json=orderIndex,proto3" json:"order_index,omitempty"`
+	KeyFeatures   []string               `protobuf:"bytes,14,rep,name=key_features,json=keyFeatures,proto3" json:"key_features,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Project) Reset() {
+	*x = Project{}
+	mi := &file_resume_v1_resume_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Project) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Project) ProtoMessage() {}
+
+func (x *Project) ProtoReflect() protoreflect.Message {
+	mi := &file_resume_v1_resume_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Project.ProtoReflect.Descriptor instead.
+func (*Project) Descriptor() ([]byte, []int) {
+	return file_resume_v1_resume_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *Project) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Project) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Project) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *Project) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *Project) GetShortDescription() string {
+	if x != nil && x.ShortDescription != nil {
+		return *x.ShortDescription
+	}
+	return ""
+}
+
+func (x *Project) GetTechnologies() []string {
+	if x != nil {
+		return x.Technologies
+	}
+	return nil
+}
+
+func (x *Project) GetGithubUrl() string {
+	if x != nil && x.GithubUrl != nil {
+		return *x.GithubUrl
+	}
+	return ""
+}
+
+func (x *Project) GetDemoUrl() string {
+	if x != nil && x.DemoUrl != nil {
+		return *x.DemoUrl
+	}
+	return ""
+}
+
+func (x *Project) GetStartDate() string {
+	if x != nil && x.StartDate != nil {
+		return *x.StartDate
+	}
+	return ""
+}
+
+func (x *Project) GetEndDate() string {
+	if x != nil && x.EndDate != nil {
+		return *x.EndDate
+	}
+	return ""
+}
+
+func (x *Project) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Project) GetIsFeatured() bool {
+	if x != nil {
+		return x.IsFeatured
+	}
+	return false
+}
+
+func (x *Project) GetOrderIndex() int32 {
+	if x != nil {
+		return x.OrderIndex
+	}
+	return 0
+}
+
+func (x *Project) GetKeyFeatures() []string {
+	if x != nil {
+		return x.KeyFeatures
+	}
+	return nil
+}
+
+func (x *Project) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Project) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+var File_resume_v1_resume_proto protoreflect.FileDescriptor
+
+const file_resume_v1_resume_proto_rawDesc = "" +
+	"\n" +
+	"\x16resume/v1/resume.proto\x12\tresume.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\x13\n" +
+	"\x11GetProfileRequest\"\xa3\x03\n" +
+	"\aProfile\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12\x14\n" +
+	"\x05email\x18\x04 \x01(\tR\x05email\x12\x19\n" +
+	"\x05phone\x18\x05 \x01(\tH\x00R\x05phone\x88\x01\x01\x12\x1f\n" +
+	"\blocation\x18\x06 \x01(\tH\x01R\blocation\x88\x01\x01\x12\x1f\n" +
+	"\blinkedin\x18\a \x01(\tH\x02R\blinkedin\x88\x01\x01\x12\x1b\n" +
+	"\x06github\x18\b \x01(\tH\x03R\x06github\x88\x01\x01\x12\x1d\n" +
+	"\asummary\x18\t \x01(\tH\x04R\asummary\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\b\n" +
+	"\x06_phoneB\v\n" +
+	"\t_locationB\v\n" +
+	"\t_linkedinB\t\n" +
+	"\a_githubB\n" +
+	"\n" +
+	"\b_summary\"\x88\x02\n" +
+	"\x15GetExperiencesRequest\x12\x18\n" +
+	"\acompany\x18\x01 \x01(\tR\acompany\x12\x1a\n" +
+	"\bposition\x18\x02 \x01(\tR\bposition\x12 \n" +
+	"\tdate_from\x18\x03 \x01(\tH\x00R\bdateFrom\x88\x01\x01\x12\x1c\n" +
+	"\adate_to\x18\x04 \x01(\tH\x01R\x06dateTo\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"is_current\x18\x05 \x01(\bH\x02R\tisCurrent\x88\x01\x01\x12\x14\n" +
+	"\x05limit\x18\x06 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\a \x01(\x05R\x06offsetB\f\n" +
+	"\n" +
+	"_date_fromB\n" +
+	"\n" +
+	"\b_date_toB\r\n" +
+	"\v_is_current\"Q\n" +
+	"\x16GetExperiencesResponse\x127\n" +
+	"\vexperiences\x18\x01 \x03(\v2\x15.resume.v1.ExperienceR\vexperiences\"\xd9\x03\n" +
+	"\n" +
+	"Experience\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x18\n" +
+	"\acompany\x18\x02 \x01(\tR\acompany\x12\x1a\n" +
+	"\bposition\x18\x03 \x01(\tR\bposition\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x04 \x01(\tR\tstartDate\x12\x1e\n" +
+	"\bend_date\x18\x05 \x01(\tH\x00R\aendDate\x88\x01\x01\x12%\n" +
+	"\vdescription\x18\x06 \x01(\tH\x01R\vdescription\x88\x01\x01\x12\x1e\n" +
+	"\n" +
+	"highlights\x18\a \x03(\tR\n" +
+	"highlights\x12\x1f\n" +
+	"\vorder_index\x18\b \x01(\x05R\n" +
+	"orderIndex\x12\x1d\n" +
+	"\n" +
+	"is_current\x18\t \x01(\bR\tisCurrent\x12\x1f\n" +
+	"\blocation\x18\n" +
+	" \x01(\tH\x02R\blocation\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\v\n" +
+	"\t_end_dateB\x0e\n" +
+	"\f_descriptionB\v\n" +
+	"\t_location\"\xbc\x01\n" +
+	"\x10GetSkillsRequest\x12\x1a\n" +
+	"\bcategory\x18\x01 \x01(\tR\bcategory\x12\x14\n" +
+	"\x05level\x18\x02 \x01(\tR\x05level\x12\x1f\n" +
+	"\bfeatured\x18\x03 \x01(\bH\x00R\bfeatured\x88\x01\x01\x12\x1a\n" +
+	"\bfallback\x18\x04 \x01(\tR\bfallback\x12\x14\n" +
+	"\x05limit\x18\x05 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x06 \x01(\x05R\x06offsetB\v\n" +
+	"\t_featured\"=\n" +
+	"\x11GetSkillsResponse\x12(\n" +
+	"\x06skills\x18\x01 \x03(\v2\x10.resume.v1.SkillR\x06skills\"\xa0\x03\n" +
+	"\x05Skill\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1a\n" +
+	"\bcategory\x18\x02 \x01(\tR\bcategory\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x19\n" +
+	"\x05level\x18\x04 \x01(\tH\x00R\x05level\x88\x01\x01\x12.\n" +
+	"\x10years_experience\x18\x05 \x01(\x05H\x01R\x0fyearsExperience\x88\x01\x01\x12\x1f\n" +
+	"\vorder_index\x18\x06 \x01(\x05R\n" +
+	"orderIndex\x12\x1f\n" +
+	"\vis_featured\x18\a \x01(\bR\n" +
+	"isFeatured\x12%\n" +
+	"\vdescription\x18\b \x01(\tH\x02R\vdescription\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\b\n" +
+	"\x06_levelB\x13\n" +
+	"\x11_years_experienceB\x0e\n" +
+	"\f_description\"\xa8\x02\n" +
+	"\x16GetAchievementsRequest\x12\x1a\n" +
+	"\bcategory\x18\x01 \x01(\tR\bcategory\x12\x17\n" +
+	"\x04year\x18\x02 \x01(\x05H\x00R\x04year\x88\x01\x01\x12 \n" +
+	"\tyear_from\x18\x03 \x01(\x05H\x01R\byearFrom\x88\x01\x01\x12\x1c\n" +
+	"\ayear_to\x18\x04 \x01(\x05H\x02R\x06yearTo\x88\x01\x01\x12\x1f\n" +
+	"\bfeatured\x18\x05 \x01(\bH\x03R\bfeatured\x88\x01\x01\x12\x1a\n" +
+	"\bfallback\x18\x06 \x01(\tR\bfallback\x12\x14\n" +
+	"\x05limit\x18\a \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\b \x01(\x05R\x06offsetB\a\n" +
+	"\x05_yearB\f\n" +
+	"\n" +
+	"_year_fromB\n" +
+	"\n" +
+	"\b_year_toB\v\n" +
+	"\t_featured\"U\n" +
+	"\x17GetAchievementsResponse\x12:\n" +
+	"\fachievements\x18\x01 \x03(\v2\x16.resume.v1.AchievementR\fachievements\"\xc8\x03\n" +
+	"\vAchievement\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12%\n" +
+	"\vdescription\x18\x03 \x01(\tH\x00R\vdescription\x88\x01\x01\x12\x1f\n" +
+	"\bcategory\x18\x04 \x01(\tH\x01R\bcategory\x88\x01\x01\x12(\n" +
+	"\rimpact_metric\x18\x05 \x01(\tH\x02R\fimpactMetric\x88\x01\x01\x12(\n" +
+	"\ryear_achieved\x18\x06 \x01(\x05H\x03R\fyearAchieved\x88\x01\x01\x12\x1f\n" +
+	"\vorder_index\x18\a \x01(\x05R\n" +
+	"orderIndex\x12\x1f\n" +
+	"\vis_featured\x18\b \x01(\bR\n" +
+	"isFeatured\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\x0e\n" +
+	"\f_descriptionB\v\n" +
+	"\t_categoryB\x10\n" +
+	"\x0e_impact_metricB\x10\n" +
+	"\x0e_year_achieved\"\xdb\x01\n" +
+	"\x13GetEducationRequest\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12 \n" +
+	"\vinstitution\x18\x02 \x01(\tR\vinstitution\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x1f\n" +
+	"\bfeatured\x18\x04 \x01(\bH\x00R\bfeatured\x88\x01\x01\x12\x1a\n" +
+	"\bfallback\x18\x05 \x01(\tR\bfallback\x12\x14\n" +
+	"\x05limit\x18\x06 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\a \x01(\x05R\x06offsetB\v\n" +
+	"\t_featured\"J\n" +
+	"\x14GetEducationResponse\x122\n" +
+	"\teducation\x18\x01 \x03(\v2\x14.resume.v1.EducationR\teducation\"\xf7\x05\n" +
+	"\tEducation\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12 \n" +
+	"\vinstitution\x18\x02 \x01(\tR\vinstitution\x126\n" +
+	"\x17degree_or_certification\x18\x03 \x01(\tR\x15degreeOrCertification\x12)\n" +
+	"\x0efield_of_study\x18\x04 \x01(\tH\x00R\ffieldOfStudy\x88\x01\x01\x12*\n" +
+	"\x0eyear_completed\x18\x05 \x01(\x05H\x01R\ryearCompleted\x88\x01\x01\x12&\n" +
+	"\fyear_started\x18\x06 \x01(\x05H\x02R\vyearStarted\x88\x01\x01\x12%\n" +
+	"\vdescription\x18\a \x01(\tH\x03R\vdescription\x88\x01\x01\x12\x12\n" +
+	"\x04type\x18\b \x01(\tR\x04type\x12\x16\n" +
+	"\x06status\x18\t \x01(\tR\x06status\x12(\n" +
+	"\rcredential_id\x18\n" +
+	" \x01(\tH\x04R\fcredentialId\x88\x01\x01\x12*\n" +
+	"\x0ecredential_url\x18\v \x01(\tH\x05R\rcredentialUrl\x88\x01\x01\x12$\n" +
+	"\vexpiry_date\x18\f \x01(\tH\x06R\n" +
+	"expiryDate\x88\x01\x01\x12\x1f\n" +
+	"\vorder_index\x18\r \x01(\x05R\n" +
+	"orderIndex\x12\x1f\n" +
+	"\vis_featured\x18\x0e \x01(\bR\n" +
+	"isFeatured\x129\n" +
+	"\n" +
+	"created_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\x11\n" +
+	"\x0f_field_of_studyB\x11\n" +
+	"\x0f_year_completedB\x0f\n" +
+	"\r_year_startedB\x0e\n" +
+	"\f_descriptionB\x10\n" +
+	"\x0e_credential_idB\x11\n" +
+	"\x0f_credential_urlB\x0e\n" +
+	"\f_expiry_date\"\xa6\x03\n" +
+	"\x12GetProjectsRequest\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x1e\n" +
+	"\n" +
+	"technology\x18\x02 \x01(\tR\n" +
+	"technology\x12\x1f\n" +
+	"\bfeatured\x18\x03 \x01(\bH\x00R\bfeatured\x88\x01\x01\x12\x1d\n" +
+	"\aongoing\x18\x04 \x01(\bH\x01R\aongoing\x88\x01\x01\x12(\n" +
+	"\rstarted_after\x18\x05 \x01(\tH\x02R\fstartedAfter\x88\x01\x01\x12*\n" +
+	"\x0estarted_before\x18\x06 \x01(\tH\x03R\rstartedBefore\x88\x01\x01\x12(\n" +
+	"\ractive_during\x18\a \x01(\tH\x04R\factiveDuring\x88\x01\x01\x12\x1a\n" +
+	"\bfallback\x18\b \x01(\tR\bfallback\x12\x14\n" +
+	"\x05limit\x18\t \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\n" +
+	" \x01(\x05R\x06offsetB\v\n" +
+	"\t_featuredB\n" +
+	"\n" +
+	"\b_ongoingB\x10\n" +
+	"\x0e_started_afterB\x11\n" +
+	"\x0f_started_beforeB\x10\n" +
+	"\x0e_active_during\"E\n" +
+	"\x13GetProjectsResponse\x12.\n" +
+	"\bprojects\x18\x01 \x03(\v2\x12.resume.v1.ProjectR\bprojects\"\x97\x05\n" +
+	"\aProject\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04slug\x18\x03 \x01(\tR\x04slug\x12%\n" +
+	"\vdescription\x18\x04 \x01(\tH\x00R\vdescription\x88\x01\x01\x120\n" +
+	"\x11short_description\x18\x05 \x01(\tH\x01R\x10shortDescription\x88\x01\x01\x12\"\n" +
+	"\ftechnologies\x18\x06 \x03(\tR\ftechnologies\x12\"\n" +
+	"\n" +
+	"github_url\x18\a \x01(\tH\x02R\tgithubUrl\x88\x01\x01\x12\x1e\n" +
+	"\bdemo_url\x18\b \x01(\tH\x03R\ademoUrl\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"start_date\x18\t \x01(\tH\x04R\tstartDate\x88\x01\x01\x12\x1e\n" +
+	"\bend_date\x18\n" +
+	" \x01(\tH\x05R\aendDate\x88\x01\x01\x12\x16\n" +
+	"\x06status\x18\v \x01(\tR\x06status\x12\x1f\n" +
+	"\vis_featured\x18\f \x01(\bR\n" +
+	"isFeatured\x12\x1f\n" +
+	"\vorder_index\x18\r \x01(\x05R\n" +
+	"orderIndex\x12!\n" +
+	"\fkey_features\x18\x0e \x03(\tR\vkeyFeatures\x129\n" +
+	"\n" +
+	"created_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\x0e\n" +
+	"\f_descriptionB\x14\n" +
+	"\x12_short_descriptionB\r\n" +
+	"\v_github_urlB\v\n" +
+	"\t_demo_urlB\r\n" +
+	"\v_start_dateB\v\n" +
+	"\t_end_date2\xe7\x03\n" +
+	"\rResumeService\x12>\n" +
+	"\n" +
+	"GetProfile\x12\x1c.resume.v1.GetProfileRequest\x1a\x12.resume.v1.Profile\x12U\n" +
+	"\x0eGetExperiences\x12 .resume.v1.GetExperiencesRequest\x1a!.resume.v1.GetExperiencesResponse\x12F\n" +
+	"\tGetSkills\x12\x1b.resume.v1.GetSkillsRequest\x1a\x1c.resume.v1.GetSkillsResponse\x12X\n" +
+	"\x0fGetAchievements\x12!.resume.v1.GetAchievementsRequest\x1a\".resume.v1.GetAchievementsResponse\x12O\n" +
+	"\fGetEducation\x12\x1e.resume.v1.GetEducationRequest\x1a\x1f.resume.v1.GetEducationResponse\x12L\n" +
+	"\vGetProjects\x12\x1d.resume.v1.GetProjectsRequest\x1a\x1e.resume.v1.GetProjectsResponseB7Z5github.com/npmulder/resume-api/internal/grpc/resumepbb\x06proto3"
+
+var (
+	file_resume_v1_resume_proto_rawDescOnce sync.Once
+	file_resume_v1_resume_proto_rawDescData []byte
+)
+
+func file_resume_v1_resume_proto_rawDescGZIP() []byte {
+	file_resume_v1_resume_proto_rawDescOnce.Do(func() {
+		file_resume_v1_resume_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_resume_v1_resume_proto_rawDesc), len(file_resume_v1_resume_proto_rawDesc)))
+	})
+	return file_resume_v1_resume_proto_rawDescData
+}
+
+var file_resume_v1_resume_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_resume_v1_resume_proto_goTypes = []any{
+	(*GetProfileRequest)(nil),       // 0: resume.v1.GetProfileRequest
+	(*Profile)(nil),                 // 1: resume.v1.Profile
+	(*GetExperiencesRequest)(nil),   // 2: resume.v1.GetExperiencesRequest
+	(*GetExperiencesResponse)(nil),  // 3: resume.v1.GetExperiencesResponse
+	(*Experience)(nil),              // 4: resume.v1.Experience
+	(*GetSkillsRequest)(nil),        // 5: resume.v1.GetSkillsRequest
+	(*GetSkillsResponse)(nil),       // 6: resume.v1.GetSkillsResponse
+	(*Skill)(nil),                   // 7: resume.v1.Skill
+	(*GetAchievementsRequest)(nil),  // 8: resume.v1.GetAchievementsRequest
+	(*GetAchievementsResponse)(nil), // 9: resume.v1.GetAchievementsResponse
+	(*Achievement)(nil),             // 10: resume.v1.Achievement
+	(*GetEducationRequest)(nil),     // 11: resume.v1.GetEducationRequest
+	(*GetEducationResponse)(nil),    // 12: resume.v1.GetEducationResponse
+	(*Education)(nil),               // 13: resume.v1.Education
+	(*GetProjectsRequest)(nil),      // 14: resume.v1.GetProjectsRequest
+	(*GetProjectsResponse)(nil),     // 15: resume.v1.GetProjectsResponse
+	(*Project)(nil),                 // 16: resume.v1.Project
+	(*timestamppb.Timestamp)(nil),   // 17: google.protobuf.Timestamp
+}
+var file_resume_v1_resume_proto_depIdxs = []int32{
+	17, // 0: resume.v1.Profile.created_at:type_name -> google.protobuf.Timestamp
+	17, // 1: resume.v1.Profile.updated_at:type_name -> google.protobuf.Timestamp
+	4,  // 2: resume.v1.GetExperiencesResponse.experiences:type_name -> resume.v1.Experience
+	17, // 3: resume.v1.Experience.created_at:type_name -> google.protobuf.Timestamp
+	17, // 4: resume.v1.Experience.updated_at:type_name -> google.protobuf.Timestamp
+	7,  // 5: resume.v1.GetSkillsResponse.skills:type_name -> resume.v1.Skill
+	17, // 6: resume.v1.Skill.created_at:type_name -> google.protobuf.Timestamp
+	17, // 7: resume.v1.Skill.updated_at:type_name -> google.protobuf.Timestamp
+	10, // 8: resume.v1.GetAchievementsResponse.achievements:type_name -> resume.v1.Achievement
+	17, // 9: resume.v1.Achievement.created_at:type_name -> google.protobuf.Timestamp
+	17, // 10: resume.v1.Achievement.updated_at:type_name -> google.protobuf.Timestamp
+	13, // 11: resume.v1.GetEducationResponse.education:type_name -> resume.v1.Education
+	17, // 12: resume.v1.Education.created_at:type_name -> google.protobuf.Timestamp
+	17, // 13: resume.v1.Education.updated_at:type_name -> google.protobuf.Timestamp
+	16, // 14: resume.v1.GetProjectsResponse.projects:type_name -> resume.v1.Project
+	17, // 15: resume.v1.Project.created_at:type_name -> google.protobuf.Timestamp
+	17, // 16: resume.v1.Project.updated_at:type_name -> google.protobuf.Timestamp
+	0,  // 17: resume.v1.ResumeService.GetProfile:input_type -> resume.v1.GetProfileRequest
+	2,  // 18: resume.v1.ResumeService.GetExperiences:input_type -> resume.v1.GetExperiencesRequest
+	5,  // 19: resume.v1.ResumeService.GetSkills:input_type -> resume.v1.GetSkillsRequest
+	8,  // 20: resume.v1.ResumeService.GetAchievements:input_type -> resume.v1.GetAchievementsRequest
+	11, // 21: resume.v1.ResumeService.GetEducation:input_type -> resume.v1.GetEducationRequest
+	14, // 22: resume.v1.ResumeService.GetProjects:input_type -> resume.v1.GetProjectsRequest
+	1,  // 23: resume.v1.ResumeService.GetProfile:output_type -> resume.v1.Profile
+	3,  // 24: resume.v1.ResumeService.GetExperiences:output_type -> resume.v1.GetExperiencesResponse
+	6,  // 25: resume.v1.ResumeService.GetSkills:output_type -> resume.v1.GetSkillsResponse
+	9,  // 26: resume.v1.ResumeService.GetAchievements:output_type -> resume.v1.GetAchievementsResponse
+	12, // 27: resume.v1.ResumeService.GetEducation:output_type -> resume.v1.GetEducationResponse
+	15, // 28: resume.v1.ResumeService.GetProjects:output_type -> resume.v1.GetProjectsResponse
+	23, // [23:29] is the sub-list for method output_type
+	17, // [17:23] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
+}
+
+func init() { file_resume_v1_resume_proto_init() }
+func file_resume_v1_resume_proto_init() {
+	if File_resume_v1_resume_proto != nil {
+		return
+	}
+	file_resume_v1_resume_proto_msgTypes[1].OneofWrappers = []any{}
+	file_resume_v1_resume_proto_msgTypes[2].OneofWrappers = []any{}
+	file_resume_v1_resume_proto_msgTypes[4].OneofWrappers = []any{}
+	file_resume_v1_resume_proto_msgTypes[5].OneofWrappers = []any{}
+	file_resume_v1_resume_proto_msgTypes[7].OneofWrappers = []any{}
+	file_resume_v1_resume_proto_msgTypes[8].OneofWrappers = []any{}
+	file_resume_v1_resume_proto_msgTypes[10].OneofWrappers = []any{}
+	file_resume_v1_resume_proto_msgTypes[11].OneofWrappers = []any{}
+	file_resume_v1_resume_proto_msgTypes[13].OneofWrappers = []any{}
+	file_resume_v1_resume_proto_msgTypes[14].OneofWrappers = []any{}
+	file_resume_v1_resume_proto_msgTypes[16].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_resume_v1_resume_proto_rawDesc), len(file_resume_v1_resume_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   17,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_resume_v1_resume_proto_goTypes,
+		DependencyIndexes: file_resume_v1_resume_proto_depIdxs,
+		MessageInfos:      file_resume_v1_resume_proto_msgTypes,
+	}.Build()
+	File_resume_v1_resume_proto = out.File
+	file_resume_v1_resume_proto_goTypes = nil
+	file_resume_v1_resume_proto_depIdxs = nil
+}
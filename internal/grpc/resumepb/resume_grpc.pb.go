@@ -0,0 +1,329 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: resume/v1/resume.proto
+
+package resumepb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ResumeService_GetProfile_FullMethodName      = "/resume.v1.ResumeService/GetProfile"
+	ResumeService_GetExperiences_FullMethodName  = "/resume.v1.ResumeService/GetExperiences"
+	ResumeService_GetSkills_FullMethodName       = "/resume.v1.ResumeService/GetSkills"
+	ResumeService_GetAchievements_FullMethodName = "/resume.v1.ResumeService/GetAchievements"
+	ResumeService_GetEducation_FullMethodName    = "/resume.v1.ResumeService/GetEducation"
+	ResumeService_GetProjects_FullMethodName     = "/resume.v1.ResumeService/GetProjects"
+)
+
+// ResumeServiceClient is the client API for ResumeService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// ResumeService exposes the read operations of the REST API.
+// Filters behave exactly like the query parameters of the matching REST routes.
+type ResumeServiceClient interface {
+	// GetProfile returns the resume owner's profile.
+	GetProfile(ctx context.Context, in *GetProfileRequest, opts ...grpc.CallOption) (*Profile, error)
+	// GetExperiences lists work experiences.
+	GetExperiences(ctx context.Context, in *GetExperiencesRequest, opts ...grpc.CallOption) (*GetExperiencesResponse, error)
+	// GetSkills lists skills.
+	GetSkills(ctx context.Context, in *GetSkillsRequest, opts ...grpc.CallOption) (*GetSkillsResponse, error)
+	// GetAchievements lists achievements.
+	GetAchievements(ctx context.Context, in *GetAchievementsRequest, opts ...grpc.CallOption) (*GetAchievementsResponse, error)
+	// GetEducation lists education and certifications.
+	GetEducation(ctx context.Context, in *GetEducationRequest, opts ...grpc.CallOption) (*GetEducationResponse, error)
+	// GetProjects lists projects.
+	GetProjects(ctx context.Context, in *GetProjectsRequest, opts ...grpc.CallOption) (*GetProjectsResponse, error)
+}
+
+type resumeServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewResumeServiceClient(cc grpc.ClientConnInterface) ResumeServiceClient {
+	return &resumeServiceClient{cc}
+}
+
+func (c *resumeServiceClient) GetProfile(ctx context.Context, in *GetProfileRequest, opts ...grpc.CallOption) (*Profile, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Profile)
+	err := c.cc.Invoke(ctx, ResumeService_GetProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resumeServiceClient) GetExperiences(ctx context.Context, in *GetExperiencesRequest, opts ...grpc.CallOption) (*GetExperiencesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetExperiencesResponse)
+	err := c.cc.Invoke(ctx, ResumeService_GetExperiences_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resumeServiceClient) GetSkills(ctx context.Context, in *GetSkillsRequest, opts ...grpc.CallOption) (*GetSkillsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSkillsResponse)
+	err := c.cc.Invoke(ctx, ResumeService_GetSkills_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resumeServiceClient) GetAchievements(ctx context.Context, in *GetAchievementsRequest, opts ...grpc.CallOption) (*GetAchievementsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAchievementsResponse)
+	err := c.cc.Invoke(ctx, ResumeService_GetAchievements_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resumeServiceClient) GetEducation(ctx context.Context, in *GetEducationRequest, opts ...grpc.CallOption) (*GetEducationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetEducationResponse)
+	err := c.cc.Invoke(ctx, ResumeService_GetEducation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resumeServiceClient) GetProjects(ctx context.Context, in *GetProjectsRequest, opts ...grpc.CallOption) (*GetProjectsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetProjectsResponse)
+	err := c.cc.Invoke(ctx, ResumeService_GetProjects_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ResumeServiceServer is the server API for ResumeService service.
+// All implementations must embed UnimplementedResumeServiceServer
+// for forward compatibility.
+//
+// ResumeService exposes the read operations of the REST API.
+// Filters behave exactly like the query parameters of the matching REST routes.
+type ResumeServiceServer interface {
+	// GetProfile returns the resume owner's profile.
+	GetProfile(context.Context, *GetProfileRequest) (*Profile, error)
+	// GetExperiences lists work experiences.
+	GetExperiences(context.Context, *GetExperiencesRequest) (*GetExperiencesResponse, error)
+	// GetSkills lists skills.
+	GetSkills(context.Context, *GetSkillsRequest) (*GetSkillsResponse, error)
+	// GetAchievements lists achievements.
+	GetAchievements(context.Context, *GetAchievementsRequest) (*GetAchievementsResponse, error)
+	// GetEducation lists education and certifications.
+	GetEducation(context.Context, *GetEducationRequest) (*GetEducationResponse, error)
+	// GetProjects lists projects.
+	GetProjects(context.Context, *GetProjectsRequest) (*GetProjectsResponse, error)
+	mustEmbedUnimplementedResumeServiceServer()
+}
+
+// UnimplementedResumeServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedResumeServiceServer struct{}
+
+func (UnimplementedResumeServiceServer) GetProfile(context.Context, *GetProfileRequest) (*Profile, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProfile not implemented")
+}
+func (UnimplementedResumeServiceServer) GetExperiences(context.Context, *GetExperiencesRequest) (*GetExperiencesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExperiences not implemented")
+}
+func (UnimplementedResumeServiceServer) GetSkills(context.Context, *GetSkillsRequest) (*GetSkillsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSkills not implemented")
+}
+func (UnimplementedResumeServiceServer) GetAchievements(context.Context, *GetAchievementsRequest) (*GetAchievementsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAchievements not implemented")
+}
+func (UnimplementedResumeServiceServer) GetEducation(context.Context, *GetEducationRequest) (*GetEducationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEducation not implemented")
+}
+func (UnimplementedResumeServiceServer) GetProjects(context.Context, *GetProjectsRequest) (*GetProjectsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProjects not implemented")
+}
+func (UnimplementedResumeServiceServer) mustEmbedUnimplementedResumeServiceServer() {}
+func (UnimplementedResumeServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeResumeServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ResumeServiceServer will
+// result in compilation errors.
+type UnsafeResumeServiceServer interface {
+	mustEmbedUnimplementedResumeServiceServer()
+}
+
+func RegisterResumeServiceServer(s grpc.ServiceRegistrar, srv ResumeServiceServer) {
+	// If the following call pancis, it indicates UnimplementedResumeServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ResumeService_ServiceDesc, srv)
+}
+
+func _ResumeService_GetProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResumeServiceServer).GetProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResumeService_GetProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResumeServiceServer).GetProfile(ctx, req.(*GetProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResumeService_GetExperiences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExperiencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResumeServiceServer).GetExperiences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResumeService_GetExperiences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResumeServiceServer).GetExperiences(ctx, req.(*GetExperiencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResumeService_GetSkills_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSkillsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResumeServiceServer).GetSkills(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResumeService_GetSkills_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResumeServiceServer).GetSkills(ctx, req.(*GetSkillsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResumeService_GetAchievements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAchievementsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResumeServiceServer).GetAchievements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResumeService_GetAchievements_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResumeServiceServer).GetAchievements(ctx, req.(*GetAchievementsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResumeService_GetEducation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEducationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResumeServiceServer).GetEducation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResumeService_GetEducation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResumeServiceServer).GetEducation(ctx, req.(*GetEducationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResumeService_GetProjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProjectsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResumeServiceServer).GetProjects(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResumeService_GetProjects_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResumeServiceServer).GetProjects(ctx, req.(*GetProjectsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ResumeService_ServiceDesc is the grpc.ServiceDesc for ResumeService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ResumeService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "resume.v1.ResumeService",
+	HandlerType: (*ResumeServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetProfile",
+			Handler:    _ResumeService_GetProfile_Handler,
+		},
+		{
+			MethodName: "GetExperiences",
+			Handler:    _ResumeService_GetExperiences_Handler,
+		},
+		{
+			MethodName: "GetSkills",
+			Handler:    _ResumeService_GetSkills_Handler,
+		},
+		{
+			MethodName: "GetAchievements",
+			Handler:    _ResumeService_GetAchievements_Handler,
+		},
+		{
+			MethodName: "GetEducation",
+			Handler:    _ResumeService_GetEducation_Handler,
+		},
+		{
+			MethodName: "GetProjects",
+			Handler:    _ResumeService_GetProjects_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "resume/v1/resume.proto",
+}
@@ -0,0 +1,292 @@
+// Package grpc serves the read operations of the resume API over gRPC
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	googlegrpc "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/npmulder/resume-api/internal/grpc/resumepb"
+	"github.com/npmulder/resume-api/internal/models"
+	"github.com/npmulder/resume-api/internal/repository"
+	"github.com/npmulder/resume-api/internal/services"
+)
+
+// dateLayout is the format of dates in requests and responses
+const dateLayout = "2006-01-02"
+
+// Server implements resumepb.ResumeServiceServer by delegating to a ResumeService
+type Server struct {
+	resumepb.UnimplementedResumeServiceServer
+	service services.ResumeService
+	// maskContact hides the profile's email and phone from every caller
+	maskContact bool
+	// maxPageSize caps the limit of list calls; 0 disables the cap
+	maxPageSize int
+}
+
+// Option configures the server created by NewServer
+type Option func(*serverOptions)
+
+type serverOptions struct {
+	maskContact bool
+	maxPageSize int
+	grpc        []googlegrpc.ServerOption
+}
+
+// WithContactMasking partly hides the profile's email address and phone
+// number, e.g. j***@example.com. The gRPC API has no authentication, so unlike
+// the REST API every caller gets the masked profile.
+func WithContactMasking() Option {
+	return func(o *serverOptions) {
+		o.maskContact = true
+	}
+}
+
+// WithMaxPageSize lowers list limits above max to max, like the REST API,
+// and says so in a warning response header
+func WithMaxPageSize(max int) Option {
+	return func(o *serverOptions) {
+		o.maxPageSize = max
+	}
+}
+
+// WithServerOptions passes opts, such as interceptors, to the gRPC server
+func WithServerOptions(opts ...googlegrpc.ServerOption) Option {
+	return func(o *serverOptions) {
+		o.grpc = append(o.grpc, opts...)
+	}
+}
+
+// NewServer creates a gRPC server with the resume service registered
+func NewServer(service services.ResumeService, opts ...Option) *googlegrpc.Server {
+	var options serverOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	server := googlegrpc.NewServer(options.grpc...)
+	resumepb.RegisterResumeServiceServer(server, &Server{
+		service:     service,
+		maskContact: options.maskContact,
+		maxPageSize: options.maxPageSize,
+	})
+	return server
+}
+
+// GetProfile returns the user's profile
+func (s *Server) GetProfile(ctx context.Context, _ *resumepb.GetProfileRequest) (*resumepb.Profile, error) {
+	profile, err := s.service.GetProfile(ctx)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	if s.maskContact {
+		profile = profile.Masked()
+	}
+	return profileToProto(profile), nil
+}
+
+// GetExperiences lists work experiences matching the request filters
+func (s *Server) GetExperiences(ctx context.Context, req *resumepb.GetExperiencesRequest) (*resumepb.GetExperiencesResponse, error) {
+	if err := validateDates(map[string]*string{"date_from": req.DateFrom, "date_to": req.DateTo}); err != nil {
+		return nil, err
+	}
+
+	experiences, err := s.service.GetExperiences(ctx, repository.ExperienceFilters{
+		Company:   req.GetCompany(),
+		Position:  req.GetPosition(),
+		DateFrom:  req.DateFrom,
+		DateTo:    req.DateTo,
+		IsCurrent: req.IsCurrent,
+		Limit:     s.clampLimit(ctx, req.GetLimit()),
+		Offset:    int(req.GetOffset()),
+	})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	resp := &resumepb.GetExperiencesResponse{}
+	for _, experience := range experiences {
+		resp.Experiences = append(resp.Experiences, experienceToProto(experience))
+	}
+	return resp, nil
+}
+
+// GetSkills lists skills matching the request filters
+func (s *Server) GetSkills(ctx context.Context, req *resumepb.GetSkillsRequest) (*resumepb.GetSkillsResponse, error) {
+	if err := validateFallback(req.GetFallback()); err != nil {
+		return nil, err
+	}
+
+	skills, err := s.service.GetSkills(ctx, repository.SkillFilters{
+		Category: req.GetCategory(),
+		Level:    req.GetLevel(),
+		Featured: req.Featured,
+		Fallback: req.GetFallback(),
+		Limit:    s.clampLimit(ctx, req.GetLimit()),
+		Offset:   int(req.GetOffset()),
+	})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	resp := &resumepb.GetSkillsResponse{}
+	for _, skill := range skills {
+		resp.Skills = append(resp.Skills, skillToProto(skill))
+	}
+	return resp, nil
+}
+
+// GetAchievements lists achievements matching the request filters
+func (s *Server) GetAchievements(ctx context.Context, req *resumepb.GetAchievementsRequest) (*resumepb.GetAchievementsResponse, error) {
+	if err := validateFallback(req.GetFallback()); err != nil {
+		return nil, err
+	}
+	if req.YearFrom != nil && req.YearTo != nil && req.GetYearFrom() > req.GetYearTo() {
+		return nil, status.Error(codes.InvalidArgument, "year_from must not be after year_to")
+	}
+	if err := models.ValidateAchievementCategory(req.GetCategory()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	achievements, err := s.service.GetAchievements(ctx, repository.AchievementFilters{
+		Category: req.GetCategory(),
+		Year:     intPtr(req.Year),
+		YearFrom: intPtr(req.YearFrom),
+		YearTo:   intPtr(req.YearTo),
+		Featured: req.Featured,
+		Fallback: req.GetFallback(),
+		Limit:    s.clampLimit(ctx, req.GetLimit()),
+		Offset:   int(req.GetOffset()),
+	})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	resp := &resumepb.GetAchievementsResponse{}
+	for _, achievement := range achievements {
+		resp.Achievements = append(resp.Achievements, achievementToProto(achievement))
+	}
+	return resp, nil
+}
+
+// GetEducation lists education entries matching the request filters
+func (s *Server) GetEducation(ctx context.Context, req *resumepb.GetEducationRequest) (*resumepb.GetEducationResponse, error) {
+	if err := validateFallback(req.GetFallback()); err != nil {
+		return nil, err
+	}
+
+	education, err := s.service.GetEducation(ctx, repository.EducationFilters{
+		Type:        req.GetType(),
+		Institution: req.GetInstitution(),
+		Status:      req.GetStatus(),
+		Featured:    req.Featured,
+		Fallback:    req.GetFallback(),
+		Limit:       s.clampLimit(ctx, req.GetLimit()),
+		Offset:      int(req.GetOffset()),
+	})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	resp := &resumepb.GetEducationResponse{}
+	for _, entry := range education {
+		resp.Education = append(resp.Education, educationToProto(entry))
+	}
+	return resp, nil
+}
+
+// GetProjects lists projects matching the request filters
+func (s *Server) GetProjects(ctx context.Context, req *resumepb.GetProjectsRequest) (*resumepb.GetProjectsResponse, error) {
+	if err := validateFallback(req.GetFallback()); err != nil {
+		return nil, err
+	}
+	if err := validateDates(map[string]*string{
+		"started_after":  req.StartedAfter,
+		"started_before": req.StartedBefore,
+		"active_during":  req.ActiveDuring,
+	}); err != nil {
+		return nil, err
+	}
+	if req.StartedAfter != nil && req.StartedBefore != nil && req.GetStartedAfter() > req.GetStartedBefore() {
+		return nil, status.Error(codes.InvalidArgument, "started_after must not be after started_before")
+	}
+
+	projects, err := s.service.GetProjects(ctx, repository.ProjectFilters{
+		Status:        req.GetStatus(),
+		Technology:    req.GetTechnology(),
+		Featured:      req.Featured,
+		Ongoing:       req.Ongoing,
+		StartedAfter:  req.StartedAfter,
+		StartedBefore: req.StartedBefore,
+		ActiveDuring:  req.ActiveDuring,
+		Fallback:      req.GetFallback(),
+		Limit:         s.clampLimit(ctx, req.GetLimit()),
+		Offset:        int(req.GetOffset()),
+	})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	resp := &resumepb.GetProjectsResponse{}
+	for _, project := range projects {
+		resp.Projects = append(resp.Projects, projectToProto(project))
+	}
+	return resp, nil
+}
+
+// clampLimit lowers limit to the maximum page size when it exceeds it and
+// adds a warning header saying so
+func (s *Server) clampLimit(ctx context.Context, limit int32) int {
+	if s.maxPageSize <= 0 || int(limit) <= s.maxPageSize {
+		return int(limit)
+	}
+	warning := fmt.Sprintf("limit %d exceeds the maximum page size; clamped to %d", limit, s.maxPageSize)
+	_ = googlegrpc.SetHeader(ctx, metadata.Pairs("warning", warning))
+	return s.maxPageSize
+}
+
+// validateFallback accepts the same fallback values as the REST query parameter
+func validateFallback(fallback string) error {
+	if fallback != "" && fallback != repository.FallbackRecent {
+		return status.Errorf(codes.InvalidArgument, "fallback must be %q", repository.FallbackRecent)
+	}
+	return nil
+}
+
+// validateDates checks that every set date is formatted as YYYY-MM-DD
+func validateDates(dates map[string]*string) error {
+	for name, date := range dates {
+		if date == nil {
+			continue
+		}
+		if _, err := time.Parse(dateLayout, *date); err != nil {
+			return status.Error(codes.InvalidArgument, fmt.Sprintf("%s must be a date formatted as YYYY-MM-DD", name))
+		}
+	}
+	return nil
+}
+
+// toStatus maps service errors to gRPC status errors, mirroring utils.HandleError
+func toStatus(err error) error {
+	switch {
+	case errors.Is(err, repository.ErrNotFound):
+		return status.Error(codes.NotFound, "the requested resource was not found")
+	case errors.Is(err, repository.ErrPreconditionFailed):
+		return status.Error(codes.FailedPrecondition, "the resource has changed since it was read")
+	case errors.Is(err, models.ErrInvalidAchievementCategory), errors.Is(err, models.ErrTooManyItems):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.Error(codes.DeadlineExceeded, "the request took too long to process")
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, "the request was canceled")
+	default:
+		return status.Error(codes.Internal, "an unexpected error occurred")
+	}
+}
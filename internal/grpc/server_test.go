@@ -0,0 +1,204 @@
+package grpc
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	googlegrpc "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/npmulder/resume-api/internal/grpc/resumepb"
+	"github.com/npmulder/resume-api/internal/models"
+	"github.com/npmulder/resume-api/internal/repository"
+	"github.com/npmulder/resume-api/internal/services"
+)
+
+// fakeResumeService implements the reads used by these tests; calling any
+// other method panics on the nil embedded interface
+type fakeResumeService struct {
+	services.ResumeService
+	profile        *models.Profile
+	err            error
+	projects       []*models.Project
+	projectFilters repository.ProjectFilters
+}
+
+func (f *fakeResumeService) GetProfile(ctx context.Context) (*models.Profile, error) {
+	return f.profile, f.err
+}
+
+func (f *fakeResumeService) GetProjects(ctx context.Context, filters repository.ProjectFilters) ([]*models.Project, error) {
+	f.projectFilters = filters
+	return f.projects, f.err
+}
+
+// newTestClient serves service over an in-memory connection
+func newTestClient(t *testing.T, service services.ResumeService, opts ...Option) resumepb.ResumeServiceClient {
+	t.Helper()
+
+	listener := bufconn.Listen(1024 * 1024)
+	server := NewServer(service, opts...)
+	go func() { _ = server.Serve(listener) }()
+	t.Cleanup(server.Stop)
+
+	conn, err := googlegrpc.NewClient("passthrough:///bufnet",
+		googlegrpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		googlegrpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return resumepb.NewResumeServiceClient(conn)
+}
+
+func TestServer_GetProfile(t *testing.T) {
+	t.Run("returns profile", func(t *testing.T) {
+		// Setup
+		updated := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+		client := newTestClient(t, &fakeResumeService{profile: &models.Profile{
+			ID:        1,
+			Name:      "John Doe",
+			Title:     "Software Engineer",
+			Email:     "john@example.com",
+			UpdatedAt: updated,
+		}})
+
+		// Call
+		profile, err := client.GetProfile(context.Background(), &resumepb.GetProfileRequest{})
+
+		// Assert response
+		require.NoError(t, err)
+		assert.Equal(t, int32(1), profile.GetId())
+		assert.Equal(t, "John Doe", profile.GetName())
+		assert.Equal(t, "john@example.com", profile.GetEmail())
+		assert.Equal(t, updated, profile.GetUpdatedAt().AsTime())
+	})
+
+	t.Run("masks contact details", func(t *testing.T) {
+		// Setup
+		phone := "+1-555-123-4567"
+		original := &models.Profile{ID: 1, Name: "John Doe", Email: "john@example.com", Phone: &phone}
+		client := newTestClient(t, &fakeResumeService{profile: original}, WithContactMasking())
+
+		// Call
+		profile, err := client.GetProfile(context.Background(), &resumepb.GetProfileRequest{})
+
+		// Assert response
+		require.NoError(t, err)
+		assert.Equal(t, "j***@example.com", profile.GetEmail())
+		assert.Equal(t, "+*-***-***-4567", profile.GetPhone())
+		assert.Equal(t, "John Doe", profile.GetName())
+		assert.Equal(t, "john@example.com", original.Email)
+	})
+
+	t.Run("maps not found", func(t *testing.T) {
+		// Setup
+		client := newTestClient(t, &fakeResumeService{err: repository.ErrNotFound})
+
+		// Call
+		_, err := client.GetProfile(context.Background(), &resumepb.GetProfileRequest{})
+
+		// Assert response
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
+
+func TestServer_GetProjects(t *testing.T) {
+	t.Run("maps filters", func(t *testing.T) {
+		// Setup
+		start := time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)
+		service := &fakeResumeService{projects: []*models.Project{
+			{ID: 7, Name: "Resume API", Technologies: []string{"Go"}, StartDate: &start},
+		}}
+		client := newTestClient(t, service)
+		featured := true
+		after := "2023-01-01"
+
+		// Call
+		resp, err := client.GetProjects(context.Background(), &resumepb.GetProjectsRequest{
+			Technology:   "Go",
+			Featured:     &featured,
+			StartedAfter: &after,
+			Limit:        5,
+		})
+
+		// Assert response
+		require.NoError(t, err)
+		require.Len(t, resp.GetProjects(), 1)
+		assert.Equal(t, "Resume API", resp.GetProjects()[0].GetName())
+		assert.Equal(t, "2023-06-01", resp.GetProjects()[0].GetStartDate())
+		assert.Equal(t, "Go", service.projectFilters.Technology)
+		assert.Equal(t, &featured, service.projectFilters.Featured)
+		assert.Equal(t, &after, service.projectFilters.StartedAfter)
+		assert.Equal(t, 5, service.projectFilters.Limit)
+	})
+
+	t.Run("clamps limit to the maximum page size", func(t *testing.T) {
+		// Setup
+		service := &fakeResumeService{}
+		client := newTestClient(t, service, WithMaxPageSize(10))
+
+		// Call
+		var header metadata.MD
+		_, err := client.GetProjects(context.Background(), &resumepb.GetProjectsRequest{Limit: 1000},
+			googlegrpc.Header(&header))
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, 10, service.projectFilters.Limit)
+		assert.Equal(t, []string{"limit 1000 exceeds the maximum page size; clamped to 10"}, header.Get("warning"))
+	})
+
+	t.Run("keeps limit within the maximum page size", func(t *testing.T) {
+		// Setup
+		service := &fakeResumeService{}
+		client := newTestClient(t, service, WithMaxPageSize(10))
+
+		// Call
+		var header metadata.MD
+		_, err := client.GetProjects(context.Background(), &resumepb.GetProjectsRequest{Limit: 5},
+			googlegrpc.Header(&header))
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, 5, service.projectFilters.Limit)
+		assert.Empty(t, header.Get("warning"))
+	})
+
+	t.Run("rejects invalid start date range", func(t *testing.T) {
+		// Setup
+		client := newTestClient(t, &fakeResumeService{})
+		after, before := "2024-01-01", "2023-01-01"
+
+		// Call
+		_, err := client.GetProjects(context.Background(), &resumepb.GetProjectsRequest{
+			StartedAfter:  &after,
+			StartedBefore: &before,
+		})
+
+		// Assert response
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("rejects malformed date", func(t *testing.T) {
+		// Setup
+		client := newTestClient(t, &fakeResumeService{})
+		during := "June 2023"
+
+		// Call
+		_, err := client.GetProjects(context.Background(), &resumepb.GetProjectsRequest{ActiveDuring: &during})
+
+		// Assert response
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
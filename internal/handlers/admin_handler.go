@@ -0,0 +1,53 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/npmulder/resume-api/internal/cache"
+	"github.com/npmulder/resume-api/internal/services"
+	"github.com/npmulder/resume-api/internal/utils"
+)
+
+// AdminHandler handles administrative requests such as cache maintenance.
+type AdminHandler struct {
+	cache cache.Cache
+}
+
+// NewAdminHandler creates a new AdminHandler.
+func NewAdminHandler(cache cache.Cache) *AdminHandler {
+	return &AdminHandler{cache: cache}
+}
+
+// InvalidateCache handles the request to clear the cached entries of a single entity.
+// @Summary Invalidate cached entity
+// @Description Remove every cached entry for one entity, leaving other entities cached
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param entity path string true "Entity to invalidate" Enums(profile, experiences, skills, achievements, education, projects, recent, resume)
+// @Param X-API-Key header string true "API key"
+// @Success 200 {object} map[string]interface{} "Number of cache keys removed"
+// @Failure 400 {object} models.APIError "Bad request"
+// @Failure 401 {object} models.APIError "Unauthorized"
+// @Failure 500 {object} models.APIError "Internal server error"
+// @Router /api/v1/admin/cache/{entity} [delete]
+// @Response 200 {object} map[string]interface{} "Example response" {"entity":"skills","deleted":4}
+func (h *AdminHandler) InvalidateCache(c *gin.Context) {
+	entity := c.Param("entity")
+	prefix, ok := services.CacheKeyPrefix(entity)
+	if !ok {
+		utils.ValidationError(c, "Unknown cache entity",
+			"entity must be one of: "+strings.Join(services.CacheEntities(), ", "))
+		return
+	}
+
+	deleted, err := h.cache.DeletePrefix(c.Request.Context(), prefix)
+	if err != nil {
+		utils.HandleError(c, err)
+		return
+	}
+
+	utils.Respond(c, http.StatusOK, gin.H{"entity": entity, "deleted": deleted})
+}
@@ -0,0 +1,90 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/npmulder/resume-api/internal/cache"
+)
+
+// keyCache is an in-memory cache that only tracks which keys are present
+type keyCache struct {
+	cache.NoOpCache
+	keys map[string]bool
+}
+
+func newKeyCache(keys ...string) *keyCache {
+	c := &keyCache{keys: make(map[string]bool)}
+	for _, key := range keys {
+		c.keys[key] = true
+	}
+	return c
+}
+
+func (c *keyCache) DeletePrefix(ctx context.Context, prefix string) (int64, error) {
+	var deleted int64
+	for key := range c.keys {
+		if strings.HasPrefix(key, prefix) {
+			delete(c.keys, key)
+			deleted++
+		}
+	}
+	return deleted, nil
+}
+
+func TestInvalidateCache(t *testing.T) {
+	t.Run("removes only the targeted entity", func(t *testing.T) {
+		// Setup
+		store := newKeyCache(
+			"skills:Languages:<nil>::0:0",
+			"skills::<nil>::10:0",
+			"projects:active::<nil>:::0:0",
+			"profile",
+			"recent:10",
+		)
+		router := setupRouter()
+		handler := NewAdminHandler(store)
+		router.DELETE("/api/v1/admin/cache/:entity", handler.InvalidateCache)
+
+		// Create request
+		req := httptest.NewRequest(http.MethodDelete, "/api/v1/admin/cache/skills", nil)
+		w := httptest.NewRecorder()
+
+		// Serve request
+		router.ServeHTTP(w, req)
+
+		// Assert response
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.JSONEq(t, `{"entity":"skills","deleted":2}`, w.Body.String())
+		assert.Equal(t, map[string]bool{
+			"projects:active::<nil>:::0:0": true,
+			"profile":                      true,
+			"recent:10":                    true,
+		}, store.keys)
+	})
+
+	t.Run("unknown entity", func(t *testing.T) {
+		// Setup
+		store := newKeyCache("profile")
+		router := setupRouter()
+		handler := NewAdminHandler(store)
+		router.DELETE("/api/v1/admin/cache/:entity", handler.InvalidateCache)
+
+		// Create request
+		req := httptest.NewRequest(http.MethodDelete, "/api/v1/admin/cache/users", nil)
+		w := httptest.NewRecorder()
+
+		// Serve request
+		router.ServeHTTP(w, req)
+
+		// Assert response
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), "Unknown cache entity")
+		assert.Len(t, store.keys, 1)
+	})
+}
@@ -0,0 +1,151 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/npmulder/resume-api/internal/utils"
+)
+
+// batchPathPrefix is the prefix every batched path must start with
+const batchPathPrefix = "/api/"
+
+// DefaultBatchMaxSize is the batch size cap used when none is configured
+const DefaultBatchMaxSize = 10
+
+// BatchOperation is a single read request within a batch
+type BatchOperation struct {
+	Method string `json:"method" example:"GET"`
+	Path   string `json:"path" example:"/api/v1/skills?featured=true"`
+}
+
+// BatchResult is the response to a single batched operation
+type BatchResult struct {
+	Status int             `json:"status" example:"200"`
+	Body   json.RawMessage `json:"body,omitempty" swaggertype:"object"`
+}
+
+// BatchHandler runs several read requests in one round trip by replaying
+// each against the API router
+type BatchHandler struct {
+	router  http.Handler
+	maxSize int
+}
+
+// NewBatchHandler creates a new BatchHandler that dispatches operations to
+// router. Batches may hold at most maxSize operations; a non-positive value
+// uses DefaultBatchMaxSize.
+func NewBatchHandler(router http.Handler, maxSize int) *BatchHandler {
+	if maxSize <= 0 {
+		maxSize = DefaultBatchMaxSize
+	}
+	return &BatchHandler{router: router, maxSize: maxSize}
+}
+
+// Batch handles the request to run several read requests at once.
+// Operations run in order and each result mirrors the status and body the
+// operation would have returned on its own.
+// @Summary Batch read requests
+// @Description Run several GET requests against the API in one round trip
+// @Tags batch
+// @Accept json
+// @Produce json
+// @Param operations body []BatchOperation true "Operations to run"
+// @Success 200 {array} BatchResult
+// @Failure 400 {object} models.APIError "Bad request"
+// @Failure 500 {object} models.APIError "Internal server error"
+// @Router /api/v1/batch [post]
+func (h *BatchHandler) Batch(c *gin.Context) {
+	var operations []BatchOperation
+	if err := c.ShouldBindJSON(&operations); err != nil {
+		utils.ValidationError(c, "Invalid request body", err.Error())
+		return
+	}
+
+	if len(operations) == 0 {
+		utils.ValidationError(c, "Invalid request body", "batch must contain at least one operation")
+		return
+	}
+	if len(operations) > h.maxSize {
+		utils.ValidationError(c, "Batch too large", fmt.Sprintf("batch may contain at most %d operations", h.maxSize))
+		return
+	}
+
+	for i, operation := range operations {
+		if !strings.EqualFold(operation.Method, http.MethodGet) {
+			utils.ValidationError(c, "Invalid batch operation", fmt.Sprintf("operation %d: only GET requests can be batched", i))
+			return
+		}
+		if !strings.HasPrefix(operation.Path, batchPathPrefix) || strings.HasPrefix(operation.Path, c.Request.URL.Path) {
+			utils.ValidationError(c, "Invalid batch operation", fmt.Sprintf("operation %d: path must be an API path other than the batch endpoint", i))
+			return
+		}
+	}
+
+	results := make([]BatchResult, 0, len(operations))
+	for _, operation := range operations {
+		results = append(results, h.run(c, operation))
+	}
+
+	utils.Respond(c, http.StatusOK, results)
+}
+
+// run replays operation against the router with the headers of the batch request
+func (h *BatchHandler) run(c *gin.Context, operation BatchOperation) BatchResult {
+	req, err := http.NewRequestWithContext(c.Request.Context(), http.MethodGet, operation.Path, nil)
+	if err != nil {
+		return BatchResult{Status: http.StatusBadRequest}
+	}
+	req.Header = c.Request.Header.Clone()
+	req.Header.Del("Content-Type")
+	req.Header.Del("Content-Length")
+	req.RemoteAddr = c.Request.RemoteAddr
+	req.Host = c.Request.Host
+
+	recorder := newBatchRecorder()
+	h.router.ServeHTTP(recorder, req)
+
+	result := BatchResult{Status: recorder.status}
+	if body := recorder.body.Bytes(); len(body) > 0 {
+		if json.Valid(body) {
+			result.Body = body
+		} else {
+			// Non-JSON responses such as resume.html are returned as a string
+			result.Body, _ = json.Marshal(string(body))
+		}
+	}
+	return result
+}
+
+// batchRecorder captures the response of a batched operation
+type batchRecorder struct {
+	header      http.Header
+	body        bytes.Buffer
+	status      int
+	wroteHeader bool
+}
+
+func newBatchRecorder() *batchRecorder {
+	return &batchRecorder{header: http.Header{}, status: http.StatusOK}
+}
+
+func (r *batchRecorder) Header() http.Header {
+	return r.header
+}
+
+func (r *batchRecorder) WriteHeader(status int) {
+	if r.wroteHeader {
+		return
+	}
+	r.status = status
+	r.wroteHeader = true
+}
+
+func (r *batchRecorder) Write(b []byte) (int, error) {
+	r.WriteHeader(http.StatusOK)
+	return r.body.Write(b)
+}
@@ -0,0 +1,121 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/npmulder/resume-api/internal/models"
+	"github.com/npmulder/resume-api/internal/repository"
+)
+
+func TestBatch(t *testing.T) {
+	t.Run("profile and skills", func(t *testing.T) {
+		// Setup
+		router := setupRouter()
+		mockService := new(MockResumeService)
+		handler := NewResumeHandler(mockService)
+
+		// Configure mock
+		mockService.On("GetProfile", mock.Anything).Return(&models.Profile{ID: 1, Name: "John Doe"}, nil)
+		mockService.On("GetSkills", mock.Anything, mock.MatchedBy(func(f repository.SkillFilters) bool {
+			return f.Category == "Languages"
+		})).Return([]*models.Skill{{ID: 2, Name: "Go"}}, nil)
+
+		// Setup route
+		router.GET("/api/v1/profile", handler.GetProfile)
+		router.GET("/api/v1/skills", handler.GetSkills)
+		router.POST("/api/v1/batch", NewBatchHandler(router, 5).Batch)
+
+		// Create request
+		body := `[{"method":"GET","path":"/api/v1/profile"},{"method":"GET","path":"/api/v1/skills?category=Languages"},{"method":"GET","path":"/api/v1/missing"}]`
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/batch", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+
+		// Serve request
+		router.ServeHTTP(w, req)
+
+		// Assert response
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var results []struct {
+			Status int             `json:"status"`
+			Body   json.RawMessage `json:"body"`
+		}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &results))
+		require.Len(t, results, 3)
+
+		assert.Equal(t, http.StatusOK, results[0].Status)
+		var profile models.Profile
+		require.NoError(t, json.Unmarshal(results[0].Body, &profile))
+		assert.Equal(t, "John Doe", profile.Name)
+
+		assert.Equal(t, http.StatusOK, results[1].Status)
+		var skills []*models.Skill
+		require.NoError(t, json.Unmarshal(results[1].Body, &skills))
+		require.Len(t, skills, 1)
+		assert.Equal(t, "Go", skills[0].Name)
+
+		// Failed operations report their own status without failing the batch
+		assert.Equal(t, http.StatusNotFound, results[2].Status)
+
+		// Verify mock expectations
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("oversized batch", func(t *testing.T) {
+		// Setup
+		router := setupRouter()
+
+		// Setup route
+		router.POST("/api/v1/batch", NewBatchHandler(router, 2).Batch)
+
+		// Create request
+		operation := `{"method":"GET","path":"/api/v1/profile"}`
+		body := "[" + strings.Join([]string{operation, operation, operation}, ",") + "]"
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/batch", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+
+		// Serve request
+		router.ServeHTTP(w, req)
+
+		// Assert response
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), "at most 2 operations")
+	})
+
+	t.Run("invalid operations", func(t *testing.T) {
+		for _, body := range []string{
+			`[]`,
+			`{"method":"GET","path":"/api/v1/profile"}`,
+			`[{"method":"DELETE","path":"/api/v1/projects?confirm=true"}]`,
+			`[{"method":"GET","path":"/api/v1/batch"}]`,
+			`[{"method":"GET","path":"/health"}]`,
+		} {
+			// Setup
+			router := setupRouter()
+
+			// Setup route
+			router.POST("/api/v1/batch", NewBatchHandler(router, 5).Batch)
+
+			// Create request
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/batch", strings.NewReader(body))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			// Serve request
+			router.ServeHTTP(w, req)
+
+			// Assert response
+			assert.Equal(t, http.StatusBadRequest, w.Code, body)
+		}
+	})
+}
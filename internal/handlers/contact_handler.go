@@ -0,0 +1,92 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/npmulder/resume-api/internal/contact"
+	"github.com/npmulder/resume-api/internal/models"
+	"github.com/npmulder/resume-api/internal/repository"
+	"github.com/npmulder/resume-api/internal/utils"
+)
+
+// ContactHandler handles contact form submissions.
+type ContactHandler struct {
+	messages repository.ContactRepository
+	sender   contact.Sender
+}
+
+// NewContactHandler creates a new ContactHandler.
+func NewContactHandler(messages repository.ContactRepository, sender contact.Sender) *ContactHandler {
+	return &ContactHandler{messages: messages, sender: sender}
+}
+
+// ContactRequest is the body of a contact form submission
+type ContactRequest struct {
+	Name    string `json:"name" binding:"required,max=255" example:"Jane Doe"`
+	Email   string `json:"email" binding:"required,email,max=255" example:"jane@example.com"`
+	Message string `json:"message" binding:"required,min=10,max=5000" example:"Are you available for a consulting project?"`
+	// Website is a honeypot: the form hides it from people, so only bots fill it in
+	Website string `json:"website" example:""`
+}
+
+// Submit handles a contact form submission.
+// The submission is stored before it is relayed, so it is kept even when relaying fails.
+// Submissions filling in the honeypot field get the same response but are dropped.
+// @Summary Send a contact message
+// @Description Store a contact form submission and relay it to the resume owner. Submissions are rate limited per client and must be spaced apart. Leave website empty; it is a spam trap.
+// @Tags contact
+// @Accept json
+// @Produce json
+// @Param request body ContactRequest true "Contact message"
+// @Success 200 {object} map[string]interface{} "Submission received"
+// @Failure 400 {object} models.APIError "Bad request"
+// @Failure 429 {object} map[string]interface{} "Too many submissions"
+// @Failure 500 {object} models.APIError "Internal server error"
+// @Failure 502 {object} models.APIError "Submission stored but not relayed"
+// @Router /api/v1/contact [post]
+// @Response 200 {object} map[string]interface{} "Example response" {"status":"received"}
+func (h *ContactHandler) Submit(c *gin.Context) {
+	var request ContactRequest
+	err := c.ShouldBindJSON(&request)
+	// Answer bots as if they succeeded, even when the rest of the form is invalid,
+	// so they can't tell the submission was dropped
+	if request.Website != "" {
+		respondReceived(c)
+		return
+	}
+	if err != nil {
+		utils.ValidationError(c, "Invalid request body", err.Error())
+		return
+	}
+
+	message := &models.ContactMessage{
+		Name:    strings.TrimSpace(request.Name),
+		Email:   request.Email,
+		Message: strings.TrimSpace(request.Message),
+	}
+	if message.Name == "" || message.Message == "" {
+		utils.ValidationError(c, "Invalid request body", "name and message must not be blank")
+		return
+	}
+
+	ctx := c.Request.Context()
+	if err := h.messages.CreateContactMessage(ctx, message); err != nil {
+		utils.HandleError(c, err)
+		return
+	}
+
+	// Relay errors stay internal; the submission is already stored
+	if err := h.sender.Send(ctx, message); err != nil {
+		utils.BadGateway(c, "Your message was saved but could not be relayed", nil)
+		return
+	}
+
+	respondReceived(c)
+}
+
+// respondReceived acknowledges a contact form submission
+func respondReceived(c *gin.Context) {
+	utils.Respond(c, http.StatusOK, gin.H{"status": "received"})
+}
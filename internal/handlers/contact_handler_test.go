@@ -0,0 +1,137 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/npmulder/resume-api/internal/models"
+)
+
+// contactInbox is an in-memory contact repository
+type contactInbox struct {
+	messages []*models.ContactMessage
+	err      error
+}
+
+func (i *contactInbox) CreateContactMessage(ctx context.Context, message *models.ContactMessage) error {
+	if i.err != nil {
+		return i.err
+	}
+	message.ID = len(i.messages) + 1
+	i.messages = append(i.messages, message)
+	return nil
+}
+
+// recordingSender records relayed messages and fails them with err
+type recordingSender struct {
+	sent []*models.ContactMessage
+	err  error
+}
+
+func (s *recordingSender) Send(ctx context.Context, message *models.ContactMessage) error {
+	s.sent = append(s.sent, message)
+	return s.err
+}
+
+func TestSubmitContact(t *testing.T) {
+	serve := func(handler *ContactHandler, body string) *httptest.ResponseRecorder {
+		router := setupRouter()
+		router.POST("/api/v1/contact", handler.Submit)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/contact", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	t.Run("persists and relays a valid submission", func(t *testing.T) {
+		inbox := &contactInbox{}
+		sender := &recordingSender{}
+
+		w := serve(NewContactHandler(inbox, sender),
+			`{"name":" Jane Doe ","email":"jane@example.com","message":"Are you available for a project?"}`)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.JSONEq(t, `{"status":"received"}`, w.Body.String())
+		require.Len(t, inbox.messages, 1)
+		assert.Equal(t, "Jane Doe", inbox.messages[0].Name)
+		assert.Equal(t, "jane@example.com", inbox.messages[0].Email)
+		assert.Equal(t, "Are you available for a project?", inbox.messages[0].Message)
+		require.Len(t, sender.sent, 1)
+		assert.Same(t, inbox.messages[0], sender.sent[0])
+		assert.Equal(t, 1, sender.sent[0].ID)
+	})
+
+	honeypots := map[string]string{
+		"valid submission":   `{"name":"Jane","email":"jane@example.com","message":"Are you available for a project?","website":"https://spam.example.com"}`,
+		"invalid submission": `{"name":"","email":"spam","message":"buy","website":"x"}`,
+	}
+	for name, body := range honeypots {
+		t.Run("drops a filled honeypot on a "+name, func(t *testing.T) {
+			inbox := &contactInbox{}
+			sender := &recordingSender{}
+
+			w := serve(NewContactHandler(inbox, sender), body)
+
+			// Indistinguishable from a stored submission
+			assert.Equal(t, http.StatusOK, w.Code)
+			assert.JSONEq(t, `{"status":"received"}`, w.Body.String())
+			assert.Empty(t, inbox.messages)
+			assert.Empty(t, sender.sent)
+		})
+	}
+
+	validationFailures := map[string]string{
+		"missing name":      `{"email":"jane@example.com","message":"Are you available for a project?"}`,
+		"blank name":        `{"name":"   ","email":"jane@example.com","message":"Are you available for a project?"}`,
+		"invalid email":     `{"name":"Jane","email":"not-an-email","message":"Are you available for a project?"}`,
+		"missing message":   `{"name":"Jane","email":"jane@example.com"}`,
+		"short message":     `{"name":"Jane","email":"jane@example.com","message":"Hi"}`,
+		"oversized message": `{"name":"Jane","email":"jane@example.com","message":"` + strings.Repeat("a", 5001) + `"}`,
+		"malformed json":    `{"name":`,
+	}
+	for name, body := range validationFailures {
+		t.Run(name, func(t *testing.T) {
+			inbox := &contactInbox{}
+			sender := &recordingSender{}
+
+			w := serve(NewContactHandler(inbox, sender), body)
+
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+			assert.Contains(t, w.Body.String(), models.ErrCodeValidationFailed)
+			assert.Empty(t, inbox.messages)
+			assert.Empty(t, sender.sent)
+		})
+	}
+
+	t.Run("keeps the submission when relaying fails", func(t *testing.T) {
+		inbox := &contactInbox{}
+		sender := &recordingSender{err: errors.New("smtp: connection refused")}
+
+		w := serve(NewContactHandler(inbox, sender),
+			`{"name":"Jane","email":"jane@example.com","message":"Are you available for a project?"}`)
+
+		assert.Equal(t, http.StatusBadGateway, w.Code)
+		assert.NotContains(t, w.Body.String(), "connection refused")
+		assert.Len(t, inbox.messages, 1)
+	})
+
+	t.Run("does not relay when storing fails", func(t *testing.T) {
+		inbox := &contactInbox{err: errors.New("database unavailable")}
+		sender := &recordingSender{}
+
+		w := serve(NewContactHandler(inbox, sender),
+			`{"name":"Jane","email":"jane@example.com","message":"Are you available for a project?"}`)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+		assert.Empty(t, sender.sent)
+	})
+}
@@ -0,0 +1,27 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/npmulder/resume-api/internal/models"
+	"github.com/npmulder/resume-api/internal/utils"
+)
+
+// RouteNotFound responds to requests for paths that match no route
+func RouteNotFound(c *gin.Context) {
+	utils.ErrorResponse(c, http.StatusNotFound, "No route matches "+c.Request.Method+" "+c.Request.URL.Path,
+		models.WithCode(models.ErrCodeNotFound),
+		models.WithSuggestion("See /swagger/index.html for the available endpoints"))
+}
+
+// MethodNotAllowed responds to requests for a known path with an unsupported
+// method. Gin sets the Allow header to the path's registered methods before
+// calling it when Engine.HandleMethodNotAllowed is enabled.
+func MethodNotAllowed(c *gin.Context) {
+	message := "Method " + c.Request.Method + " is not allowed"
+	if allow := c.Writer.Header().Get("Allow"); allow != "" {
+		message += "; allowed methods: " + allow
+	}
+	utils.MethodNotAllowed(c, message)
+}
@@ -0,0 +1,84 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/npmulder/resume-api/internal/middleware"
+	"github.com/npmulder/resume-api/internal/models"
+)
+
+func TestRouteNotFound(t *testing.T) {
+	// Setup
+	router := setupRouter()
+	router.Use(middleware.RequestIDMiddleware())
+	router.NoRoute(RouteNotFound)
+
+	// Create request
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/nonexistent", nil)
+	req.Header.Set("X-Request-ID", "req-123")
+	w := httptest.NewRecorder()
+
+	// Serve request
+	router.ServeHTTP(w, req)
+
+	// Assert response
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.Contains(t, w.Header().Get("Content-Type"), "application/json")
+
+	var response models.APIError
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, models.ErrCodeNotFound, response.Code)
+	assert.Equal(t, "/api/v1/nonexistent", response.Path)
+	assert.Equal(t, "req-123", response.RequestID)
+	assert.Contains(t, response.Suggestion, "/swagger")
+}
+
+func TestMethodNotAllowed(t *testing.T) {
+	// Setup
+	router := setupRouter()
+	router.HandleMethodNotAllowed = true
+	router.NoMethod(MethodNotAllowed)
+
+	// Setup route
+	router.GET("/health", func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+
+	t.Run("unsupported method", func(t *testing.T) {
+		// Create request
+		req := httptest.NewRequest(http.MethodPost, "/health", nil)
+		w := httptest.NewRecorder()
+
+		// Serve request
+		router.ServeHTTP(w, req)
+
+		// Assert response
+		assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+		assert.Equal(t, "GET", w.Header().Get("Allow"))
+
+		var response models.APIError
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, models.ErrCodeMethodNotAllowed, response.Code)
+		assert.Equal(t, "/health", response.Path)
+	})
+
+	t.Run("unknown path is still not found", func(t *testing.T) {
+		// Create request
+		req := httptest.NewRequest(http.MethodPost, "/unknown", nil)
+		w := httptest.NewRecorder()
+
+		// Serve request
+		router.ServeHTTP(w, req)
+
+		// Assert response
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Empty(t, w.Header().Get("Allow"))
+	})
+}
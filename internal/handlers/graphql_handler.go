@@ -0,0 +1,502 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/graphql-go/graphql"
+
+	"github.com/npmulder/resume-api/internal/models"
+	"github.com/npmulder/resume-api/internal/repository"
+	"github.com/npmulder/resume-api/internal/utils"
+)
+
+// GraphQLRequest defines the body of a GraphQL request
+type GraphQLRequest struct {
+	Query         string         `json:"query" binding:"required" example:"{ profile { name } skills(featured: true) { name level } }"`
+	Variables     map[string]any `json:"variables"`
+	OperationName string         `json:"operationName"`
+}
+
+// GraphQL handles read queries against the resume over GraphQL. Only the
+// sections a query selects are read, through the same service, caching,
+// contact masking and page size cap as the REST endpoints.
+// @Summary GraphQL query
+// @Description Run a read-only GraphQL query over the profile, experiences, skills, achievements, education and projects. Filters are field arguments named like the REST query parameters in camelCase. Errors are reported in the errors array of a 200 response.
+// @Tags graphql
+// @Accept json
+// @Produce json
+// @Param request body GraphQLRequest true "GraphQL query"
+// @Param X-API-Key header string false "API key; shows the full email and phone when contact masking is enabled"
+// @Success 200 {object} object "GraphQL response with data and errors"
+// @Failure 400 {object} models.APIError "Bad request"
+// @Router /graphql [post]
+func (h *ResumeHandler) GraphQL(c *gin.Context) {
+	var request GraphQLRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		utils.ValidationError(c, "Invalid request body", err.Error())
+		return
+	}
+
+	result := graphql.Do(graphql.Params{
+		Schema:         graphqlSchema,
+		RequestString:  request.Query,
+		VariableValues: request.Variables,
+		OperationName:  request.OperationName,
+		Context:        c.Request.Context(),
+		RootObject:     map[string]any{graphqlRootKey: &graphqlRoot{handler: h, c: c}},
+	})
+	utils.Respond(c, http.StatusOK, result)
+}
+
+// graphqlRootKey is the root object entry holding the request's graphqlRoot
+const graphqlRootKey = "root"
+
+// graphqlRoot gives the root field resolvers the handler and request they
+// serve, as the schema is built once and shared between handlers
+type graphqlRoot struct {
+	handler *ResumeHandler
+	c       *gin.Context
+}
+
+func rootOf(p graphql.ResolveParams) *graphqlRoot {
+	return p.Info.RootValue.(map[string]any)[graphqlRootKey].(*graphqlRoot)
+}
+
+var graphqlProfileType = graphql.NewObject(graphql.ObjectConfig{
+	Name: "Profile",
+	Fields: graphql.Fields{
+		"id":        &graphql.Field{Type: graphql.Int},
+		"name":      &graphql.Field{Type: graphql.String},
+		"title":     &graphql.Field{Type: graphql.String},
+		"email":     &graphql.Field{Type: graphql.String},
+		"phone":     &graphql.Field{Type: graphql.String},
+		"location":  &graphql.Field{Type: graphql.String},
+		"linkedin":  &graphql.Field{Type: graphql.String},
+		"github":    &graphql.Field{Type: graphql.String},
+		"summary":   &graphql.Field{Type: graphql.String},
+		"createdAt": &graphql.Field{Type: graphql.DateTime},
+		"updatedAt": &graphql.Field{Type: graphql.DateTime},
+	},
+})
+
+var graphqlExperienceType = graphql.NewObject(graphql.ObjectConfig{
+	Name: "Experience",
+	Fields: graphql.Fields{
+		"id":          &graphql.Field{Type: graphql.Int},
+		"company":     &graphql.Field{Type: graphql.String},
+		"position":    &graphql.Field{Type: graphql.String},
+		"startDate":   &graphql.Field{Type: graphql.DateTime},
+		"endDate":     &graphql.Field{Type: graphql.DateTime},
+		"description": &graphql.Field{Type: graphql.String},
+		"highlights":  &graphql.Field{Type: graphql.NewList(graphql.String)},
+		"orderIndex":  &graphql.Field{Type: graphql.Int},
+		"isCurrent":   &graphql.Field{Type: graphql.Boolean},
+		"location":    &graphql.Field{Type: graphql.String},
+		"createdAt":   &graphql.Field{Type: graphql.DateTime},
+		"updatedAt":   &graphql.Field{Type: graphql.DateTime},
+	},
+})
+
+var graphqlSkillType = graphql.NewObject(graphql.ObjectConfig{
+	Name: "Skill",
+	Fields: graphql.Fields{
+		"id":              &graphql.Field{Type: graphql.Int},
+		"category":        &graphql.Field{Type: graphql.String},
+		"name":            &graphql.Field{Type: graphql.String},
+		"level":           &graphql.Field{Type: graphql.String},
+		"yearsExperience": &graphql.Field{Type: graphql.Int},
+		"orderIndex":      &graphql.Field{Type: graphql.Int},
+		"isFeatured":      &graphql.Field{Type: graphql.Boolean},
+		"description":     &graphql.Field{Type: graphql.String},
+		"createdAt":       &graphql.Field{Type: graphql.DateTime},
+		"updatedAt":       &graphql.Field{Type: graphql.DateTime},
+	},
+})
+
+var graphqlAchievementType = graphql.NewObject(graphql.ObjectConfig{
+	Name: "Achievement",
+	Fields: graphql.Fields{
+		"id":           &graphql.Field{Type: graphql.Int},
+		"title":        &graphql.Field{Type: graphql.String},
+		"description":  &graphql.Field{Type: graphql.String},
+		"category":     &graphql.Field{Type: graphql.String},
+		"impactMetric": &graphql.Field{Type: graphql.String},
+		"yearAchieved": &graphql.Field{Type: graphql.Int},
+		"orderIndex":   &graphql.Field{Type: graphql.Int},
+		"isFeatured":   &graphql.Field{Type: graphql.Boolean},
+		"createdAt":    &graphql.Field{Type: graphql.DateTime},
+		"updatedAt":    &graphql.Field{Type: graphql.DateTime},
+	},
+})
+
+var graphqlEducationType = graphql.NewObject(graphql.ObjectConfig{
+	Name: "Education",
+	Fields: graphql.Fields{
+		"id":                    &graphql.Field{Type: graphql.Int},
+		"institution":           &graphql.Field{Type: graphql.String},
+		"degreeOrCertification": &graphql.Field{Type: graphql.String},
+		"fieldOfStudy":          &graphql.Field{Type: graphql.String},
+		"yearStarted":           &graphql.Field{Type: graphql.Int},
+		"yearCompleted":         &graphql.Field{Type: graphql.Int},
+		"description":           &graphql.Field{Type: graphql.String},
+		"type":                  &graphql.Field{Type: graphql.String},
+		"status":                &graphql.Field{Type: graphql.String},
+		"credentialId":          &graphql.Field{Type: graphql.String},
+		"credentialUrl":         &graphql.Field{Type: graphql.String},
+		"expiryDate":            &graphql.Field{Type: graphql.DateTime},
+		"orderIndex":            &graphql.Field{Type: graphql.Int},
+		"isFeatured":            &graphql.Field{Type: graphql.Boolean},
+		"createdAt":             &graphql.Field{Type: graphql.DateTime},
+		"updatedAt":             &graphql.Field{Type: graphql.DateTime},
+	},
+})
+
+var graphqlProjectType = graphql.NewObject(graphql.ObjectConfig{
+	Name: "Project",
+	Fields: graphql.Fields{
+		"id":               &graphql.Field{Type: graphql.Int},
+		"name":             &graphql.Field{Type: graphql.String},
+		"slug":             &graphql.Field{Type: graphql.String},
+		"description":      &graphql.Field{Type: graphql.String},
+		"shortDescription": &graphql.Field{Type: graphql.String},
+		"technologies":     &graphql.Field{Type: graphql.NewList(graphql.String)},
+		"githubUrl":        &graphql.Field{Type: graphql.String},
+		"demoUrl":          &graphql.Field{Type: graphql.String},
+		"startDate":        &graphql.Field{Type: graphql.DateTime},
+		"endDate":          &graphql.Field{Type: graphql.DateTime},
+		"status":           &graphql.Field{Type: graphql.String},
+		"isFeatured":       &graphql.Field{Type: graphql.Boolean},
+		"orderIndex":       &graphql.Field{Type: graphql.Int},
+		"keyFeatures":      &graphql.Field{Type: graphql.NewList(graphql.String)},
+		"createdAt":        &graphql.Field{Type: graphql.DateTime},
+		"updatedAt":        &graphql.Field{Type: graphql.DateTime},
+	},
+})
+
+// graphqlPageArgs are the arguments shared by every list field
+var graphqlPageArgs = graphql.FieldConfigArgument{
+	"limit":  &graphql.ArgumentConfig{Type: graphql.Int},
+	"offset": &graphql.ArgumentConfig{Type: graphql.Int},
+}
+
+// graphqlListArgs returns the page arguments plus args
+func graphqlListArgs(args graphql.FieldConfigArgument) graphql.FieldConfigArgument {
+	for name, arg := range graphqlPageArgs {
+		args[name] = arg
+	}
+	return args
+}
+
+// graphqlSchema is the read-only schema served by GraphQL. Field arguments
+// mirror the REST query parameters in camelCase.
+var graphqlSchema = mustGraphQLSchema(graphql.SchemaConfig{
+	Query: graphql.NewObject(graphql.ObjectConfig{
+		Name: "Query",
+		Fields: graphql.Fields{
+			"profile": &graphql.Field{
+				Type:    graphqlProfileType,
+				Resolve: resolveProfile,
+			},
+			"experiences": &graphql.Field{
+				Type: graphql.NewList(graphqlExperienceType),
+				Args: graphqlListArgs(graphql.FieldConfigArgument{
+					"company":   &graphql.ArgumentConfig{Type: graphql.String},
+					"position":  &graphql.ArgumentConfig{Type: graphql.String},
+					"dateFrom":  &graphql.ArgumentConfig{Type: graphql.String},
+					"dateTo":    &graphql.ArgumentConfig{Type: graphql.String},
+					"isCurrent": &graphql.ArgumentConfig{Type: graphql.Boolean},
+					"highlight": &graphql.ArgumentConfig{Type: graphql.String},
+				}),
+				Resolve: resolveExperiences,
+			},
+			"skills": &graphql.Field{
+				Type: graphql.NewList(graphqlSkillType),
+				Args: graphqlListArgs(graphql.FieldConfigArgument{
+					"category":   &graphql.ArgumentConfig{Type: graphql.String},
+					"categories": &graphql.ArgumentConfig{Type: graphql.NewList(graphql.String)},
+					"level":      &graphql.ArgumentConfig{Type: graphql.String},
+					"featured":   &graphql.ArgumentConfig{Type: graphql.Boolean},
+					"fallback":   &graphql.ArgumentConfig{Type: graphql.String},
+				}),
+				Resolve: resolveSkills,
+			},
+			"achievements": &graphql.Field{
+				Type: graphql.NewList(graphqlAchievementType),
+				Args: graphqlListArgs(graphql.FieldConfigArgument{
+					"category": &graphql.ArgumentConfig{Type: graphql.String},
+					"year":     &graphql.ArgumentConfig{Type: graphql.Int},
+					"yearFrom": &graphql.ArgumentConfig{Type: graphql.Int},
+					"yearTo":   &graphql.ArgumentConfig{Type: graphql.Int},
+					"featured": &graphql.ArgumentConfig{Type: graphql.Boolean},
+					"fallback": &graphql.ArgumentConfig{Type: graphql.String},
+				}),
+				Resolve: resolveAchievements,
+			},
+			"education": &graphql.Field{
+				Type: graphql.NewList(graphqlEducationType),
+				Args: graphqlListArgs(graphql.FieldConfigArgument{
+					"type":        &graphql.ArgumentConfig{Type: graphql.String},
+					"institution": &graphql.ArgumentConfig{Type: graphql.String},
+					"status":      &graphql.ArgumentConfig{Type: graphql.String},
+					"featured":    &graphql.ArgumentConfig{Type: graphql.Boolean},
+					"fallback":    &graphql.ArgumentConfig{Type: graphql.String},
+				}),
+				Resolve: resolveEducation,
+			},
+			"projects": &graphql.Field{
+				Type: graphql.NewList(graphqlProjectType),
+				Args: graphqlListArgs(graphql.FieldConfigArgument{
+					"status":        &graphql.ArgumentConfig{Type: graphql.String},
+					"technology":    &graphql.ArgumentConfig{Type: graphql.String},
+					"featured":      &graphql.ArgumentConfig{Type: graphql.Boolean},
+					"ongoing":       &graphql.ArgumentConfig{Type: graphql.Boolean},
+					"hasDemo":       &graphql.ArgumentConfig{Type: graphql.Boolean},
+					"hasRepo":       &graphql.ArgumentConfig{Type: graphql.Boolean},
+					"startedAfter":  &graphql.ArgumentConfig{Type: graphql.String},
+					"startedBefore": &graphql.ArgumentConfig{Type: graphql.String},
+					"activeDuring":  &graphql.ArgumentConfig{Type: graphql.String},
+					"fallback":      &graphql.ArgumentConfig{Type: graphql.String},
+				}),
+				Resolve: resolveProjects,
+			},
+		},
+	}),
+})
+
+func mustGraphQLSchema(config graphql.SchemaConfig) graphql.Schema {
+	schema, err := graphql.NewSchema(config)
+	if err != nil {
+		panic(fmt.Sprintf("invalid GraphQL schema: %v", err))
+	}
+	return schema
+}
+
+func resolveProfile(p graphql.ResolveParams) (any, error) {
+	root := rootOf(p)
+	profile, err := root.handler.service.GetProfile(p.Context)
+	if err != nil {
+		return nil, graphqlError(err)
+	}
+	return root.handler.publicProfile(root.c, profile), nil
+}
+
+func resolveExperiences(p graphql.ResolveParams) (any, error) {
+	root := rootOf(p)
+	filters := repository.ExperienceFilters{
+		Company:           stringArg(p.Args, "company"),
+		Position:          stringArg(p.Args, "position"),
+		DateFrom:          stringPtrArg(p.Args, "dateFrom"),
+		DateTo:            stringPtrArg(p.Args, "dateTo"),
+		IsCurrent:         boolPtrArg(p.Args, "isCurrent"),
+		HighlightContains: stringArg(p.Args, "highlight"),
+		Limit:             intArg(p.Args, "limit"),
+		Offset:            intArg(p.Args, "offset"),
+	}
+	if err := validateGraphQLDates(map[string]*string{"dateFrom": filters.DateFrom, "dateTo": filters.DateTo}); err != nil {
+		return nil, err
+	}
+	root.handler.clampLimit(root.c, &filters.Limit)
+
+	experiences, err := root.handler.service.GetExperiences(p.Context, filters)
+	if err != nil {
+		return nil, graphqlError(err)
+	}
+	return experiences, nil
+}
+
+func resolveSkills(p graphql.ResolveParams) (any, error) {
+	root := rootOf(p)
+	filters := repository.SkillFilters{
+		Category:   stringArg(p.Args, "category"),
+		Categories: stringListArg(p.Args, "categories"),
+		Level:      stringArg(p.Args, "level"),
+		Featured:   boolPtrArg(p.Args, "featured"),
+		Fallback:   stringArg(p.Args, "fallback"),
+		Limit:      intArg(p.Args, "limit"),
+		Offset:     intArg(p.Args, "offset"),
+	}
+	if err := validateGraphQLFallback(filters.Fallback); err != nil {
+		return nil, err
+	}
+	root.handler.clampLimit(root.c, &filters.Limit)
+
+	skills, err := root.handler.service.GetSkills(p.Context, filters)
+	if err != nil {
+		return nil, graphqlError(err)
+	}
+	return skills, nil
+}
+
+func resolveAchievements(p graphql.ResolveParams) (any, error) {
+	root := rootOf(p)
+	filters := repository.AchievementFilters{
+		Category: stringArg(p.Args, "category"),
+		Year:     intPtrArg(p.Args, "year"),
+		YearFrom: intPtrArg(p.Args, "yearFrom"),
+		YearTo:   intPtrArg(p.Args, "yearTo"),
+		Featured: boolPtrArg(p.Args, "featured"),
+		Fallback: stringArg(p.Args, "fallback"),
+		Limit:    intArg(p.Args, "limit"),
+		Offset:   intArg(p.Args, "offset"),
+	}
+	if err := validateGraphQLFallback(filters.Fallback); err != nil {
+		return nil, err
+	}
+	if filters.YearFrom != nil && filters.YearTo != nil && *filters.YearFrom > *filters.YearTo {
+		return nil, errors.New("yearFrom must not be after yearTo")
+	}
+	if err := models.ValidateAchievementCategory(filters.Category); err != nil {
+		return nil, err
+	}
+	root.handler.clampLimit(root.c, &filters.Limit)
+
+	achievements, err := root.handler.service.GetAchievements(p.Context, filters)
+	if err != nil {
+		return nil, graphqlError(err)
+	}
+	return achievements, nil
+}
+
+func resolveEducation(p graphql.ResolveParams) (any, error) {
+	root := rootOf(p)
+	filters := repository.EducationFilters{
+		Type:        stringArg(p.Args, "type"),
+		Institution: stringArg(p.Args, "institution"),
+		Status:      stringArg(p.Args, "status"),
+		Featured:    boolPtrArg(p.Args, "featured"),
+		Fallback:    stringArg(p.Args, "fallback"),
+		Limit:       intArg(p.Args, "limit"),
+		Offset:      intArg(p.Args, "offset"),
+	}
+	if err := validateGraphQLFallback(filters.Fallback); err != nil {
+		return nil, err
+	}
+	root.handler.clampLimit(root.c, &filters.Limit)
+
+	education, err := root.handler.service.GetEducation(p.Context, filters)
+	if err != nil {
+		return nil, graphqlError(err)
+	}
+	return root.handler.publicEducation(root.c, education), nil
+}
+
+func resolveProjects(p graphql.ResolveParams) (any, error) {
+	root := rootOf(p)
+	filters := repository.ProjectFilters{
+		Status:        stringArg(p.Args, "status"),
+		Technology:    stringArg(p.Args, "technology"),
+		Featured:      boolPtrArg(p.Args, "featured"),
+		Ongoing:       boolPtrArg(p.Args, "ongoing"),
+		HasDemo:       boolPtrArg(p.Args, "hasDemo"),
+		HasRepo:       boolPtrArg(p.Args, "hasRepo"),
+		StartedAfter:  stringPtrArg(p.Args, "startedAfter"),
+		StartedBefore: stringPtrArg(p.Args, "startedBefore"),
+		ActiveDuring:  stringPtrArg(p.Args, "activeDuring"),
+		Fallback:      stringArg(p.Args, "fallback"),
+		Limit:         intArg(p.Args, "limit"),
+		Offset:        intArg(p.Args, "offset"),
+	}
+	if err := validateGraphQLFallback(filters.Fallback); err != nil {
+		return nil, err
+	}
+	if err := validateGraphQLDates(map[string]*string{
+		"startedAfter":  filters.StartedAfter,
+		"startedBefore": filters.StartedBefore,
+		"activeDuring":  filters.ActiveDuring,
+	}); err != nil {
+		return nil, err
+	}
+	if filters.StartedAfter != nil && filters.StartedBefore != nil && *filters.StartedAfter > *filters.StartedBefore {
+		return nil, errors.New("startedAfter must not be after startedBefore")
+	}
+	root.handler.clampLimit(root.c, &filters.Limit)
+
+	projects, err := root.handler.service.GetProjects(p.Context, filters)
+	if err != nil {
+		return nil, graphqlError(err)
+	}
+	return projects, nil
+}
+
+func stringArg(args map[string]any, name string) string {
+	s, _ := args[name].(string)
+	return s
+}
+
+func stringPtrArg(args map[string]any, name string) *string {
+	if s, ok := args[name].(string); ok {
+		return &s
+	}
+	return nil
+}
+
+func stringListArg(args map[string]any, name string) []string {
+	values, _ := args[name].([]any)
+	var list []string
+	for _, value := range values {
+		if s, ok := value.(string); ok {
+			list = append(list, s)
+		}
+	}
+	return list
+}
+
+func intArg(args map[string]any, name string) int {
+	i, _ := args[name].(int)
+	return i
+}
+
+func intPtrArg(args map[string]any, name string) *int {
+	if i, ok := args[name].(int); ok {
+		return &i
+	}
+	return nil
+}
+
+func boolPtrArg(args map[string]any, name string) *bool {
+	if b, ok := args[name].(bool); ok {
+		return &b
+	}
+	return nil
+}
+
+// validateGraphQLFallback accepts the same fallback values as the REST query parameter
+func validateGraphQLFallback(fallback string) error {
+	if fallback != "" && fallback != repository.FallbackRecent {
+		return fmt.Errorf("fallback must be %q", repository.FallbackRecent)
+	}
+	return nil
+}
+
+// validateGraphQLDates checks that every set date is formatted as YYYY-MM-DD
+func validateGraphQLDates(dates map[string]*string) error {
+	for name, date := range dates {
+		if date == nil {
+			continue
+		}
+		if _, err := time.Parse("2006-01-02", *date); err != nil {
+			return fmt.Errorf("%s must be a date formatted as YYYY-MM-DD", name)
+		}
+	}
+	return nil
+}
+
+// graphqlError maps service errors to the messages reported in the errors
+// array, mirroring utils.HandleError without exposing internal details
+func graphqlError(err error) error {
+	switch {
+	case errors.Is(err, repository.ErrNotFound):
+		return errors.New("the requested resource was not found")
+	case errors.Is(err, models.ErrInvalidAchievementCategory), errors.Is(err, models.ErrTooManyItems):
+		return err
+	case errors.Is(err, context.DeadlineExceeded), errors.Is(err, context.Canceled):
+		return errors.New("the request took too long to process")
+	default:
+		return errors.New("an unexpected error occurred")
+	}
+}
@@ -0,0 +1,178 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/npmulder/resume-api/internal/middleware"
+	"github.com/npmulder/resume-api/internal/models"
+	"github.com/npmulder/resume-api/internal/repository"
+)
+
+// graphqlResponse is the GraphQL response envelope
+type graphqlResponse struct {
+	Data   map[string]json.RawMessage `json:"data"`
+	Errors []struct {
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
+// postGraphQL sends query to handler's GraphQL endpoint, with key as the API
+// key when set
+func postGraphQL(t *testing.T, handler *ResumeHandler, query, key string) (*httptest.ResponseRecorder, graphqlResponse) {
+	t.Helper()
+
+	router := setupRouter()
+	router.POST("/graphql", handler.GraphQL)
+
+	body, err := json.Marshal(GraphQLRequest{Query: query})
+	require.NoError(t, err)
+	req := httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(string(body)))
+	req.Header.Set("Content-Type", "application/json")
+	if key != "" {
+		req.Header.Set(middleware.APIKeyHeader, key)
+	}
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	var response graphqlResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	return w, response
+}
+
+func TestGraphQL(t *testing.T) {
+	level := "expert"
+
+	t.Run("profile name and featured skills", func(t *testing.T) {
+		// Setup
+		mockService := new(MockResumeService)
+		handler := NewResumeHandler(mockService)
+
+		profile := &models.Profile{ID: 1, Name: "John Doe", Title: "Software Engineer", Email: "john@example.com"}
+		skills := []*models.Skill{{ID: 4, Name: "Go", Category: "Languages", Level: &level, IsFeatured: true}}
+
+		// Configure mock
+		mockService.On("GetProfile", mock.Anything).Return(profile, nil)
+		mockService.On("GetSkills", mock.Anything, mock.MatchedBy(func(f repository.SkillFilters) bool {
+			return f.Featured != nil && *f.Featured && f.Category == "" && f.Limit == 0
+		})).Return(skills, nil)
+
+		// Query
+		w, response := postGraphQL(t, handler, `{ profile { name } skills(featured: true) { name level isFeatured } }`, "")
+
+		// Assert response
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Empty(t, response.Errors)
+		assert.JSONEq(t, `{"name":"John Doe"}`, string(response.Data["profile"]))
+		assert.JSONEq(t, `[{"name":"Go","level":"expert","isFeatured":true}]`, string(response.Data["skills"]))
+
+		// Only the selected sections are read
+		mockService.AssertExpectations(t)
+		mockService.AssertNotCalled(t, "GetExperiences", mock.Anything, mock.Anything)
+		mockService.AssertNotCalled(t, "GetProjects", mock.Anything, mock.Anything)
+	})
+
+	t.Run("maps project filters", func(t *testing.T) {
+		// Setup
+		mockService := new(MockResumeService)
+		handler := NewResumeHandler(mockService, WithMaxPageSize(10))
+
+		// Configure mock
+		mockService.On("GetProjects", mock.Anything, mock.MatchedBy(func(f repository.ProjectFilters) bool {
+			return f.Technology == "Go" && f.HasDemo != nil && *f.HasDemo &&
+				f.StartedAfter != nil && *f.StartedAfter == "2023-01-01" && f.Limit == 10
+		})).Return([]*models.Project{{ID: 7, Name: "Resume API", Technologies: []string{"Go"}}}, nil)
+
+		// Query
+		w, response := postGraphQL(t, handler,
+			`{ projects(technology: "Go", hasDemo: true, startedAfter: "2023-01-01", limit: 500) { name technologies } }`, "")
+
+		// Assert response
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Empty(t, response.Errors)
+		assert.JSONEq(t, `[{"name":"Resume API","technologies":["Go"]}]`, string(response.Data["projects"]))
+		assert.Contains(t, w.Header().Get("Warning"), "clamped to 10")
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("masks contact details", func(t *testing.T) {
+		// Setup
+		mockService := new(MockResumeService)
+		handler := NewResumeHandler(mockService, WithContactMasking("secret"))
+		mockService.On("GetProfile", mock.Anything).Return(&models.Profile{ID: 1, Name: "John Doe", Email: "john@example.com"}, nil)
+
+		// Query
+		w, anonymous := postGraphQL(t, handler, `{ profile { email } }`, "")
+		_, authenticated := postGraphQL(t, handler, `{ profile { email } }`, "secret")
+
+		// Assert response
+		assert.JSONEq(t, `{"email":"j***@example.com"}`, string(anonymous.Data["profile"]))
+		assert.Equal(t, middleware.APIKeyHeader, w.Header().Get("Vary"))
+		assert.JSONEq(t, `{"email":"john@example.com"}`, string(authenticated.Data["profile"]))
+	})
+
+	t.Run("rejects invalid arguments", func(t *testing.T) {
+		// Setup
+		mockService := new(MockResumeService)
+		handler := NewResumeHandler(mockService)
+
+		// Query
+		w, response := postGraphQL(t, handler, `{ achievements(yearFrom: 2024, yearTo: 2020) { title } }`, "")
+
+		// Assert response
+		assert.Equal(t, http.StatusOK, w.Code)
+		require.Len(t, response.Errors, 1)
+		assert.Equal(t, "yearFrom must not be after yearTo", response.Errors[0].Message)
+		mockService.AssertNotCalled(t, "GetAchievements", mock.Anything, mock.Anything)
+	})
+
+	t.Run("hides internal errors", func(t *testing.T) {
+		// Setup
+		mockService := new(MockResumeService)
+		handler := NewResumeHandler(mockService)
+		mockService.On("GetEducation", mock.Anything, mock.Anything).Return(nil, errors.New("connection refused"))
+
+		// Query
+		_, response := postGraphQL(t, handler, `{ education { institution } }`, "")
+
+		// Assert response
+		require.Len(t, response.Errors, 1)
+		assert.Equal(t, "an unexpected error occurred", response.Errors[0].Message)
+	})
+
+	t.Run("rejects unknown fields", func(t *testing.T) {
+		// Setup
+		mockService := new(MockResumeService)
+		handler := NewResumeHandler(mockService)
+
+		// Query
+		_, response := postGraphQL(t, handler, `{ profile { password } }`, "")
+
+		// Assert response
+		require.NotEmpty(t, response.Errors)
+		assert.Contains(t, response.Errors[0].Message, "password")
+		mockService.AssertNotCalled(t, "GetProfile", mock.Anything)
+	})
+
+	t.Run("missing query", func(t *testing.T) {
+		// Setup
+		router := setupRouter()
+		router.POST("/graphql", NewResumeHandler(new(MockResumeService)).GraphQL)
+
+		req := httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(`{}`))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		// Assert response
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
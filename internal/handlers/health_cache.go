@@ -0,0 +1,43 @@
+package handlers
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/npmulder/resume-api/internal/database"
+)
+
+// CachedHealthChecker reuses a recent database health check, so frequent
+// probes don't each run a query. Concurrent callers wait for a check in
+// progress instead of starting their own.
+type CachedHealthChecker struct {
+	checker DatabaseHealthChecker
+	ttl     time.Duration
+
+	mu        sync.Mutex
+	checkedAt time.Time
+	status    *database.HealthStatus
+	err       error
+}
+
+// NewCachedHealthChecker creates a health checker that reuses the result of
+// checker for ttl. A ttl of zero or less checks every time.
+func NewCachedHealthChecker(checker DatabaseHealthChecker, ttl time.Duration) *CachedHealthChecker {
+	return &CachedHealthChecker{checker: checker, ttl: ttl}
+}
+
+// Health returns the last health check result while it is younger than the
+// ttl, and runs a new check otherwise
+func (c *CachedHealthChecker) Health(ctx context.Context) (*database.HealthStatus, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if !c.checkedAt.IsZero() && time.Since(c.checkedAt) < c.ttl {
+		return c.status, c.err
+	}
+
+	c.status, c.err = c.checker.Health(ctx)
+	c.checkedAt = time.Now()
+	return c.status, c.err
+}
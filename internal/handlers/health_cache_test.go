@@ -0,0 +1,89 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/npmulder/resume-api/internal/database"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// countingDatabase is a DatabaseHealthChecker that counts its checks
+type countingDatabase struct {
+	calls atomic.Int32
+	err   error
+}
+
+func (c *countingDatabase) Health(ctx context.Context) (*database.HealthStatus, error) {
+	c.calls.Add(1)
+	// Keep the check in flight long enough for concurrent probes to overlap
+	time.Sleep(10 * time.Millisecond)
+	if c.err != nil {
+		return &database.HealthStatus{Status: "unhealthy", Error: c.err.Error()}, c.err
+	}
+	return &database.HealthStatus{Status: "healthy"}, nil
+}
+
+func TestCachedHealthChecker(t *testing.T) {
+	t.Run("concurrent probes share one check within the ttl", func(t *testing.T) {
+		db := &countingDatabase{}
+		checker := NewCachedHealthChecker(db, time.Minute)
+
+		var wg sync.WaitGroup
+		for i := 0; i < 20; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				status, err := checker.Health(context.Background())
+				assert.NoError(t, err)
+				assert.Equal(t, "healthy", status.Status)
+			}()
+		}
+		wg.Wait()
+
+		assert.Equal(t, int32(1), db.calls.Load())
+	})
+
+	t.Run("checks again once the ttl has passed", func(t *testing.T) {
+		db := &countingDatabase{}
+		checker := NewCachedHealthChecker(db, 20*time.Millisecond)
+
+		_, err := checker.Health(context.Background())
+		require.NoError(t, err)
+		time.Sleep(30 * time.Millisecond)
+		_, err = checker.Health(context.Background())
+		require.NoError(t, err)
+
+		assert.Equal(t, int32(2), db.calls.Load())
+	})
+
+	t.Run("caches failures too", func(t *testing.T) {
+		db := &countingDatabase{err: errors.New("connection refused")}
+		checker := NewCachedHealthChecker(db, time.Minute)
+
+		for i := 0; i < 3; i++ {
+			status, err := checker.Health(context.Background())
+			assert.Error(t, err)
+			assert.Equal(t, "unhealthy", status.Status)
+		}
+
+		assert.Equal(t, int32(1), db.calls.Load())
+	})
+
+	t.Run("zero ttl checks every time", func(t *testing.T) {
+		db := &countingDatabase{}
+		checker := NewCachedHealthChecker(db, 0)
+
+		for i := 0; i < 3; i++ {
+			_, err := checker.Health(context.Background())
+			require.NoError(t, err)
+		}
+
+		assert.Equal(t, int32(3), db.calls.Load())
+	})
+}
@@ -1,20 +1,114 @@
 package handlers
 
 import (
+	"context"
 	"net/http"
+	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/npmulder/resume-api/internal/cache"
+	"github.com/npmulder/resume-api/internal/database"
+	"github.com/npmulder/resume-api/internal/models"
+	"github.com/npmulder/resume-api/internal/utils"
 )
 
+// Overall health statuses reported by the health endpoint
+const (
+	HealthStatusOK        = "ok"
+	HealthStatusDegraded  = "degraded"
+	HealthStatusUnhealthy = "unhealthy"
+)
+
+// cacheHealthTimeout bounds how long the cache probe may take
+const cacheHealthTimeout = 2 * time.Second
+
+// DatabaseHealthChecker reports the health of the database.
+// It is implemented by *database.DB.
+type DatabaseHealthChecker interface {
+	Health(ctx context.Context) (*database.HealthStatus, error)
+}
+
+// HealthHandler handles the health check requests.
+type HealthHandler struct {
+	db        DatabaseHealthChecker
+	cache     cache.Cache
+	version   string
+	startedAt time.Time
+}
+
+// HealthHandlerOption configures a HealthHandler created by NewHealthHandler
+type HealthHandlerOption func(*HealthHandler)
+
+// WithBuildInfo reports version as the API version and measures uptime from
+// startedAt, the time the process started
+func WithBuildInfo(version string, startedAt time.Time) HealthHandlerOption {
+	return func(h *HealthHandler) {
+		h.version = version
+		h.startedAt = startedAt
+	}
+}
+
+// NewHealthHandler creates a new HealthHandler. Without WithBuildInfo,
+// uptime is measured from when the handler was created.
+func NewHealthHandler(db DatabaseHealthChecker, cache cache.Cache, opts ...HealthHandlerOption) *HealthHandler {
+	h := &HealthHandler{db: db, cache: cache, startedAt: time.Now()}
+	for _, opt := range opts {
+		opt(h)
+	}
+	return h
+}
+
 // HealthCheck handles the request to check the health of the service.
+// The service is unhealthy when the database is down and degraded when only the cache is down.
 // @Summary Health check
-// @Description Check if the service is up and running
+// @Description Report the overall status, build version and uptime in seconds of the service, and the health of its database and cache
 // @Tags health
 // @Accept json
 // @Produce json
-// @Success 200 {object} map[string]string "Service is healthy"
+// @Success 200 {object} models.HealthResponse "Service is healthy or degraded"
+// @Failure 503 {object} models.HealthResponse "Service is unhealthy"
 // @Router /health [get]
-// @Response 200 {object} map[string]string "Example response" {"status":"ok"}
-func HealthCheck(c *gin.Context) {
-	c.JSON(http.StatusOK, gin.H{"status": "ok"})
+// @Response 200 {object} models.HealthResponse "Example response" {"status":"ok","version":"1.4.0","uptime":86400,"dependencies":{"database":{"status":"healthy","timestamp":"2023-01-01T00:00:00Z","response_time":1200000,"connections":{"total":2,"idle":1,"used":1,"maximum":25,"acquiring":0}},"cache":{"status":"healthy","response_time":350000}}}
+func (h *HealthHandler) HealthCheck(c *gin.Context) {
+	ctx := c.Request.Context()
+	response := models.HealthResponse{
+		Status:  HealthStatusOK,
+		Version: h.version,
+		Uptime:  int64(time.Since(h.startedAt).Seconds()),
+	}
+
+	dbStatus, err := h.db.Health(ctx)
+	response.Dependencies.Database = dbStatus
+	if err != nil {
+		response.Status = HealthStatusUnhealthy
+	}
+
+	response.Dependencies.Cache = h.checkCache(ctx)
+	if response.Dependencies.Cache.Status != "healthy" && response.Status == HealthStatusOK {
+		response.Status = HealthStatusDegraded
+	}
+
+	status := http.StatusOK
+	if response.Status == HealthStatusUnhealthy {
+		status = http.StatusServiceUnavailable
+	}
+	utils.Respond(c, status, response)
+}
+
+// checkCache pings the cache with a timeout and reports its status
+func (h *HealthHandler) checkCache(ctx context.Context) *models.CacheHealth {
+	ctx, cancel := context.WithTimeout(ctx, cacheHealthTimeout)
+	defer cancel()
+
+	start := time.Now()
+	err := h.cache.Ping(ctx)
+	status := &models.CacheHealth{
+		Status:       "healthy",
+		ResponseTime: time.Since(start),
+	}
+	if err != nil {
+		status.Status = "unhealthy"
+		status.Error = err.Error()
+	}
+	return status
 }
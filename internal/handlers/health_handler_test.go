@@ -0,0 +1,111 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/npmulder/resume-api/internal/cache"
+	"github.com/npmulder/resume-api/internal/database"
+	"github.com/npmulder/resume-api/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubDatabase is a DatabaseHealthChecker that returns a fixed result
+type stubDatabase struct {
+	err error
+}
+
+func (s *stubDatabase) Health(ctx context.Context) (*database.HealthStatus, error) {
+	if s.err != nil {
+		return &database.HealthStatus{Status: "unhealthy", Error: s.err.Error()}, s.err
+	}
+	return &database.HealthStatus{Status: "healthy"}, nil
+}
+
+// failingCache is a cache whose Ping always fails
+type failingCache struct {
+	cache.NoOpCache
+}
+
+func (c *failingCache) Ping(ctx context.Context) error {
+	return errors.New("connection refused")
+}
+
+func performHealthCheck(t *testing.T, handler *HealthHandler) (*httptest.ResponseRecorder, models.HealthResponse) {
+	t.Helper()
+
+	router := setupRouter()
+	router.GET("/health", handler.HealthCheck)
+
+	req := httptest.NewRequest(http.MethodGet, "/health", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	var response models.HealthResponse
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	return w, response
+}
+
+func TestHealthCheck(t *testing.T) {
+	t.Run("healthy", func(t *testing.T) {
+		handler := NewHealthHandler(&stubDatabase{}, cache.NewNoOpCache())
+
+		w, response := performHealthCheck(t, handler)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, HealthStatusOK, response.Status)
+		assert.Equal(t, "healthy", response.Dependencies.Database.Status)
+		assert.Equal(t, "healthy", response.Dependencies.Cache.Status)
+	})
+
+	t.Run("cache down degrades status", func(t *testing.T) {
+		handler := NewHealthHandler(&stubDatabase{}, &failingCache{})
+
+		w, response := performHealthCheck(t, handler)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, HealthStatusDegraded, response.Status)
+		assert.Equal(t, "healthy", response.Dependencies.Database.Status)
+		assert.Equal(t, "unhealthy", response.Dependencies.Cache.Status)
+		assert.Contains(t, response.Dependencies.Cache.Error, "connection refused")
+		assert.Less(t, response.Dependencies.Cache.ResponseTime, cacheHealthTimeout+time.Second)
+	})
+
+	t.Run("database down is unhealthy", func(t *testing.T) {
+		handler := NewHealthHandler(&stubDatabase{err: errors.New("dial error")}, &failingCache{})
+
+		w, response := performHealthCheck(t, handler)
+
+		assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+		assert.Equal(t, HealthStatusUnhealthy, response.Status)
+		assert.Equal(t, "unhealthy", response.Dependencies.Database.Status)
+	})
+	t.Run("documented fields", func(t *testing.T) {
+		startedAt := time.Now().Add(-90 * time.Second)
+		handler := NewHealthHandler(&stubDatabase{}, cache.NewNoOpCache(), WithBuildInfo("1.4.0", startedAt))
+
+		w, response := performHealthCheck(t, handler)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "1.4.0", response.Version)
+		assert.GreaterOrEqual(t, response.Uptime, int64(90))
+		assert.Less(t, response.Uptime, int64(120))
+
+		var body map[string]json.RawMessage
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+		for _, field := range []string{"status", "version", "uptime", "dependencies"} {
+			assert.Contains(t, body, field)
+		}
+		var dependencies map[string]json.RawMessage
+		require.NoError(t, json.Unmarshal(body["dependencies"], &dependencies))
+		assert.Contains(t, dependencies, "database")
+		assert.Contains(t, dependencies, "cache")
+	})
+}
@@ -0,0 +1,95 @@
+package handlers
+
+import (
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/npmulder/resume-api/internal/utils"
+)
+
+// RouteInfo describes one endpoint in the route catalog
+type RouteInfo struct {
+	Method      string `json:"method" example:"GET"`
+	Path        string `json:"path" example:"/api/v1/profile"`
+	Description string `json:"description,omitempty" example:"Get user profile"`
+}
+
+// RouteCatalog lists the endpoints available under an API version
+type RouteCatalog struct {
+	Routes []RouteInfo `json:"routes"`
+}
+
+// routeDescriptions holds a brief description of each v1 route, keyed by
+// method and route path relative to the version prefix. Routes missing here
+// are still listed, just without a description.
+var routeDescriptions = map[string]string{
+	"GET ":                                     "List the available endpoints",
+	"GET /profile":                             "Get user profile",
+	"PUT /profile":                             "Update user profile",
+	"GET /profile/summary":                     "Get plaintext profile summary",
+	"GET /profile/completeness":                "Get profile completeness",
+	"GET /profile/history":                     "Get profile history",
+	"GET /profile/diff":                        "Get profile diff",
+	"GET /experiences":                         "Get work experiences",
+	"GET /experiences/tenure":                  "Get tenure summary",
+	"GET /skills":                              "Get skills",
+	"GET /skills/levels":                       "Get skill level histogram",
+	"GET /skills/:name/projects":               "Get projects using a skill",
+	"PUT /skills/category":                     "Rename a skill category",
+	"GET /achievements":                        "Get achievements",
+	"GET /education":                           "Get education",
+	"GET /education/institutions":              "Get education institutions",
+	"GET /education/credential/:id":            "Get certification by credential ID",
+	"GET /projects":                            "Get projects",
+	"DELETE /projects":                         "Delete all projects",
+	"GET /projects/:id/similar":                "Get similar projects",
+	"GET /recent":                              "Get recently updated items",
+	"GET /stats":                               "Get resume stats",
+	"GET /meta":                                "Get section timestamps",
+	"GET /search":                              "Search the resume",
+	"GET /export":                              "Export changed entries",
+	"GET /resume.html":                         "Get print-ready HTML resume",
+	"POST /validate":                           "Validate a resume payload",
+	"POST /contact":                            "Send a contact message",
+	"POST /batch":                              "Batch read requests",
+	"DELETE /admin/cache/:entity":              "Invalidate cached entity",
+	"GET /admin/webhooks/failures":             "List failed webhook deliveries",
+	"POST /admin/webhooks/failures/:id/replay": "Replay a failed webhook delivery",
+}
+
+// RouteIndex returns a handler listing the routes registered on engine under
+// prefix. The catalog is read from the router on each request, so it covers
+// routes registered after the index itself and those behind feature flags.
+// @Summary List available endpoints
+// @Description Get a catalog of the endpoints this API version serves, with their methods and a brief description
+// @Tags meta
+// @Produce json
+// @Success 200 {object} handlers.RouteCatalog "Available endpoints"
+// @Router /api/v1 [get]
+func RouteIndex(engine *gin.Engine, prefix string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		routes := []RouteInfo{}
+		for _, route := range engine.Routes() {
+			relative, ok := strings.CutPrefix(route.Path, prefix)
+			if !ok || (relative != "" && !strings.HasPrefix(relative, "/")) {
+				continue
+			}
+			routes = append(routes, RouteInfo{
+				Method:      route.Method,
+				Path:        route.Path,
+				Description: routeDescriptions[route.Method+" "+relative],
+			})
+		}
+		sort.Slice(routes, func(i, j int) bool {
+			if routes[i].Path != routes[j].Path {
+				return routes[i].Path < routes[j].Path
+			}
+			return routes[i].Method < routes[j].Method
+		})
+
+		utils.Respond(c, http.StatusOK, RouteCatalog{Routes: routes})
+	}
+}
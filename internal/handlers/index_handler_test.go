@@ -0,0 +1,46 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRouteIndex(t *testing.T) {
+	// Setup
+	router := setupRouter()
+	noop := func(c *gin.Context) {}
+	router.GET("/health", noop)
+	v1 := router.Group("/api/v1")
+	v1.GET("", RouteIndex(router, "/api/v1"))
+	v1.GET("/profile", noop)
+	v1.PUT("/profile", noop)
+	v1.GET("/skills", noop)
+	v1.GET("/skills/:name/projects", noop)
+	router.GET("/api/v10/profile", noop)
+
+	// Create request
+	req := httptest.NewRequest(http.MethodGet, "/api/v1", nil)
+	w := httptest.NewRecorder()
+
+	// Serve request
+	router.ServeHTTP(w, req)
+
+	// Assert response
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response RouteCatalog
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, []RouteInfo{
+		{Method: http.MethodGet, Path: "/api/v1", Description: "List the available endpoints"},
+		{Method: http.MethodGet, Path: "/api/v1/profile", Description: "Get user profile"},
+		{Method: http.MethodPut, Path: "/api/v1/profile", Description: "Update user profile"},
+		{Method: http.MethodGet, Path: "/api/v1/skills", Description: "Get skills"},
+		{Method: http.MethodGet, Path: "/api/v1/skills/:name/projects", Description: "Get projects using a skill"},
+	}, response.Routes)
+}
@@ -0,0 +1,31 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/swaggo/swag"
+
+	"github.com/npmulder/resume-api/internal/utils"
+)
+
+// OpenAPIHandler returns a handler serving the raw OpenAPI document generated
+// by swag, independent of the Swagger UI route, for client code generation
+// @Summary OpenAPI specification
+// @Description Retrieve the raw OpenAPI (Swagger 2.0) document describing this API
+// @Tags docs
+// @Produce json
+// @Success 200 {object} object "OpenAPI document"
+// @Failure 404 {object} models.APIError "Not found"
+// @Router /openapi.json [get]
+func OpenAPIHandler() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		doc, err := swag.ReadDoc()
+		if err != nil {
+			// The docs package only registers the spec once `make swagger` has run
+			utils.NotFound(c, "OpenAPI specification has not been generated")
+			return
+		}
+		c.Data(http.StatusOK, "application/json; charset=utf-8", []byte(doc))
+	}
+}
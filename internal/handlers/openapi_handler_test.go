@@ -0,0 +1,58 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/swaggo/swag"
+)
+
+// stubSpec is a minimal OpenAPI document registered in place of the generated docs
+type stubSpec struct{}
+
+func (stubSpec) ReadDoc() string {
+	return `{"swagger":"2.0","info":{"title":"Resume API"},"paths":{"/api/v1/profile":{"get":{}}}}`
+}
+
+func TestOpenAPIHandler(t *testing.T) {
+	// Setup
+	router := setupRouter()
+
+	// Setup route
+	router.GET("/openapi.json", OpenAPIHandler())
+
+	t.Run("not generated", func(t *testing.T) {
+		// Create request
+		req := httptest.NewRequest(http.MethodGet, "/openapi.json", nil)
+		w := httptest.NewRecorder()
+
+		// Serve request
+		router.ServeHTTP(w, req)
+
+		// Assert response
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("serves the registered spec", func(t *testing.T) {
+		swag.Register(swag.Name, stubSpec{})
+
+		// Create request
+		req := httptest.NewRequest(http.MethodGet, "/openapi.json", nil)
+		w := httptest.NewRecorder()
+
+		// Serve request
+		router.ServeHTTP(w, req)
+
+		// Assert response
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "application/json; charset=utf-8", w.Header().Get("Content-Type"))
+
+		var spec map[string]any
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &spec))
+		assert.Contains(t, spec, "paths")
+	})
+}
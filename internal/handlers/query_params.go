@@ -0,0 +1,64 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/npmulder/resume-api/internal/export"
+	"github.com/npmulder/resume-api/internal/repository"
+	"github.com/npmulder/resume-api/internal/utils"
+)
+
+// QueryParams returns the query parameters each v1 route registered under
+// prefix accepts, keyed by method and route path. Every route is listed, with
+// nil for routes taking no parameters. List parameters are read from the
+// structs the handlers bind, so the two can't drift apart.
+func QueryParams(prefix string) map[string][]string {
+	params := map[string][]string{
+		"":                          nil,
+		"/profile":                  {utils.FieldsQueryParam},
+		"/profile/summary":          nil,
+		"/profile/history":          nil,
+		"/profile/diff":             utils.QueryParamNames(ProfileDiffQuery{}),
+		"/profile/completeness":     nil,
+		"/experiences":              append(utils.QueryParamNames(repository.ExperienceFilters{}), utils.FieldsQueryParam),
+		"/experiences/tenure":       nil,
+		"/skills":                   append(utils.QueryParamNames(repository.SkillFilters{}), utils.FieldsQueryParam),
+		"/skills/levels":            nil,
+		"/skills/:name/projects":    nil,
+		"/achievements":             append(utils.QueryParamNames(repository.AchievementFilters{}), utils.FieldsQueryParam),
+		"/education":                append(utils.QueryParamNames(repository.EducationFilters{}), utils.FieldsQueryParam),
+		"/education/institutions":   nil,
+		"/education/credential/:id": nil,
+		"/projects":                 append(utils.QueryParamNames(repository.ProjectFilters{}), utils.FieldsQueryParam),
+		"/projects/:id/similar":     utils.QueryParamNames(SimilarProjectsQuery{}),
+		"/recent":                   append(utils.QueryParamNames(RecentQuery{}), utils.FieldsQueryParam),
+		"/stats":                    nil,
+		"/meta":                     nil,
+		"/search":                   utils.QueryParamNames(SearchQuery{}),
+		"/export":                   utils.QueryParamNames(ExportQuery{}),
+		"/resume.html":              {export.DateFormatQueryParam},
+		"/admin/webhooks/failures":  nil,
+	}
+
+	registry := make(map[string][]string)
+	for path, names := range params {
+		registry[http.MethodGet+" "+prefix+path] = names
+	}
+
+	// Routes for the other methods mostly take their input from the body
+	for route, names := range map[string][]string{
+		http.MethodPut + " /profile":                             nil,
+		http.MethodPut + " /skills/category":                     nil,
+		http.MethodDelete + " /projects":                         {"confirm"},
+		http.MethodDelete + " /admin/cache/:entity":              nil,
+		http.MethodPost + " /validate":                           nil,
+		http.MethodPost + " /contact":                            nil,
+		http.MethodPost + " /batch":                              nil,
+		http.MethodPost + " /admin/webhooks/failures/:id/replay": nil,
+	} {
+		method, path, _ := strings.Cut(route, " ")
+		registry[method+" "+prefix+path] = names
+	}
+	return registry
+}
@@ -0,0 +1,20 @@
+package handlers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQueryParams(t *testing.T) {
+	registry := QueryParams("/api/v1")
+
+	assert.Subset(t, registry["GET /api/v1/projects"], []string{"status", "technology", "featured", "started_after", "fallback", "limit", "offset", "fields"})
+	assert.Subset(t, registry["GET /api/v1/experiences"], []string{"company", "highlight", "fields"})
+	assert.Equal(t, []string{"q"}, registry["GET /api/v1/search"])
+	assert.Equal(t, []string{"since"}, registry["GET /api/v1/export"])
+	assert.Equal(t, []string{"limit"}, registry["GET /api/v1/projects/:id/similar"])
+	assert.Equal(t, []string{"confirm"}, registry["DELETE /api/v1/projects"])
+	assert.Contains(t, registry, "GET /api/v1/stats")
+	assert.Empty(t, registry["GET /api/v1/stats"])
+}
@@ -1,10 +1,18 @@
 package handlers
 
 import (
+	"bytes"
 	"errors"
+	"fmt"
 	"net/http"
+	"net/url"
+	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/npmulder/resume-api/internal/export"
+	"github.com/npmulder/resume-api/internal/middleware"
+	"github.com/npmulder/resume-api/internal/models"
 	"github.com/npmulder/resume-api/internal/repository"
 	"github.com/npmulder/resume-api/internal/services"
 	"github.com/npmulder/resume-api/internal/utils"
@@ -13,11 +21,61 @@ import (
 // ResumeHandler handles the HTTP requests for the resume data.
 type ResumeHandler struct {
 	service services.ResumeService
+	// snapshot serves the full resume while it can't be read; nil disables it
+	snapshot *ResumeSnapshot
+	// maskContact hides the profile's email and phone from requests without apiKey
+	maskContact bool
+	apiKey      string
+	// maxPageSize caps the limit of list requests; 0 disables the cap
+	maxPageSize int
+	// publicBaseURL is the external base URL of absolute links; when empty it
+	// is derived from each request
+	publicBaseURL string
+}
+
+// ResumeHandlerOption configures a ResumeHandler created by NewResumeHandler
+type ResumeHandlerOption func(*ResumeHandler)
+
+// WithResumeSnapshot serves the full resume from snapshot when reading it
+// fails, refreshing the snapshot after every successful read
+func WithResumeSnapshot(snapshot *ResumeSnapshot) ResumeHandlerOption {
+	return func(h *ResumeHandler) {
+		h.snapshot = snapshot
+	}
+}
+
+// WithContactMasking partly hides the profile's email address and phone
+// number, e.g. j***@example.com, from requests that don't carry apiKey
+func WithContactMasking(apiKey string) ResumeHandlerOption {
+	return func(h *ResumeHandler) {
+		h.maskContact = true
+		h.apiKey = apiKey
+	}
+}
+
+// WithPublicBaseURL builds absolute links from baseURL, e.g.
+// https://api.example.com, instead of the request's forwarding headers
+func WithPublicBaseURL(baseURL string) ResumeHandlerOption {
+	return func(h *ResumeHandler) {
+		h.publicBaseURL = baseURL
+	}
+}
+
+// WithMaxPageSize lowers list limits above max to max, flagging the response
+// with a Warning header, so a huge limit can't load and serialize every row
+func WithMaxPageSize(max int) ResumeHandlerOption {
+	return func(h *ResumeHandler) {
+		h.maxPageSize = max
+	}
 }
 
 // NewResumeHandler creates a new ResumeHandler.
-func NewResumeHandler(service services.ResumeService) *ResumeHandler {
-	return &ResumeHandler{service: service}
+func NewResumeHandler(service services.ResumeService, opts ...ResumeHandlerOption) *ResumeHandler {
+	h := &ResumeHandler{service: service}
+	for _, opt := range opts {
+		opt(h)
+	}
+	return h
 }
 
 // GetProfile handles the request to get the user's profile.
@@ -26,7 +84,10 @@ func NewResumeHandler(service services.ResumeService) *ResumeHandler {
 // @Tags profile
 // @Accept json
 // @Produce json
+// @Param fields query string false "Comma-separated list of fields to include"
+// @Param X-API-Key header string false "API key; shows the full email and phone when contact masking is enabled"
 // @Success 200 {object} models.Profile
+// @Header 200 {string} ETag "Version of the profile, for If-Match on updates"
 // @Failure 404 {object} models.APIError "Not found"
 // @Failure 500 {object} models.APIError "Internal server error"
 // @Router /api/v1/profile [get]
@@ -41,7 +102,243 @@ func (h *ResumeHandler) GetProfile(c *gin.Context) {
 		utils.HandleError(c, err)
 		return
 	}
-	c.JSON(http.StatusOK, profile)
+	c.Header("ETag", utils.ETag(profile.ID, profile.UpdatedAt))
+	utils.JSONWithFields(c, http.StatusOK, h.publicProfile(c, profile))
+}
+
+// publicProfile returns profile as the request may see it: masked when
+// contact masking is enabled and the request doesn't carry the API key. Every
+// handler responding with the profile, on its own or as part of the resume,
+// passes it through here.
+func (h *ResumeHandler) publicProfile(c *gin.Context, profile *models.Profile) *models.Profile {
+	if !h.maskContact || profile == nil {
+		return profile
+	}
+	// Shared caches must keep the masked and full responses apart
+	c.Writer.Header().Add("Vary", middleware.APIKeyHeader)
+	if middleware.HasAPIKey(c, h.apiKey) {
+		return profile
+	}
+	return profile.Masked()
+}
+
+// absoluteURL resolves ref against the API's external base URL when it is a
+// path on this host, such as /files/cert.pdf. Absolute URLs are unchanged.
+func (h *ResumeHandler) absoluteURL(c *gin.Context, ref string) string {
+	if !strings.HasPrefix(ref, "/") || strings.HasPrefix(ref, "//") {
+		return ref
+	}
+	return utils.BaseURL(c, h.publicBaseURL) + ref
+}
+
+// publicEducation returns education with relative credential URLs made
+// absolute. Entries needing a change are copied, so cached values are left
+// untouched.
+func (h *ResumeHandler) publicEducation(c *gin.Context, education []*models.Education) []*models.Education {
+	resolved, copied := education, false
+	for i, entry := range education {
+		if entry.CredentialURL == nil {
+			continue
+		}
+		absolute := h.absoluteURL(c, *entry.CredentialURL)
+		if absolute == *entry.CredentialURL {
+			continue
+		}
+		if !copied {
+			resolved, copied = append([]*models.Education(nil), education...), true
+		}
+		entryCopy := *entry
+		entryCopy.CredentialURL = &absolute
+		resolved[i] = &entryCopy
+	}
+	return resolved
+}
+
+// GetProfileSummary handles the request to get a plaintext profile summary.
+// @Summary Get plaintext profile summary
+// @Description Render the profile as "name — title" followed by the summary, for terminals and email signatures
+// @Tags profile
+// @Produce plain
+// @Success 200 {string} string "Profile summary"
+// @Failure 404 {object} models.APIError "Not found"
+// @Failure 500 {object} models.APIError "Internal server error"
+// @Router /api/v1/profile/summary [get]
+func (h *ResumeHandler) GetProfileSummary(c *gin.Context) {
+	profile, err := h.service.GetProfile(c.Request.Context())
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			utils.NotFound(c, "Profile not found")
+			return
+		}
+		utils.HandleError(c, err)
+		return
+	}
+
+	summary := profile.Name + " — " + profile.Title + "\n"
+	if profile.Summary != nil && *profile.Summary != "" {
+		summary += *profile.Summary + "\n"
+	}
+	c.String(http.StatusOK, summary)
+}
+
+// UpdateProfileRequest defines the body of a profile update
+type UpdateProfileRequest struct {
+	Name     string  `json:"name" binding:"required,max=255"`
+	Title    string  `json:"title" binding:"required,max=255"`
+	Email    string  `json:"email" binding:"required,email,max=255"`
+	Phone    *string `json:"phone" binding:"omitempty,max=50"`
+	Location *string `json:"location" binding:"omitempty,max=255"`
+	LinkedIn *string `json:"linkedin" binding:"omitempty,max=255"`
+	GitHub   *string `json:"github" binding:"omitempty,max=255"`
+	Summary  *string `json:"summary"`
+}
+
+// UpdateProfile handles the request to replace the user's profile.
+// The If-Match header must carry the ETag of the version being replaced, so
+// that concurrent edits are rejected rather than silently overwritten.
+// @Summary Update user profile
+// @Description Replace the user's profile. Requires an API key and an If-Match header with the ETag from the last read.
+// @Tags profile
+// @Accept json
+// @Produce json
+// @Param X-API-Key header string true "API key"
+// @Param If-Match header string true "ETag of the profile version being replaced"
+// @Param profile body UpdateProfileRequest true "Profile"
+// @Success 200 {object} models.Profile
+// @Header 200 {string} ETag "Version of the updated profile"
+// @Failure 400 {object} models.APIError "Bad request"
+// @Failure 401 {object} models.APIError "Unauthorized"
+// @Failure 404 {object} models.APIError "Not found"
+// @Failure 412 {object} models.APIError "Profile changed since it was read"
+// @Failure 428 {object} models.APIError "If-Match header missing"
+// @Failure 500 {object} models.APIError "Internal server error"
+// @Router /api/v1/profile [put]
+func (h *ResumeHandler) UpdateProfile(c *gin.Context) {
+	ifMatch := c.GetHeader("If-Match")
+	if ifMatch == "" {
+		utils.PreconditionRequired(c, "If-Match header is required")
+		return
+	}
+
+	var request UpdateProfileRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		utils.ValidationError(c, "Invalid request body", err.Error())
+		return
+	}
+
+	current, err := h.service.GetProfile(c.Request.Context())
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			utils.NotFound(c, "Profile not found")
+			return
+		}
+		utils.HandleError(c, err)
+		return
+	}
+	// The version comes from the client's tag rather than the read above,
+	// which may be cached or lag behind on a replica; the repository checks
+	// it against the primary as part of the update
+	version, ok := utils.IfMatchVersion(ifMatch, current.ID)
+	if !ok {
+		utils.PreconditionFailed(c, "Profile has changed since it was read")
+		return
+	}
+
+	profile := &models.Profile{
+		ID:        current.ID,
+		Name:      request.Name,
+		Title:     request.Title,
+		Email:     request.Email,
+		Phone:     request.Phone,
+		Location:  request.Location,
+		LinkedIn:  request.LinkedIn,
+		GitHub:    request.GitHub,
+		Summary:   request.Summary,
+		CreatedAt: current.CreatedAt,
+		// The update only applies while the stored profile is still this
+		// version; If-Match: * leaves it zero to accept any version
+		UpdatedAt: version,
+	}
+	if err := h.service.UpdateProfile(c.Request.Context(), profile); err != nil {
+		switch {
+		case errors.Is(err, repository.ErrNotFound):
+			utils.NotFound(c, "Profile not found")
+		case errors.Is(err, repository.ErrPreconditionFailed):
+			utils.PreconditionFailed(c, "Profile has changed since it was read")
+		default:
+			utils.HandleError(c, err)
+		}
+		return
+	}
+
+	c.Header("ETag", utils.ETag(profile.ID, profile.UpdatedAt))
+	utils.Respond(c, http.StatusOK, profile)
+}
+
+// GetProfileHistory handles the request to get past versions of the user's profile.
+// @Summary Get profile history
+// @Description Retrieve the versions of the profile replaced by updates, newest first. Requires an API key.
+// @Tags profile
+// @Accept json
+// @Produce json
+// @Param X-API-Key header string true "API key"
+// @Success 200 {array} models.ProfileVersion
+// @Failure 401 {object} models.APIError "Unauthorized"
+// @Failure 500 {object} models.APIError "Internal server error"
+// @Router /api/v1/profile/history [get]
+// @Response 200 {array} models.ProfileVersion "Example response" [{"id":2,"profile_id":1,"name":"John Doe","title":"Software Engineer","email":"john.doe@example.com","updated_at":"2023-06-01T00:00:00Z","replaced_at":"2024-01-01T00:00:00Z"}]
+func (h *ResumeHandler) GetProfileHistory(c *gin.Context) {
+	versions, err := h.service.GetProfileHistory(c.Request.Context())
+	if err != nil {
+		utils.HandleError(c, err)
+		return
+	}
+	utils.Respond(c, http.StatusOK, versions)
+}
+
+// ProfileDiffQuery defines the query parameters of the profile diff
+type ProfileDiffQuery struct {
+	From time.Time `form:"from" time_format:"2006-01-02T15:04:05Z07:00" binding:"required"`
+	To   time.Time `form:"to" time_format:"2006-01-02T15:04:05Z07:00" binding:"required"`
+}
+
+// GetProfileDiff handles the request to compare the profile at two points in time.
+// @Summary Get profile diff
+// @Description Compare the profile as it was at two points in time, field by field, using the profile history. A field that had no value is reported with a null old or new value. Requires an API key.
+// @Tags profile
+// @Accept json
+// @Produce json
+// @Param X-API-Key header string true "API key"
+// @Param from query string true "RFC 3339 timestamp of the earlier state"
+// @Param to query string true "RFC 3339 timestamp of the later state"
+// @Success 200 {object} models.ProfileDiff
+// @Failure 400 {object} models.APIError "Bad request"
+// @Failure 401 {object} models.APIError "Unauthorized"
+// @Failure 404 {object} models.APIError "Profile not found"
+// @Failure 500 {object} models.APIError "Internal server error"
+// @Router /api/v1/profile/diff [get]
+// @Response 200 {object} models.ProfileDiff "Example response" {"from":"2023-06-01T00:00:00Z","to":"2024-06-01T00:00:00Z","changes":[{"field":"title","old":"Software Engineer","new":"Senior Software Engineer"},{"field":"github","old":null,"new":"https://github.com/johndoe"}]}
+func (h *ResumeHandler) GetProfileDiff(c *gin.Context) {
+	var query ProfileDiffQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		utils.ValidationError(c, "Invalid query parameters", err.Error())
+		return
+	}
+	if query.From.After(query.To) {
+		utils.ValidationError(c, "Invalid query parameters", "from must not be after to")
+		return
+	}
+
+	diff, err := h.service.GetProfileDiff(c.Request.Context(), query.From, query.To)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			utils.NotFound(c, "Profile not found")
+			return
+		}
+		utils.HandleError(c, err)
+		return
+	}
+	utils.Respond(c, http.StatusOK, diff)
 }
 
 // GetExperiences handles the request to get the user's work experiences.
@@ -55,11 +352,13 @@ func (h *ResumeHandler) GetProfile(c *gin.Context) {
 // @Param date_from query string false "Filter by start date (ISO format)"
 // @Param date_to query string false "Filter by end date (ISO format)"
 // @Param is_current query boolean false "Filter for current positions"
+// @Param highlight query string false "Filter by text contained in a highlight"
 // @Param limit query int false "Limit number of results"
 // @Param offset query int false "Offset for pagination"
+// @Param fields query string false "Comma-separated list of fields to include in each item"
 // @Success 200 {array} models.Experience
+// @Header 200 {string} Warning "Set when the limit was lowered to the maximum page size"
 // @Failure 400 {object} models.APIError "Bad request"
-// @Failure 404 {object} models.APIError "Not found"
 // @Failure 500 {object} models.APIError "Internal server error"
 // @Router /api/v1/experiences [get]
 // @Response 200 {array} models.Experience "Example response" [{"id":1,"company":"Tech Innovations Inc.","position":"Senior Software Engineer","start_date":"2020-01-01T00:00:00Z","end_date":null,"description":"Led development of cloud-native applications","highlights":["Implemented CI/CD pipeline","Reduced deployment time by 50%","Mentored junior developers"],"order_index":1,"is_current":true,"location":"San Francisco, CA","created_at":"2023-01-01T00:00:00Z","updated_at":"2023-01-01T00:00:00Z"},{"id":2,"company":"Digital Solutions LLC","position":"Software Developer","start_date":"2017-06-01T00:00:00Z","end_date":"2019-12-31T00:00:00Z","description":"Worked on backend services for e-commerce platform","highlights":["Developed RESTful APIs","Optimized database queries","Implemented payment processing integration"],"order_index":2,"is_current":false,"location":"New York, NY","created_at":"2023-01-01T00:00:00Z","updated_at":"2023-01-01T00:00:00Z"}]
@@ -69,17 +368,102 @@ func (h *ResumeHandler) GetExperiences(c *gin.Context) {
 		utils.ValidationError(c, "Invalid query parameters", err.Error())
 		return
 	}
+	h.clampLimit(c, &filters.Limit)
 
 	experiences, err := h.service.GetExperiences(c.Request.Context(), filters)
 	if err != nil {
-		if errors.Is(err, repository.ErrNotFound) {
-			utils.NotFound(c, "No experiences found matching the criteria")
-			return
-		}
 		utils.HandleError(c, err)
 		return
 	}
-	c.JSON(http.StatusOK, experiences)
+	utils.JSONWithFields(c, http.StatusOK, experiences)
+}
+
+// clampLimit lowers limit to the maximum page size when it exceeds it and
+// adds a Warning header saying so
+func (h *ResumeHandler) clampLimit(c *gin.Context, limit *int) {
+	if h.maxPageSize <= 0 || *limit <= h.maxPageSize {
+		return
+	}
+	c.Header("Warning", fmt.Sprintf(`299 - "limit %d exceeds the maximum page size; clamped to %d"`, *limit, h.maxPageSize))
+	*limit = h.maxPageSize
+}
+
+// GetTenure handles the request to get the user's tenure summary.
+// @Summary Get tenure summary
+// @Description Retrieve the total months worked, months per company and average tenure, counting overlapping roles once and current roles until now
+// @Tags experiences
+// @Accept json
+// @Produce json
+// @Success 200 {object} models.TenureSummary
+// @Failure 500 {object} models.APIError "Internal server error"
+// @Router /api/v1/experiences/tenure [get]
+// @Response 200 {object} models.TenureSummary "Example response" {"total_months":96,"average_tenure_months":48,"companies":[{"company":"Tech Corp","months":60},{"company":"Startup Inc","months":36}]}
+func (h *ResumeHandler) GetTenure(c *gin.Context) {
+	summary, err := h.service.GetTenureSummary(c.Request.Context())
+	if err != nil {
+		utils.HandleError(c, err)
+		return
+	}
+	utils.Respond(c, http.StatusOK, summary)
+}
+
+// GetStats handles the request to get headline stats about the resume.
+// @Summary Get resume stats
+// @Description Retrieve aggregate figures: whole years since the earliest experience started and the number of skills, projects and certifications
+// @Tags stats
+// @Accept json
+// @Produce json
+// @Success 200 {object} models.Stats
+// @Failure 500 {object} models.APIError "Internal server error"
+// @Router /api/v1/stats [get]
+// @Response 200 {object} models.Stats "Example response" {"years_experience":8,"skills":24,"projects":6,"certifications":3}
+func (h *ResumeHandler) GetStats(c *gin.Context) {
+	stats, err := h.service.GetStats(c.Request.Context())
+	if err != nil {
+		utils.HandleError(c, err)
+		return
+	}
+	utils.Respond(c, http.StatusOK, stats)
+}
+
+// GetMeta handles the request to get when each resume section was last
+// updated, so clients can tell whether to refetch
+// @Summary Get section timestamps
+// @Description Retrieve the last update time of each resume section (null when a section is empty) and an etag that changes whenever any of them does
+// @Tags meta
+// @Accept json
+// @Produce json
+// @Success 200 {object} models.Meta
+// @Header 200 {string} ETag "Combined version of all sections"
+// @Failure 500 {object} models.APIError "Internal server error"
+// @Router /api/v1/meta [get]
+func (h *ResumeHandler) GetMeta(c *gin.Context) {
+	meta, err := h.service.GetMeta(c.Request.Context())
+	if err != nil {
+		utils.HandleError(c, err)
+		return
+	}
+	c.Header("ETag", meta.ETag)
+	utils.Respond(c, http.StatusOK, meta)
+}
+
+// GetCompleteness handles the request to score how complete the resume is.
+// @Summary Get profile completeness
+// @Description Retrieve a 0-100 score based on which optional profile fields (summary, location, phone, links) are set and which sections have at least one entry, with the missing items
+// @Tags profile
+// @Accept json
+// @Produce json
+// @Success 200 {object} models.Completeness
+// @Failure 500 {object} models.APIError "Internal server error"
+// @Router /api/v1/profile/completeness [get]
+// @Response 200 {object} models.Completeness "Example response" {"score":77,"missing":["phone","achievements"]}
+func (h *ResumeHandler) GetCompleteness(c *gin.Context) {
+	completeness, err := h.service.GetCompleteness(c.Request.Context())
+	if err != nil {
+		utils.HandleError(c, err)
+		return
+	}
+	utils.Respond(c, http.StatusOK, completeness)
 }
 
 // GetSkills handles the request to get the user's skills.
@@ -88,14 +472,16 @@ func (h *ResumeHandler) GetExperiences(c *gin.Context) {
 // @Tags skills
 // @Accept json
 // @Produce json
-// @Param category query string false "Filter by skill category"
+// @Param category query []string false "Filter by skill category; repeat to match any of several" collectionFormat(multi)
 // @Param level query string false "Filter by skill level (beginner, intermediate, advanced, expert)"
 // @Param featured query boolean false "Filter for featured skills"
+// @Param fallback query string false "Return the most recent entries when no featured skills exist (recent)"
 // @Param limit query int false "Limit number of results"
 // @Param offset query int false "Offset for pagination"
+// @Param fields query string false "Comma-separated list of fields to include in each item"
 // @Success 200 {array} models.Skill
+// @Header 200 {string} Warning "Set when the limit was lowered to the maximum page size"
 // @Failure 400 {object} models.APIError "Bad request"
-// @Failure 404 {object} models.APIError "Not found"
 // @Failure 500 {object} models.APIError "Internal server error"
 // @Router /api/v1/skills [get]
 // @Response 200 {array} models.Skill "Example response" [{"id":1,"category":"Languages","name":"Go","level":"advanced","years_experience":5,"order_index":1,"is_featured":true,"description":"Proficient in Go development including concurrency patterns and standard library","created_at":"2023-01-01T00:00:00Z","updated_at":"2023-01-01T00:00:00Z"},{"id":2,"category":"Frameworks","name":"React","level":"intermediate","years_experience":3,"order_index":2,"is_featured":true,"description":"Experience with React and Redux for frontend development","created_at":"2023-01-01T00:00:00Z","updated_at":"2023-01-01T00:00:00Z"},{"id":3,"category":"Tools","name":"Docker","level":"expert","years_experience":6,"order_index":3,"is_featured":true,"description":"Expert in containerization and orchestration with Docker and Kubernetes","created_at":"2023-01-01T00:00:00Z","updated_at":"2023-01-01T00:00:00Z"}]
@@ -105,17 +491,66 @@ func (h *ResumeHandler) GetSkills(c *gin.Context) {
 		utils.ValidationError(c, "Invalid query parameters", err.Error())
 		return
 	}
+	h.clampLimit(c, &filters.Limit)
 
 	skills, err := h.service.GetSkills(c.Request.Context(), filters)
 	if err != nil {
-		if errors.Is(err, repository.ErrNotFound) {
-			utils.NotFound(c, "No skills found matching the criteria")
-			return
-		}
 		utils.HandleError(c, err)
 		return
 	}
-	c.JSON(http.StatusOK, skills)
+	utils.JSONWithFields(c, http.StatusOK, skills)
+}
+
+// GetSkillLevels handles the request to get the number of skills per level.
+// @Summary Get skill level histogram
+// @Description Retrieve the number of skills at each proficiency level, with skills without a level counted as unspecified
+// @Tags skills
+// @Accept json
+// @Produce json
+// @Success 200 {object} models.SkillLevelHistogram
+// @Failure 500 {object} models.APIError "Internal server error"
+// @Router /api/v1/skills/levels [get]
+// @Response 200 {object} models.SkillLevelHistogram "Example response" {"beginner":1,"intermediate":4,"advanced":6,"expert":3,"unspecified":2}
+func (h *ResumeHandler) GetSkillLevels(c *gin.Context) {
+	histogram, err := h.service.GetSkillLevels(c.Request.Context())
+	if err != nil {
+		utils.HandleError(c, err)
+		return
+	}
+	utils.Respond(c, http.StatusOK, histogram)
+}
+
+// SkillURI defines the path parameters of the skill lookups
+type SkillURI struct {
+	Name string `uri:"name" binding:"required,max=100"`
+}
+
+// GetSkillProjects handles the request to get the projects using a skill.
+// @Summary Get projects using a skill
+// @Description Retrieve the projects whose technologies include the named skill, matching the skill name regardless of case
+// @Tags skills
+// @Accept json
+// @Produce json
+// @Param name path string true "Skill name"
+// @Success 200 {array} models.Project
+// @Failure 400 {object} models.APIError "Bad request"
+// @Failure 404 {object} models.APIError "Skill not found"
+// @Failure 500 {object} models.APIError "Internal server error"
+// @Router /api/v1/skills/{name}/projects [get]
+// @Response 200 {array} models.Project "Example response" [{"id":1,"name":"Cloud-Native Resume API","description":"RESTful API for resume data with caching and metrics","short_description":"Resume API with advanced features","technologies":["Go","PostgreSQL","Docker","Redis"],"github_url":"https://github.com/username/resume-api","demo_url":"https://api.example.com","start_date":"2022-06-01T00:00:00Z","end_date":null,"status":"active","is_featured":true,"order_index":1,"key_features":["OpenAPI documentation","Redis caching"],"created_at":"2023-01-01T00:00:00Z","updated_at":"2023-01-01T00:00:00Z"}]
+func (h *ResumeHandler) GetSkillProjects(c *gin.Context) {
+	var uri SkillURI
+	if err := c.ShouldBindUri(&uri); err != nil {
+		utils.ValidationError(c, "Invalid skill name", err.Error())
+		return
+	}
+
+	projects, err := h.service.GetSkillProjects(c.Request.Context(), uri.Name)
+	if err != nil {
+		utils.HandleError(c, err)
+		return
+	}
+	utils.Respond(c, http.StatusOK, projects)
 }
 
 // GetAchievements handles the request to get the user's achievements.
@@ -123,15 +558,18 @@ func (h *ResumeHandler) GetSkills(c *gin.Context) {
 // @Description Retrieve the user's key accomplishments and achievements with optional filtering
 // @Tags achievements
 // @Accept json
-// @Produce json
-// @Param category query string false "Filter by achievement category"
+// @Param category query string false "Filter by achievement category (performance, security, leadership, innovation, efficiency, teamwork)"
 // @Param year query int false "Filter by year achieved"
+// @Param year_from query int false "Filter by year achieved on or after this year"
+// @Param year_to query int false "Filter by year achieved on or before this year"
 // @Param featured query boolean false "Filter for featured achievements"
+// @Param fallback query string false "Return the most recent entries when no featured achievements exist (recent)"
 // @Param limit query int false "Limit number of results"
 // @Param offset query int false "Offset for pagination"
+// @Param fields query string false "Comma-separated list of fields to include in each item"
 // @Success 200 {array} models.Achievement
+// @Header 200 {string} Warning "Set when the limit was lowered to the maximum page size"
 // @Failure 400 {object} models.APIError "Bad request"
-// @Failure 404 {object} models.APIError "Not found"
 // @Failure 500 {object} models.APIError "Internal server error"
 // @Router /api/v1/achievements [get]
 // @Response 200 {array} models.Achievement "Example response" [{"id":1,"title":"Performance Optimization Award","description":"Recognized for optimizing application performance by 40%","category":"performance","impact_metric":"40% reduction in response time","year_achieved":2022,"order_index":1,"is_featured":true,"created_at":"2023-01-01T00:00:00Z","updated_at":"2023-01-01T00:00:00Z"},{"id":2,"title":"Security Excellence","description":"Identified and fixed critical security vulnerabilities","category":"security","impact_metric":"Prevented potential data breach affecting 10,000+ users","year_achieved":2021,"order_index":2,"is_featured":true,"created_at":"2023-01-01T00:00:00Z","updated_at":"2023-01-01T00:00:00Z"},{"id":3,"title":"Team Leadership Award","description":"Led cross-functional team to successful product launch","category":"leadership","impact_metric":"Delivered project 2 weeks ahead of schedule","year_achieved":2020,"order_index":3,"is_featured":false,"created_at":"2023-01-01T00:00:00Z","updated_at":"2023-01-01T00:00:00Z"}]
@@ -141,17 +579,22 @@ func (h *ResumeHandler) GetAchievements(c *gin.Context) {
 		utils.ValidationError(c, "Invalid query parameters", err.Error())
 		return
 	}
+	h.clampLimit(c, &filters.Limit)
+	if filters.YearFrom != nil && filters.YearTo != nil && *filters.YearFrom > *filters.YearTo {
+		utils.ValidationError(c, "Invalid query parameters", "year_from must not be after year_to")
+		return
+	}
+	if err := models.ValidateAchievementCategory(filters.Category); err != nil {
+		utils.ValidationError(c, "Invalid query parameters", err.Error())
+		return
+	}
 
 	achievements, err := h.service.GetAchievements(c.Request.Context(), filters)
 	if err != nil {
-		if errors.Is(err, repository.ErrNotFound) {
-			utils.NotFound(c, "No achievements found matching the criteria")
-			return
-		}
 		utils.HandleError(c, err)
 		return
 	}
-	c.JSON(http.StatusOK, achievements)
+	utils.JSONWithFields(c, http.StatusOK, achievements)
 }
 
 // GetEducation handles the request to get the user's education.
@@ -164,11 +607,13 @@ func (h *ResumeHandler) GetAchievements(c *gin.Context) {
 // @Param institution query string false "Filter by institution name"
 // @Param status query string false "Filter by status (completed, in_progress, planned)"
 // @Param featured query boolean false "Filter for featured education entries"
+// @Param fallback query string false "Return the most recent entries when no featured education entries exist (recent)"
 // @Param limit query int false "Limit number of results"
 // @Param offset query int false "Offset for pagination"
+// @Param fields query string false "Comma-separated list of fields to include in each item"
 // @Success 200 {array} models.Education
+// @Header 200 {string} Warning "Set when the limit was lowered to the maximum page size"
 // @Failure 400 {object} models.APIError "Bad request"
-// @Failure 404 {object} models.APIError "Not found"
 // @Failure 500 {object} models.APIError "Internal server error"
 // @Router /api/v1/education [get]
 // @Response 200 {array} models.Education "Example response" [{"id":1,"institution":"Stanford University","degree_or_certification":"Master of Science","field_of_study":"Computer Science","year_completed":2018,"year_started":2016,"description":"Specialized in Artificial Intelligence and Machine Learning","type":"education","status":"completed","order_index":1,"is_featured":true,"degree_title":"Master of Science in Computer Science","created_at":"2023-01-01T00:00:00Z","updated_at":"2023-01-01T00:00:00Z"},{"id":2,"institution":"AWS","degree_or_certification":"AWS Certified Solutions Architect","field_of_study":"Cloud Architecture","year_completed":2021,"year_started":2021,"description":"Professional certification for designing distributed systems on AWS","type":"certification","status":"completed","credential_id":"AWS-CSA-123456","credential_url":"https://aws.amazon.com/verification","expiry_date":"2024-01-01T00:00:00Z","order_index":2,"is_featured":true,"degree_title":"AWS Certified Solutions Architect","created_at":"2023-01-01T00:00:00Z","updated_at":"2023-01-01T00:00:00Z"},{"id":3,"institution":"University of California, Berkeley","degree_or_certification":"PhD","field_of_study":"Computer Science","year_started":2022,"description":"Research focus on distributed systems and cloud computing","type":"education","status":"in_progress","order_index":3,"is_featured":false,"degree_title":"PhD in Computer Science","created_at":"2023-01-01T00:00:00Z","updated_at":"2023-01-01T00:00:00Z"}]
@@ -178,17 +623,66 @@ func (h *ResumeHandler) GetEducation(c *gin.Context) {
 		utils.ValidationError(c, "Invalid query parameters", err.Error())
 		return
 	}
+	h.clampLimit(c, &filters.Limit)
 
 	education, err := h.service.GetEducation(c.Request.Context(), filters)
 	if err != nil {
-		if errors.Is(err, repository.ErrNotFound) {
-			utils.NotFound(c, "No education records found matching the criteria")
-			return
-		}
 		utils.HandleError(c, err)
 		return
 	}
-	c.JSON(http.StatusOK, education)
+	utils.JSONWithFields(c, http.StatusOK, h.publicEducation(c, education))
+}
+
+// GetInstitutions handles the request to get the distinct institutions.
+// @Summary Get education institutions
+// @Description Retrieve the distinct institutions with the number of education entries from each, most entries first
+// @Tags education
+// @Accept json
+// @Produce json
+// @Success 200 {array} models.InstitutionCount
+// @Failure 500 {object} models.APIError "Internal server error"
+// @Router /api/v1/education/institutions [get]
+// @Response 200 {array} models.InstitutionCount "Example response" [{"institution":"AWS","count":2},{"institution":"Stanford University","count":1}]
+func (h *ResumeHandler) GetInstitutions(c *gin.Context) {
+	institutions, err := h.service.GetInstitutions(c.Request.Context())
+	if err != nil {
+		utils.HandleError(c, err)
+		return
+	}
+	utils.Respond(c, http.StatusOK, institutions)
+}
+
+// CredentialURI defines the path parameters of the credential lookup
+type CredentialURI struct {
+	ID string `uri:"id" binding:"required,max=255"`
+}
+
+// GetEducationByCredentialID handles the request to look up a certification by its credential ID.
+// @Summary Get certification by credential ID
+// @Description Retrieve the certification with the given credential ID, so verifiers can check it
+// @Tags education
+// @Accept json
+// @Produce json
+// @Param id path string true "Credential ID"
+// @Success 200 {object} models.Education
+// @Failure 400 {object} models.APIError "Bad request"
+// @Failure 404 {object} models.APIError "Certification not found"
+// @Failure 500 {object} models.APIError "Internal server error"
+// @Router /api/v1/education/credential/{id} [get]
+// @Response 200 {object} models.Education "Example response" {"id":2,"institution":"AWS","degree_or_certification":"AWS Certified Solutions Architect","field_of_study":"Cloud Architecture","year_completed":2021,"type":"certification","status":"completed","credential_id":"AWS-CSA-123456","credential_url":"https://aws.amazon.com/verification","order_index":2,"is_featured":true,"degree_title":"","created_at":"2023-01-01T00:00:00Z","updated_at":"2023-01-01T00:00:00Z"}
+func (h *ResumeHandler) GetEducationByCredentialID(c *gin.Context) {
+	var uri CredentialURI
+	if err := c.ShouldBindUri(&uri); err != nil {
+		utils.ValidationError(c, "Invalid credential ID", err.Error())
+		return
+	}
+
+	education, err := h.service.GetEducationByCredentialID(c.Request.Context(), uri.ID)
+	if err != nil {
+		utils.HandleError(c, err)
+		return
+	}
+	utils.Respond(c, http.StatusOK, h.publicEducation(c, []*models.Education{education})[0])
 }
 
 // GetProjects handles the request to get the user's projects.
@@ -199,12 +693,20 @@ func (h *ResumeHandler) GetEducation(c *gin.Context) {
 // @Produce json
 // @Param status query string false "Filter by status (active, completed, archived, planned)"
 // @Param technology query string false "Filter by technology used"
+// @Param ongoing query boolean false "Filter for active projects without an end date"
+// @Param has_demo query boolean false "Filter for projects with (true) or without (false) a demo link"
+// @Param has_repo query boolean false "Filter for projects with (true) or without (false) a repository link"
+// @Param started_after query string false "Only projects started on or after this date (YYYY-MM-DD)"
+// @Param started_before query string false "Only projects started on or before this date (YYYY-MM-DD)"
+// @Param active_during query string false "Only projects running on this date (YYYY-MM-DD)"
 // @Param featured query boolean false "Filter for featured projects"
+// @Param fallback query string false "Return the most recent entries when no featured projects exist (recent)"
 // @Param limit query int false "Limit number of results"
 // @Param offset query int false "Offset for pagination"
+// @Param fields query string false "Comma-separated list of fields to include in each item"
 // @Success 200 {array} models.Project
+// @Header 200 {string} Warning "Set when the limit was lowered to the maximum page size"
 // @Failure 400 {object} models.APIError "Bad request"
-// @Failure 404 {object} models.APIError "Not found"
 // @Failure 500 {object} models.APIError "Internal server error"
 // @Router /api/v1/projects [get]
 // @Response 200 {array} models.Project "Example response" [{"id":1,"name":"Cloud-Native Resume API","description":"RESTful API for resume data with caching and metrics","short_description":"Resume API with advanced features","technologies":["Go","PostgreSQL","Docker","Redis"],"github_url":"https://github.com/username/resume-api","demo_url":"https://api.example.com","start_date":"2022-06-01T00:00:00Z","end_date":null,"status":"active","is_featured":true,"order_index":1,"key_features":["OpenAPI documentation","Redis caching","Prometheus metrics","Distributed tracing"],"created_at":"2023-01-01T00:00:00Z","updated_at":"2023-01-01T00:00:00Z"},{"id":2,"name":"E-commerce Platform","description":"Full-stack e-commerce solution with payment processing","short_description":"Complete e-commerce solution","technologies":["React","Node.js","MongoDB","Stripe"],"github_url":"https://github.com/username/ecommerce","demo_url":"https://shop.example.com","start_date":"2021-01-01T00:00:00Z","end_date":"2021-12-31T00:00:00Z","status":"completed","is_featured":true,"order_index":2,"key_features":["User authentication","Product catalog","Shopping cart","Payment processing","Order tracking"],"created_at":"2023-01-01T00:00:00Z","updated_at":"2023-01-01T00:00:00Z"},{"id":3,"name":"AI-powered Content Analyzer","description":"Tool for analyzing and categorizing text content using NLP","short_description":"NLP-based content analysis tool","technologies":["Python","TensorFlow","Flask","AWS"],"github_url":null,"demo_url":null,"start_date":"2023-01-01T00:00:00Z","end_date":null,"status":"planned","is_featured":false,"order_index":3,"key_features":["Sentiment analysis","Topic classification","Content summarization","Language detection"],"created_at":"2023-01-01T00:00:00Z","updated_at":"2023-01-01T00:00:00Z"}]
@@ -214,15 +716,305 @@ func (h *ResumeHandler) GetProjects(c *gin.Context) {
 		utils.ValidationError(c, "Invalid query parameters", err.Error())
 		return
 	}
+	h.clampLimit(c, &filters.Limit)
+	if filters.StartedAfter != nil && filters.StartedBefore != nil && *filters.StartedAfter > *filters.StartedBefore {
+		utils.ValidationError(c, "Invalid query parameters", "started_after must not be after started_before")
+		return
+	}
 
 	projects, err := h.service.GetProjects(c.Request.Context(), filters)
+	if err != nil {
+		utils.HandleError(c, err)
+		return
+	}
+	utils.JSONWithFields(c, http.StatusOK, projects)
+}
+
+// ProjectURI defines the path parameters of single-project routes
+type ProjectURI struct {
+	ID int `uri:"id" binding:"required,min=1"`
+}
+
+// SimilarProjectsQuery defines the query parameters of the similar projects endpoint
+type SimilarProjectsQuery struct {
+	Limit int `form:"limit" binding:"omitempty,min=1,max=50"`
+}
+
+// GetSimilarProjects handles the request to get the projects most similar to a project.
+// @Summary Get similar projects
+// @Description Retrieve other projects sharing technologies with a project, most shared technologies first. Projects sharing none are left out.
+// @Tags projects
+// @Accept json
+// @Produce json
+// @Param id path int true "Project ID"
+// @Param limit query int false "Number of projects to return (default 5, max 50)"
+// @Success 200 {array} models.SimilarProject
+// @Failure 400 {object} models.APIError "Bad request"
+// @Failure 404 {object} models.APIError "Project not found"
+// @Failure 500 {object} models.APIError "Internal server error"
+// @Router /api/v1/projects/{id}/similar [get]
+// @Response 200 {array} models.SimilarProject "Example response" [{"id":2,"name":"Portfolio Site","slug":"portfolio-site","technologies":["Go","PostgreSQL"],"status":"completed","is_featured":false,"order_index":2,"key_features":[],"created_at":"2023-01-01T00:00:00Z","updated_at":"2023-01-01T00:00:00Z","shared_technologies":2}]
+func (h *ResumeHandler) GetSimilarProjects(c *gin.Context) {
+	var uri ProjectURI
+	if err := c.ShouldBindUri(&uri); err != nil {
+		utils.ValidationError(c, "Invalid project ID", err.Error())
+		return
+	}
+
+	var query SimilarProjectsQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		utils.ValidationError(c, "Invalid query parameters", err.Error())
+		return
+	}
+
+	projects, err := h.service.GetSimilarProjects(c.Request.Context(), uri.ID, query.Limit)
+	if err != nil {
+		utils.HandleError(c, err)
+		return
+	}
+	utils.Respond(c, http.StatusOK, projects)
+}
+
+// RecentQuery defines the query parameters of the recently updated feed
+type RecentQuery struct {
+	Limit int `form:"limit" binding:"omitempty,min=1,max=100"`
+}
+
+// GetRecent handles the request to get the most recently updated items across all sections.
+// @Summary Get recently updated items
+// @Description Retrieve the most recently updated items across all resume sections, newest first
+// @Tags recent
+// @Accept json
+// @Produce json
+// @Param limit query int false "Number of items to return (default 10, max 100)"
+// @Param fields query string false "Comma-separated list of fields to include in each item"
+// @Success 200 {array} models.RecentItem
+// @Failure 400 {object} models.APIError "Bad request"
+// @Failure 500 {object} models.APIError "Internal server error"
+// @Router /api/v1/recent [get]
+// @Response 200 {array} models.RecentItem "Example response" [{"type":"project","id":1,"title":"Cloud-Native Resume API","updated_at":"2023-03-01T00:00:00Z"},{"type":"skill","id":4,"title":"Go","updated_at":"2023-02-15T00:00:00Z"}]
+func (h *ResumeHandler) GetRecent(c *gin.Context) {
+	var query RecentQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		utils.ValidationError(c, "Invalid query parameters", err.Error())
+		return
+	}
+
+	items, err := h.service.GetRecentlyUpdated(c.Request.Context(), query.Limit)
+	if err != nil {
+		utils.HandleError(c, err)
+		return
+	}
+	utils.JSONWithFields(c, http.StatusOK, items)
+}
+
+// SearchQuery defines the query parameters of the search endpoint
+type SearchQuery struct {
+	Q string `form:"q" binding:"required,min=2,max=100"`
+}
+
+// Search handles the request to search every resume section for a term.
+// @Summary Search the resume
+// @Description Find entries in every section whose text contains the search term, most recently updated first. Results are capped server-side; truncated is true and total holds the full match count when the cap is hit.
+// @Tags search
+// @Accept json
+// @Produce json
+// @Param q query string true "Search term (2-100 characters)"
+// @Success 200 {object} models.SearchResults
+// @Failure 400 {object} models.APIError "Bad request"
+// @Failure 500 {object} models.APIError "Internal server error"
+// @Router /api/v1/search [get]
+// @Response 200 {object} models.SearchResults "Example response" {"results":[{"type":"project","id":1,"title":"Cloud-Native Resume API"},{"type":"skill","id":4,"title":"Go"}],"total":2,"truncated":false}
+func (h *ResumeHandler) Search(c *gin.Context) {
+	var query SearchQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		utils.ValidationError(c, "Invalid query parameters", err.Error())
+		return
+	}
+
+	results, err := h.service.Search(c.Request.Context(), query.Q)
+	if err != nil {
+		utils.HandleError(c, err)
+		return
+	}
+	utils.Respond(c, http.StatusOK, results)
+}
+
+// ExportQuery defines the query parameters of the incremental export
+type ExportQuery struct {
+	Since time.Time `form:"since" time_format:"2006-01-02T15:04:05Z07:00"`
+}
+
+// Export handles the request to export the entries changed since a point in
+// time, for syncing the resume to an external system.
+// @Summary Export changed entries
+// @Description Retrieve the entries of every section updated after since, or the whole resume when since is omitted. The profile is null when it hasn't changed. Entries are never deleted, so no deletions are reported. links.next is the absolute URL of the export picking up after this one.
+// @Tags export
+// @Accept json
+// @Produce json
+// @Param since query string false "Only include entries updated after this RFC 3339 timestamp"
+// @Param X-API-Key header string false "API key; shows the full email and phone when contact masking is enabled"
+// @Success 200 {object} models.ResumeChanges
+// @Failure 400 {object} models.APIError "Bad request"
+// @Failure 500 {object} models.APIError "Internal server error"
+// @Router /api/v1/export [get]
+// @Response 200 {object} models.ResumeChanges "Example response" {"since":"2024-01-01T00:00:00Z","profile":null,"experiences":[],"skills":[{"id":4,"name":"Go","category":"Programming Languages","level":"expert","updated_at":"2024-02-15T00:00:00Z"}],"achievements":[],"education":[],"projects":[]}
+func (h *ResumeHandler) Export(c *gin.Context) {
+	var query ExportQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		utils.ValidationError(c, "Invalid query parameters", err.Error())
+		return
+	}
+
+	// Changes made while the export is read are picked up by the next one
+	exportedAt := time.Now().UTC()
+	changes, err := h.service.GetChangesSince(c.Request.Context(), query.Since)
+	if err != nil {
+		utils.HandleError(c, err)
+		return
+	}
+
+	response := *changes
+	response.Profile = h.publicProfile(c, changes.Profile)
+	response.Education = h.publicEducation(c, changes.Education)
+	next := url.Values{"since": {exportedAt.Format(time.RFC3339)}}
+	response.Links = &models.ExportLinks{Next: h.absoluteURL(c, c.Request.URL.Path) + "?" + next.Encode()}
+	utils.Respond(c, http.StatusOK, &response)
+}
+
+// GetResumeHTML handles the request to get the full resume as print-ready HTML.
+// @Summary Get print-ready HTML resume
+// @Description Render the full resume as a standalone HTML page styled for printing to PDF from a browser
+// @Tags export
+// @Produce html
+// @Param date_format query string false "Date layout: 2006-01-02 (default), 2006-01, 01/2006, Jan 2006 or January 2006"
+// @Param Range header string false "Byte range to fetch, e.g. bytes=0-1023"
+// @Param If-Range header string false "Only honor Range if the resume is unchanged since this Last-Modified date"
+// @Success 200 {string} string "HTML resume"
+// @Success 206 {string} string "Requested byte range of the HTML resume"
+// @Header 200 {string} Last-Modified "Latest update across the resume"
+// @Header 200 {string} Accept-Ranges "bytes"
+// @Header 200 {string} X-Served-From "snapshot when the resume couldn't be read and a snapshot was served instead"
+// @Failure 400 {object} models.APIError "Bad request"
+// @Failure 404 {object} models.APIError "Not found"
+// @Failure 500 {object} models.APIError "Internal server error"
+// @Router /api/v1/resume.html [get]
+func (h *ResumeHandler) GetResumeHTML(c *gin.Context) {
+	dates, err := export.NewDateFormatter(c.Query(export.DateFormatQueryParam))
+	if err != nil {
+		utils.ValidationError(c, "Invalid query parameters", err.Error())
+		return
+	}
+
+	resume, err := h.getFullResume(c)
 	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
-			utils.NotFound(c, "No projects found matching the criteria")
+			utils.NotFound(c, "Profile not found")
 			return
 		}
 		utils.HandleError(c, err)
 		return
 	}
-	c.JSON(http.StatusOK, projects)
+
+	if profile := h.publicProfile(c, resume.Profile); profile != resume.Profile {
+		masked := *resume
+		masked.Profile = profile
+		resume = &masked
+	}
+
+	var buf bytes.Buffer
+	if err := export.RenderHTML(&buf, resume, dates); err != nil {
+		utils.HandleError(c, err)
+		return
+	}
+	// The page carries its print stylesheet inline, which the default policy blocks
+	c.Header("Content-Security-Policy", "default-src 'none'; style-src 'unsafe-inline'")
+	c.Header("Content-Type", "text/html; charset=utf-8")
+	// ServeContent answers Range, If-Range and If-Modified-Since requests, so
+	// clients can resume a partial download while the resume is unchanged
+	http.ServeContent(c.Writer, c.Request, "resume.html", resume.LastModified(), bytes.NewReader(buf.Bytes()))
+}
+
+// getFullResume reads the full resume, falling back to the snapshot when
+// reading fails for any reason other than a missing profile
+func (h *ResumeHandler) getFullResume(c *gin.Context) (*models.Resume, error) {
+	resume, err := h.service.GetFullResume(c.Request.Context())
+	if h.snapshot == nil {
+		return resume, err
+	}
+	if err == nil {
+		h.snapshot.Store(resume)
+		return resume, nil
+	}
+
+	if snapshot := h.snapshot.Resume(); snapshot != nil && !errors.Is(err, repository.ErrNotFound) {
+		c.Header(ServedFromHeader, "snapshot")
+		return snapshot, nil
+	}
+	return nil, err
+}
+
+// RenameSkillCategoryRequest defines the body of a skill category rename
+type RenameSkillCategoryRequest struct {
+	From string `json:"from" binding:"required,max=100" example:"Programming Languages"`
+	To   string `json:"to" binding:"required,max=100,nefield=From" example:"Languages"`
+}
+
+// RenameSkillCategory handles the request to rename a skill category.
+// Every skill in the category moves to the new one in a single update.
+// @Summary Rename a skill category
+// @Description Move every skill in a category to a new category name. Requires an API key.
+// @Tags skills
+// @Accept json
+// @Produce json
+// @Param X-API-Key header string true "API key"
+// @Param rename body RenameSkillCategoryRequest true "Current and new category name"
+// @Success 200 {object} map[string]int64 "Number of skills updated"
+// @Failure 400 {object} models.APIError "Bad request"
+// @Failure 401 {object} models.APIError "Unauthorized"
+// @Failure 409 {object} models.APIError "The new category already has a skill with the same name"
+// @Failure 500 {object} models.APIError "Internal server error"
+// @Router /api/v1/skills/category [put]
+// @Response 200 {object} map[string]int64 "Example response" {"updated":5}
+func (h *ResumeHandler) RenameSkillCategory(c *gin.Context) {
+	var request RenameSkillCategoryRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		utils.ValidationError(c, "Invalid request body", err.Error())
+		return
+	}
+
+	updated, err := h.service.RenameSkillCategory(c.Request.Context(), request.From, request.To)
+	if err != nil {
+		utils.HandleError(c, err)
+		return
+	}
+	utils.Respond(c, http.StatusOK, gin.H{"updated": updated})
+}
+
+// DeleteProjects handles the request to delete all of the user's projects.
+// @Summary Delete all projects
+// @Description Delete every project, e.g. to reset the section before a re-import. Requires confirm=true and an API key.
+// @Tags projects
+// @Accept json
+// @Produce json
+// @Param confirm query boolean true "Must be true to confirm the bulk delete"
+// @Param X-API-Key header string true "API key"
+// @Success 200 {object} map[string]int64 "Number of projects deleted"
+// @Failure 400 {object} models.APIError "Bad request"
+// @Failure 401 {object} models.APIError "Unauthorized"
+// @Failure 500 {object} models.APIError "Internal server error"
+// @Router /api/v1/projects [delete]
+// @Response 200 {object} map[string]int64 "Example response" {"deleted":3}
+func (h *ResumeHandler) DeleteProjects(c *gin.Context) {
+	if c.Query("confirm") != "true" {
+		utils.ValidationError(c, "Bulk delete requires confirmation", "set the confirm=true query parameter")
+		return
+	}
+
+	deleted, err := h.service.DeleteAllProjects(c.Request.Context())
+	if err != nil {
+		utils.HandleError(c, err)
+		return
+	}
+	utils.Respond(c, http.StatusOK, gin.H{"deleted": deleted})
 }
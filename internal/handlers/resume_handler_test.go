@@ -4,15 +4,22 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/npmulder/resume-api/internal/middleware"
 	"github.com/npmulder/resume-api/internal/models"
 	"github.com/npmulder/resume-api/internal/repository"
+	"github.com/npmulder/resume-api/internal/utils"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 // MockResumeService is a mock implementation of the ResumeService interface
@@ -26,6 +33,87 @@ func (m *MockResumeService) GetProfile(ctx context.Context) (*models.Profile, er
 	return profile, args.Error(1)
 }
 
+func (m *MockResumeService) GetSimilarProjects(ctx context.Context, id int, limit int) ([]*models.SimilarProject, error) {
+	args := m.Called(ctx, id, limit)
+	projects, _ := args.Get(0).([]*models.SimilarProject)
+	return projects, args.Error(1)
+}
+
+func (m *MockResumeService) GetSkillProjects(ctx context.Context, name string) ([]*models.Project, error) {
+	args := m.Called(ctx, name)
+	projects, _ := args.Get(0).([]*models.Project)
+	return projects, args.Error(1)
+}
+
+func (m *MockResumeService) GetProfileDiff(ctx context.Context, from, to time.Time) (*models.ProfileDiff, error) {
+	args := m.Called(ctx, from, to)
+	diff, _ := args.Get(0).(*models.ProfileDiff)
+	return diff, args.Error(1)
+}
+
+func (m *MockResumeService) GetFullResume(ctx context.Context) (*models.Resume, error) {
+	args := m.Called(ctx)
+	resume, _ := args.Get(0).(*models.Resume)
+	return resume, args.Error(1)
+}
+
+func (m *MockResumeService) GetTenureSummary(ctx context.Context) (*models.TenureSummary, error) {
+	args := m.Called(ctx)
+	summary, _ := args.Get(0).(*models.TenureSummary)
+	return summary, args.Error(1)
+}
+
+func (m *MockResumeService) GetStats(ctx context.Context) (*models.Stats, error) {
+	args := m.Called(ctx)
+	stats, _ := args.Get(0).(*models.Stats)
+	return stats, args.Error(1)
+}
+
+func (m *MockResumeService) RenameSkillCategory(ctx context.Context, from, to string) (int64, error) {
+	args := m.Called(ctx, from, to)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockResumeService) GetMeta(ctx context.Context) (*models.Meta, error) {
+	args := m.Called(ctx)
+	meta, _ := args.Get(0).(*models.Meta)
+	return meta, args.Error(1)
+}
+
+func (m *MockResumeService) GetCompleteness(ctx context.Context) (*models.Completeness, error) {
+	args := m.Called(ctx)
+	completeness, _ := args.Get(0).(*models.Completeness)
+	return completeness, args.Error(1)
+}
+
+func (m *MockResumeService) Search(ctx context.Context, term string) (*models.SearchResults, error) {
+	args := m.Called(ctx, term)
+	results, _ := args.Get(0).(*models.SearchResults)
+	return results, args.Error(1)
+}
+
+func (m *MockResumeService) UpdateProfile(ctx context.Context, profile *models.Profile) error {
+	args := m.Called(ctx, profile)
+	return args.Error(0)
+}
+
+func (m *MockResumeService) GetProfileHistory(ctx context.Context) ([]*models.ProfileVersion, error) {
+	args := m.Called(ctx)
+	versions, _ := args.Get(0).([]*models.ProfileVersion)
+	return versions, args.Error(1)
+}
+
+func (m *MockResumeService) GetChangesSince(ctx context.Context, since time.Time) (*models.ResumeChanges, error) {
+	args := m.Called(ctx, since)
+	changes, _ := args.Get(0).(*models.ResumeChanges)
+	return changes, args.Error(1)
+}
+
+func (m *MockResumeService) CreateProfile(ctx context.Context, profile *models.Profile) error {
+	args := m.Called(ctx, profile)
+	return args.Error(0)
+}
+
 func (m *MockResumeService) GetExperiences(ctx context.Context, filters repository.ExperienceFilters) ([]*models.Experience, error) {
 	args := m.Called(ctx, filters)
 	experiences, _ := args.Get(0).([]*models.Experience)
@@ -38,12 +126,30 @@ func (m *MockResumeService) GetSkills(ctx context.Context, filters repository.Sk
 	return skills, args.Error(1)
 }
 
+func (m *MockResumeService) GetSkillLevels(ctx context.Context) (*models.SkillLevelHistogram, error) {
+	args := m.Called(ctx)
+	histogram, _ := args.Get(0).(*models.SkillLevelHistogram)
+	return histogram, args.Error(1)
+}
+
 func (m *MockResumeService) GetAchievements(ctx context.Context, filters repository.AchievementFilters) ([]*models.Achievement, error) {
 	args := m.Called(ctx, filters)
 	achievements, _ := args.Get(0).([]*models.Achievement)
 	return achievements, args.Error(1)
 }
 
+func (m *MockResumeService) GetInstitutions(ctx context.Context) ([]*models.InstitutionCount, error) {
+	args := m.Called(ctx)
+	institutions, _ := args.Get(0).([]*models.InstitutionCount)
+	return institutions, args.Error(1)
+}
+
+func (m *MockResumeService) GetEducationByCredentialID(ctx context.Context, credentialID string) (*models.Education, error) {
+	args := m.Called(ctx, credentialID)
+	education, _ := args.Get(0).(*models.Education)
+	return education, args.Error(1)
+}
+
 func (m *MockResumeService) GetEducation(ctx context.Context, filters repository.EducationFilters) ([]*models.Education, error) {
 	args := m.Called(ctx, filters)
 	education, _ := args.Get(0).([]*models.Education)
@@ -56,6 +162,17 @@ func (m *MockResumeService) GetProjects(ctx context.Context, filters repository.
 	return projects, args.Error(1)
 }
 
+func (m *MockResumeService) GetRecentlyUpdated(ctx context.Context, limit int) ([]*models.RecentItem, error) {
+	args := m.Called(ctx, limit)
+	items, _ := args.Get(0).([]*models.RecentItem)
+	return items, args.Error(1)
+}
+
+func (m *MockResumeService) DeleteAllProjects(ctx context.Context) (int64, error) {
+	args := m.Called(ctx)
+	return args.Get(0).(int64), args.Error(1)
+}
+
 func setupRouter() *gin.Engine {
 	gin.SetMode(gin.TestMode)
 	return gin.New()
@@ -98,6 +215,7 @@ func TestGetProfile(t *testing.T) {
 		assert.Equal(t, expectedProfile.Name, response.Name)
 		assert.Equal(t, expectedProfile.Title, response.Title)
 		assert.Equal(t, expectedProfile.Email, response.Email)
+		assert.Equal(t, utils.ETag(expectedProfile.ID, expectedProfile.UpdatedAt), w.Header().Get("ETag"))
 
 		// Verify mock expectations
 		mockService.AssertExpectations(t)
@@ -158,29 +276,130 @@ func TestGetProfile(t *testing.T) {
 	})
 }
 
-func TestGetExperiences(t *testing.T) {
+func TestGetProfileContactMasking(t *testing.T) {
+	phone := "+1-555-123-4567"
+	profile := &models.Profile{ID: 1, Name: "John Doe", Title: "Software Engineer", Email: "john@example.com", Phone: &phone}
+
+	// getProfile requests the profile from a handler masking contact details,
+	// sending key as the API key when set
+	getProfile := func(t *testing.T, path, key string) *httptest.ResponseRecorder {
+		router := setupRouter()
+		mockService := new(MockResumeService)
+		mockService.On("GetProfile", mock.Anything).Return(profile, nil)
+		mockService.On("GetFullResume", mock.Anything).Return(&models.Resume{Profile: profile}, nil)
+		mockService.On("GetChangesSince", mock.Anything, mock.Anything).Return(&models.ResumeChanges{Resume: models.Resume{Profile: profile}}, nil)
+		handler := NewResumeHandler(mockService, WithContactMasking("secret"))
+		router.GET("/api/v1/profile", handler.GetProfile)
+		router.GET("/api/v1/resume.html", handler.GetResumeHTML)
+		router.GET("/api/v1/export", handler.Export)
+
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		if key != "" {
+			req.Header.Set(middleware.APIKeyHeader, key)
+		}
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	t.Run("masked for anonymous requests", func(t *testing.T) {
+		w := getProfile(t, "/api/v1/profile", "")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		var response models.Profile
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, "j***@example.com", response.Email)
+		require.NotNil(t, response.Phone)
+		assert.Equal(t, "+*-***-***-4567", *response.Phone)
+		assert.Equal(t, "John Doe", response.Name)
+		assert.Equal(t, middleware.APIKeyHeader, w.Header().Get("Vary"))
+
+		// The service's, possibly cached, profile is not modified
+		assert.Equal(t, "john@example.com", profile.Email)
+	})
+
+	t.Run("masked for an invalid API key", func(t *testing.T) {
+		w := getProfile(t, "/api/v1/profile", "wrong")
+
+		assert.Contains(t, w.Body.String(), `"email":"j***@example.com"`)
+	})
+
+	t.Run("full for authenticated requests", func(t *testing.T) {
+		w := getProfile(t, "/api/v1/profile", "secret")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		var response models.Profile
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, "john@example.com", response.Email)
+		require.NotNil(t, response.Phone)
+		assert.Equal(t, "+1-555-123-4567", *response.Phone)
+	})
+
+	t.Run("masked in the HTML resume", func(t *testing.T) {
+		anonymous := getProfile(t, "/api/v1/resume.html", "")
+		assert.Equal(t, http.StatusOK, anonymous.Code)
+		assert.Contains(t, anonymous.Body.String(), "j***@example.com")
+		assert.NotContains(t, anonymous.Body.String(), "john@example.com")
+
+		authenticated := getProfile(t, "/api/v1/resume.html", "secret")
+		assert.Contains(t, authenticated.Body.String(), "john@example.com")
+	})
+
+	t.Run("masked in the export", func(t *testing.T) {
+		anonymous := getProfile(t, "/api/v1/export?since=1970-01-01T00:00:00Z", "")
+		assert.Equal(t, http.StatusOK, anonymous.Code)
+		var response models.ResumeChanges
+		require.NoError(t, json.Unmarshal(anonymous.Body.Bytes(), &response))
+		require.NotNil(t, response.Profile)
+		assert.Equal(t, "j***@example.com", response.Profile.Email)
+		require.NotNil(t, response.Profile.Phone)
+		assert.Equal(t, "+*-***-***-4567", *response.Profile.Phone)
+		assert.Equal(t, middleware.APIKeyHeader, anonymous.Header().Get("Vary"))
+
+		authenticated := getProfile(t, "/api/v1/export?since=1970-01-01T00:00:00Z", "secret")
+		assert.Contains(t, authenticated.Body.String(), `"email":"john@example.com"`)
+
+		// The service's, possibly cached, profile is not modified
+		assert.Equal(t, "john@example.com", profile.Email)
+	})
+
+	t.Run("full when masking is disabled", func(t *testing.T) {
+		router := setupRouter()
+		mockService := new(MockResumeService)
+		mockService.On("GetProfile", mock.Anything).Return(profile, nil)
+		router.GET("/api/v1/profile", NewResumeHandler(mockService).GetProfile)
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/profile", nil))
+
+		assert.Contains(t, w.Body.String(), `"email":"john@example.com"`)
+		assert.Empty(t, w.Header().Get("Vary"))
+	})
+}
+
+func TestGetProfileSummary(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		// Setup
 		router := setupRouter()
 		mockService := new(MockResumeService)
 		handler := NewResumeHandler(mockService)
 
-		expectedExperiences := []*models.Experience{
-			{
-				ID:       1,
-				Company:  "Example Corp",
-				Position: "Software Engineer",
-			},
+		summary := "Builds reliable APIs in Go."
+		profile := &models.Profile{
+			ID:      1,
+			Name:    "John Doe",
+			Title:   "Software Engineer",
+			Summary: &summary,
 		}
 
-		// Configure mock to match any filters
-		mockService.On("GetExperiences", mock.Anything, mock.AnythingOfType("repository.ExperienceFilters")).Return(expectedExperiences, nil)
+		// Configure mock
+		mockService.On("GetProfile", mock.Anything).Return(profile, nil)
 
 		// Setup route
-		router.GET("/api/v1/experiences", handler.GetExperiences)
+		router.GET("/api/v1/profile/summary", handler.GetProfileSummary)
 
 		// Create request
-		req := httptest.NewRequest(http.MethodGet, "/api/v1/experiences?company=Example&limit=10", nil)
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/profile/summary", nil)
 		w := httptest.NewRecorder()
 
 		// Serve request
@@ -188,22 +407,13 @@ func TestGetExperiences(t *testing.T) {
 
 		// Assert response
 		assert.Equal(t, http.StatusOK, w.Code)
-
-		var response []*models.Experience
-		err := json.Unmarshal(w.Body.Bytes(), &response)
-		assert.NoError(t, err)
-		assert.Len(t, response, 1)
-		assert.Equal(t, expectedExperiences[0].ID, response[0].ID)
-		assert.Equal(t, expectedExperiences[0].Company, response[0].Company)
-		assert.Equal(t, expectedExperiences[0].Position, response[0].Position)
+		assert.Equal(t, "text/plain; charset=utf-8", w.Header().Get("Content-Type"))
+		assert.Equal(t, "John Doe — Software Engineer\nBuilds reliable APIs in Go.\n", w.Body.String())
 
 		// Verify mock expectations
 		mockService.AssertExpectations(t)
 	})
 
-	// Note: We're not testing invalid query parameters because Gin's binding
-	// behavior for int fields with invalid values is to set them to 0, not fail
-
 	t.Run("not found", func(t *testing.T) {
 		// Setup
 		router := setupRouter()
@@ -211,13 +421,13 @@ func TestGetExperiences(t *testing.T) {
 		handler := NewResumeHandler(mockService)
 
 		// Configure mock
-		mockService.On("GetExperiences", mock.Anything, mock.AnythingOfType("repository.ExperienceFilters")).Return(nil, repository.ErrNotFound)
+		mockService.On("GetProfile", mock.Anything).Return(nil, repository.ErrNotFound)
 
 		// Setup route
-		router.GET("/api/v1/experiences", handler.GetExperiences)
+		router.GET("/api/v1/profile/summary", handler.GetProfileSummary)
 
 		// Create request
-		req := httptest.NewRequest(http.MethodGet, "/api/v1/experiences", nil)
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/profile/summary", nil)
 		w := httptest.NewRecorder()
 
 		// Serve request
@@ -225,86 +435,195 @@ func TestGetExperiences(t *testing.T) {
 
 		// Assert response
 		assert.Equal(t, http.StatusNotFound, w.Code)
-		assert.Contains(t, w.Body.String(), "No experiences found matching the criteria")
+		assert.Contains(t, w.Body.String(), "Profile not found")
 
 		// Verify mock expectations
 		mockService.AssertExpectations(t)
 	})
 }
 
-func TestGetSkills(t *testing.T) {
-	t.Run("success", func(t *testing.T) {
-		// Setup
+func TestUpdateProfile(t *testing.T) {
+	readAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	current := &models.Profile{ID: 1, Name: "John Doe", Title: "Software Engineer", Email: "john@example.com", UpdatedAt: readAt}
+	currentETag := utils.ETag(current.ID, current.UpdatedAt)
+	body := `{"name":"John Doe","title":"Senior Software Engineer","email":"john@example.com"}`
+
+	serve := func(mockService *MockResumeService, ifMatch string) *httptest.ResponseRecorder {
 		router := setupRouter()
-		mockService := new(MockResumeService)
 		handler := NewResumeHandler(mockService)
+		router.PUT("/api/v1/profile", handler.UpdateProfile)
 
-		level := "expert"
-		expectedSkills := []*models.Skill{
-			{
-				ID:       1,
-				Name:     "Go",
-				Category: "Programming Languages",
-				Level:    &level,
-			},
+		req := httptest.NewRequest(http.MethodPut, "/api/v1/profile", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		if ifMatch != "" {
+			req.Header.Set("If-Match", ifMatch)
 		}
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
 
-		// Configure mock
-		mockService.On("GetSkills", mock.Anything, mock.AnythingOfType("repository.SkillFilters")).Return(expectedSkills, nil)
+	t.Run("matching If-Match", func(t *testing.T) {
+		mockService := new(MockResumeService)
+		updatedAt := readAt.Add(time.Hour)
+		mockService.On("GetProfile", mock.Anything).Return(current, nil)
+		mockService.On("UpdateProfile", mock.Anything, mock.MatchedBy(func(profile *models.Profile) bool {
+			return profile.ID == 1 && profile.Title == "Senior Software Engineer"
+		})).Run(func(args mock.Arguments) {
+			args.Get(1).(*models.Profile).UpdatedAt = updatedAt
+		}).Return(nil)
 
-		// Setup route
-		router.GET("/api/v1/skills", handler.GetSkills)
+		w := serve(mockService, currentETag)
 
-		// Create request
-		req := httptest.NewRequest(http.MethodGet, "/api/v1/skills?category=Programming+Languages&limit=10", nil)
-		w := httptest.NewRecorder()
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, utils.ETag(1, updatedAt), w.Header().Get("ETag"))
+		assert.Contains(t, w.Body.String(), "Senior Software Engineer")
+		mockService.AssertExpectations(t)
+	})
 
-		// Serve request
-		router.ServeHTTP(w, req)
+	t.Run("stale If-Match", func(t *testing.T) {
+		mockService := new(MockResumeService)
+		staleAt := readAt.Add(-time.Hour)
+		mockService.On("GetProfile", mock.Anything).Return(current, nil)
+		mockService.On("UpdateProfile", mock.Anything, mock.MatchedBy(func(profile *models.Profile) bool {
+			return profile.UpdatedAt.Equal(staleAt)
+		})).Return(repository.NewRepositoryError("update", "profile", repository.ErrPreconditionFailed))
+
+		w := serve(mockService, utils.ETag(current.ID, staleAt))
+
+		assert.Equal(t, http.StatusPreconditionFailed, w.Code)
+		assert.Contains(t, w.Body.String(), models.ErrCodePreconditionFailed)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("If-Match newer than the cached read", func(t *testing.T) {
+		// The cached or replica read lags behind the version the client
+		// holds; the client's version is what the update is conditional on
+		mockService := new(MockResumeService)
+		newerAt := readAt.Add(time.Minute)
+		mockService.On("GetProfile", mock.Anything).Return(current, nil)
+		mockService.On("UpdateProfile", mock.Anything, mock.MatchedBy(func(profile *models.Profile) bool {
+			return profile.UpdatedAt.Equal(newerAt)
+		})).Return(nil)
+
+		w := serve(mockService, utils.ETag(current.ID, newerAt))
 
-		// Assert response
 		assert.Equal(t, http.StatusOK, w.Code)
+		mockService.AssertExpectations(t)
+	})
 
-		var response []*models.Skill
-		err := json.Unmarshal(w.Body.Bytes(), &response)
-		assert.NoError(t, err)
-		assert.Len(t, response, 1)
-		assert.Equal(t, expectedSkills[0].ID, response[0].ID)
-		assert.Equal(t, expectedSkills[0].Name, response[0].Name)
-		assert.Equal(t, expectedSkills[0].Category, response[0].Category)
-		assert.Equal(t, expectedSkills[0].Level, response[0].Level)
+	t.Run("If-Match any version", func(t *testing.T) {
+		mockService := new(MockResumeService)
+		mockService.On("GetProfile", mock.Anything).Return(current, nil)
+		mockService.On("UpdateProfile", mock.Anything, mock.MatchedBy(func(profile *models.Profile) bool {
+			return profile.UpdatedAt.IsZero()
+		})).Return(nil)
 
-		// Verify mock expectations
+		w := serve(mockService, "*")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("If-Match for another resource", func(t *testing.T) {
+		mockService := new(MockResumeService)
+		mockService.On("GetProfile", mock.Anything).Return(current, nil)
+
+		w := serve(mockService, utils.ETag(current.ID+1, readAt))
+
+		assert.Equal(t, http.StatusPreconditionFailed, w.Code)
+		mockService.AssertNotCalled(t, "UpdateProfile", mock.Anything, mock.Anything)
+	})
+
+	t.Run("concurrent update after the check", func(t *testing.T) {
+		mockService := new(MockResumeService)
+		mockService.On("GetProfile", mock.Anything).Return(current, nil)
+		mockService.On("UpdateProfile", mock.Anything, mock.MatchedBy(func(profile *models.Profile) bool {
+			return profile.UpdatedAt.Equal(readAt)
+		})).Return(repository.NewRepositoryError("update", "profile", repository.ErrPreconditionFailed))
+
+		w := serve(mockService, currentETag)
+
+		assert.Equal(t, http.StatusPreconditionFailed, w.Code)
+		assert.Contains(t, w.Body.String(), models.ErrCodePreconditionFailed)
 		mockService.AssertExpectations(t)
 	})
+
+	t.Run("missing If-Match", func(t *testing.T) {
+		mockService := new(MockResumeService)
+
+		w := serve(mockService, "")
+
+		assert.Equal(t, http.StatusPreconditionRequired, w.Code)
+		mockService.AssertNotCalled(t, "GetProfile", mock.Anything)
+		mockService.AssertNotCalled(t, "UpdateProfile", mock.Anything, mock.Anything)
+	})
 }
 
-func TestGetAchievements(t *testing.T) {
+func TestGetProfileHistory(t *testing.T) {
+	// Setup
+	router := setupRouter()
+	mockService := new(MockResumeService)
+	handler := NewResumeHandler(mockService)
+
+	replacedAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	versions := []*models.ProfileVersion{
+		{ID: 1, ProfileID: 1, Name: "John Doe", Title: "Software Engineer", Email: "john@example.com", ReplacedAt: replacedAt},
+	}
+
+	// Configure mock
+	mockService.On("GetProfileHistory", mock.Anything).Return(versions, nil)
+
+	// Setup route
+	router.GET("/api/v1/profile/history", handler.GetProfileHistory)
+
+	// Create request
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/profile/history", nil)
+	w := httptest.NewRecorder()
+
+	// Serve request
+	router.ServeHTTP(w, req)
+
+	// Assert response
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response []*models.ProfileVersion
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Len(t, response, 1)
+	assert.Equal(t, "Software Engineer", response[0].Title)
+	assert.Equal(t, replacedAt, response[0].ReplacedAt)
+
+	// Verify mock expectations
+	mockService.AssertExpectations(t)
+}
+
+func TestGetProfileDiff(t *testing.T) {
+	from := time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	at := func(want time.Time) interface{} {
+		return mock.MatchedBy(func(got time.Time) bool { return got.Equal(want) })
+	}
+
 	t.Run("success", func(t *testing.T) {
 		// Setup
 		router := setupRouter()
 		mockService := new(MockResumeService)
 		handler := NewResumeHandler(mockService)
 
-		description := "Improved system performance by 50%"
-		yearAchieved := 2023
-		expectedAchievements := []*models.Achievement{
-			{
-				ID:           1,
-				Title:        "Performance Improvement",
-				Description:  &description,
-				YearAchieved: &yearAchieved,
-			},
-		}
+		oldTitle, newTitle := "Software Engineer", "Senior Software Engineer"
+		diff := &models.ProfileDiff{From: from, To: to, Changes: []models.ProfileFieldChange{
+			{Field: "title", Old: &oldTitle, New: &newTitle},
+		}}
 
 		// Configure mock
-		mockService.On("GetAchievements", mock.Anything, mock.AnythingOfType("repository.AchievementFilters")).Return(expectedAchievements, nil)
+		mockService.On("GetProfileDiff", mock.Anything, at(from), at(to)).Return(diff, nil)
 
 		// Setup route
-		router.GET("/api/v1/achievements", handler.GetAchievements)
+		router.GET("/api/v1/profile/diff", handler.GetProfileDiff)
 
 		// Create request
-		req := httptest.NewRequest(http.MethodGet, "/api/v1/achievements?year=2023&limit=10", nil)
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/profile/diff?from=2023-06-01T00:00:00Z&to=2024-06-01T00:00:00Z", nil)
 		w := httptest.NewRecorder()
 
 		// Serve request
@@ -312,94 +631,124 @@ func TestGetAchievements(t *testing.T) {
 
 		// Assert response
 		assert.Equal(t, http.StatusOK, w.Code)
-
-		var response []*models.Achievement
-		err := json.Unmarshal(w.Body.Bytes(), &response)
-		assert.NoError(t, err)
-		assert.Len(t, response, 1)
-		assert.Equal(t, expectedAchievements[0].ID, response[0].ID)
-		assert.Equal(t, expectedAchievements[0].Title, response[0].Title)
-		assert.Equal(t, *expectedAchievements[0].Description, *response[0].Description)
-		assert.Equal(t, *expectedAchievements[0].YearAchieved, *response[0].YearAchieved)
+		assert.JSONEq(t, `{"from":"2023-06-01T00:00:00Z","to":"2024-06-01T00:00:00Z","changes":[{"field":"title","old":"Software Engineer","new":"Senior Software Engineer"}]}`, w.Body.String())
 
 		// Verify mock expectations
 		mockService.AssertExpectations(t)
 	})
-}
 
-func TestGetEducation(t *testing.T) {
-	t.Run("success", func(t *testing.T) {
+	t.Run("invalid range", func(t *testing.T) {
 		// Setup
 		router := setupRouter()
 		mockService := new(MockResumeService)
 		handler := NewResumeHandler(mockService)
 
-		fieldOfStudy := "Computer Science"
-		expectedEducation := []*models.Education{
-			{
-				ID:                    1,
-				Institution:           "University of Example",
-				DegreeOrCertification: "Bachelor of Science",
-				FieldOfStudy:          &fieldOfStudy,
-				Type:                  "education",
-				Status:                "completed",
-			},
+		// Setup route
+		router.GET("/api/v1/profile/diff", handler.GetProfileDiff)
+
+		for _, query := range []string{"", "?from=2023-06-01T00:00:00Z", "?from=yesterday&to=2024-06-01T00:00:00Z", "?from=2024-06-01T00:00:00Z&to=2023-06-01T00:00:00Z"} {
+			// Create request
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/profile/diff"+query, nil)
+			w := httptest.NewRecorder()
+
+			// Serve request
+			router.ServeHTTP(w, req)
+
+			// Assert response
+			assert.Equal(t, http.StatusBadRequest, w.Code, query)
 		}
 
+		// The service is never asked for an invalid range
+		mockService.AssertNotCalled(t, "GetProfileDiff", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("no profile", func(t *testing.T) {
+		// Setup
+		router := setupRouter()
+		mockService := new(MockResumeService)
+		handler := NewResumeHandler(mockService)
+
 		// Configure mock
-		mockService.On("GetEducation", mock.Anything, mock.AnythingOfType("repository.EducationFilters")).Return(expectedEducation, nil)
+		mockService.On("GetProfileDiff", mock.Anything, at(from), at(to)).Return(nil, repository.ErrNotFound)
 
 		// Setup route
-		router.GET("/api/v1/education", handler.GetEducation)
+		router.GET("/api/v1/profile/diff", handler.GetProfileDiff)
 
 		// Create request
-		req := httptest.NewRequest(http.MethodGet, "/api/v1/education?type=degree&limit=10", nil)
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/profile/diff?from=2023-06-01T00:00:00Z&to=2024-06-01T00:00:00Z", nil)
 		w := httptest.NewRecorder()
 
 		// Serve request
 		router.ServeHTTP(w, req)
 
 		// Assert response
-		assert.Equal(t, http.StatusOK, w.Code)
-
-		var response []*models.Education
-		err := json.Unmarshal(w.Body.Bytes(), &response)
-		assert.NoError(t, err)
-		assert.Len(t, response, 1)
-		assert.Equal(t, expectedEducation[0].ID, response[0].ID)
-		assert.Equal(t, expectedEducation[0].Institution, response[0].Institution)
-		assert.Equal(t, expectedEducation[0].DegreeOrCertification, response[0].DegreeOrCertification)
-		assert.Equal(t, *expectedEducation[0].FieldOfStudy, *response[0].FieldOfStudy)
-		assert.Equal(t, expectedEducation[0].Type, response[0].Type)
-		assert.Equal(t, expectedEducation[0].Status, response[0].Status)
+		assert.Equal(t, http.StatusNotFound, w.Code)
 
 		// Verify mock expectations
 		mockService.AssertExpectations(t)
 	})
 }
 
-func TestGetProjects(t *testing.T) {
+func TestGetExperiences(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		// Setup
 		router := setupRouter()
 		mockService := new(MockResumeService)
 		handler := NewResumeHandler(mockService)
 
-		expectedProjects := []*models.Project{
+		expectedExperiences := []*models.Experience{
 			{
-				ID:   1,
-				Name: "Resume API",
+				ID:       1,
+				Company:  "Example Corp",
+				Position: "Software Engineer",
 			},
 		}
 
+		// Configure mock to match any filters
+		mockService.On("GetExperiences", mock.Anything, mock.AnythingOfType("repository.ExperienceFilters")).Return(expectedExperiences, nil)
+
+		// Setup route
+		router.GET("/api/v1/experiences", handler.GetExperiences)
+
+		// Create request
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/experiences?company=Example&limit=10", nil)
+		w := httptest.NewRecorder()
+
+		// Serve request
+		router.ServeHTTP(w, req)
+
+		// Assert response
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var response []*models.Experience
+		err := json.Unmarshal(w.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		assert.Len(t, response, 1)
+		assert.Equal(t, expectedExperiences[0].ID, response[0].ID)
+		assert.Equal(t, expectedExperiences[0].Company, response[0].Company)
+		assert.Equal(t, expectedExperiences[0].Position, response[0].Position)
+
+		// Verify mock expectations
+		mockService.AssertExpectations(t)
+	})
+
+	// Note: We're not testing invalid query parameters because Gin's binding
+	// behavior for int fields with invalid values is to set them to 0, not fail
+
+	t.Run("empty filtered list", func(t *testing.T) {
+		// Setup
+		router := setupRouter()
+		mockService := new(MockResumeService)
+		handler := NewResumeHandler(mockService)
+
 		// Configure mock
-		mockService.On("GetProjects", mock.Anything, mock.AnythingOfType("repository.ProjectFilters")).Return(expectedProjects, nil)
+		mockService.On("GetExperiences", mock.Anything, mock.AnythingOfType("repository.ExperienceFilters")).Return([]*models.Experience{}, nil)
 
 		// Setup route
-		router.GET("/api/v1/projects", handler.GetProjects)
+		router.GET("/api/v1/experiences", handler.GetExperiences)
 
 		// Create request
-		req := httptest.NewRequest(http.MethodGet, "/api/v1/projects?status=active&limit=10", nil)
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/experiences?company=Nonexistent", nil)
 		w := httptest.NewRecorder()
 
 		// Serve request
@@ -407,15 +756,1294 @@ func TestGetProjects(t *testing.T) {
 
 		// Assert response
 		assert.Equal(t, http.StatusOK, w.Code)
+		assert.JSONEq(t, `[]`, w.Body.String())
 
-		var response []*models.Project
+		// Verify mock expectations
+		mockService.AssertExpectations(t)
+	})
+}
+
+func TestGetSkills(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// Setup
+		router := setupRouter()
+		mockService := new(MockResumeService)
+		handler := NewResumeHandler(mockService)
+
+		level := "expert"
+		expectedSkills := []*models.Skill{
+			{
+				ID:       1,
+				Name:     "Go",
+				Category: "Programming Languages",
+				Level:    &level,
+			},
+		}
+
+		// Configure mock
+		mockService.On("GetSkills", mock.Anything, mock.AnythingOfType("repository.SkillFilters")).Return(expectedSkills, nil)
+
+		// Setup route
+		router.GET("/api/v1/skills", handler.GetSkills)
+
+		// Create request
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/skills?category=Programming+Languages&limit=10", nil)
+		w := httptest.NewRecorder()
+
+		// Serve request
+		router.ServeHTTP(w, req)
+
+		// Assert response
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var response []*models.Skill
 		err := json.Unmarshal(w.Body.Bytes(), &response)
 		assert.NoError(t, err)
 		assert.Len(t, response, 1)
-		assert.Equal(t, expectedProjects[0].ID, response[0].ID)
-		assert.Equal(t, expectedProjects[0].Name, response[0].Name)
+		assert.Equal(t, expectedSkills[0].ID, response[0].ID)
+		assert.Equal(t, expectedSkills[0].Name, response[0].Name)
+		assert.Equal(t, expectedSkills[0].Category, response[0].Category)
+		assert.Equal(t, expectedSkills[0].Level, response[0].Level)
+
+		// Verify mock expectations
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("multiple categories", func(t *testing.T) {
+		// Setup
+		router := setupRouter()
+		mockService := new(MockResumeService)
+		handler := NewResumeHandler(mockService)
+
+		// Configure mock; every repeated category reaches the filter
+		categories := func(filters repository.SkillFilters) bool {
+			return assert.ObjectsAreEqual([]string{"Languages", "Databases"}, filters.Categories)
+		}
+		mockService.On("GetSkills", mock.Anything, mock.MatchedBy(categories)).Return([]*models.Skill{}, nil)
+
+		// Setup route
+		router.GET("/api/v1/skills", handler.GetSkills)
+
+		// Create request
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/skills?category=Languages&category=Databases", nil)
+		w := httptest.NewRecorder()
+
+		// Serve request
+		router.ServeHTTP(w, req)
+
+		// Assert response
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		// Verify mock expectations
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("featured page", func(t *testing.T) {
+		// Setup
+		router := setupRouter()
+		mockService := new(MockResumeService)
+		handler := NewResumeHandler(mockService)
+
+		// Configure mock
+		mockService.On("GetSkills", mock.Anything, mock.MatchedBy(func(filters repository.SkillFilters) bool {
+			return filters.Featured != nil && *filters.Featured && filters.Limit == 2 && filters.Offset == 4
+		})).Return([]*models.Skill{{ID: 5, Name: "Go", IsFeatured: true}}, nil)
+
+		// Setup route
+		router.GET("/api/v1/skills", handler.GetSkills)
+
+		// Create request
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/skills?featured=true&limit=2&offset=4", nil)
+		w := httptest.NewRecorder()
+
+		// Serve request
+		router.ServeHTTP(w, req)
+
+		// Assert response
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		// Verify mock expectations
+		mockService.AssertExpectations(t)
+	})
+}
+
+func TestGetSkillLevels(t *testing.T) {
+	// Setup
+	router := setupRouter()
+	mockService := new(MockResumeService)
+	handler := NewResumeHandler(mockService)
+
+	// Configure mock
+	mockService.On("GetSkillLevels", mock.Anything).Return(&models.SkillLevelHistogram{Intermediate: 2, Expert: 1, Unspecified: 3}, nil)
+
+	// Setup route
+	router.GET("/api/v1/skills/levels", handler.GetSkillLevels)
+
+	// Create request
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/skills/levels", nil)
+	w := httptest.NewRecorder()
+
+	// Serve request
+	router.ServeHTTP(w, req)
+
+	// Assert response
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"beginner":0,"intermediate":2,"advanced":0,"expert":1,"unspecified":3}`, w.Body.String())
+
+	// Verify mock expectations
+	mockService.AssertExpectations(t)
+}
+
+func TestGetMeta(t *testing.T) {
+	// Setup
+	router := setupRouter()
+	mockService := new(MockResumeService)
+	handler := NewResumeHandler(mockService)
+
+	// Configure mock
+	updatedAt := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	mockService.On("GetMeta", mock.Anything).Return(&models.Meta{ProfileUpdatedAt: &updatedAt, ETag: `"0123456789abcdef"`}, nil)
+
+	// Setup route
+	router.GET("/api/v1/meta", handler.GetMeta)
+
+	// Create request
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/meta", nil)
+	w := httptest.NewRecorder()
+
+	// Serve request
+	router.ServeHTTP(w, req)
+
+	// Assert response
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, `"0123456789abcdef"`, w.Header().Get("ETag"))
+	assert.JSONEq(t, `{
+		"profile_updated_at": "2024-03-01T12:00:00Z",
+		"experiences_updated_at": null,
+		"skills_updated_at": null,
+		"achievements_updated_at": null,
+		"education_updated_at": null,
+		"projects_updated_at": null,
+		"etag": "\"0123456789abcdef\""
+	}`, w.Body.String())
+
+	// Verify mock expectations
+	mockService.AssertExpectations(t)
+}
+
+func TestGetCompleteness(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// Setup
+		router := setupRouter()
+		mockService := new(MockResumeService)
+		handler := NewResumeHandler(mockService)
+
+		// Configure mock
+		mockService.On("GetCompleteness", mock.Anything).Return(&models.Completeness{Score: 77, Missing: []string{"phone", "achievements"}}, nil)
+
+		// Setup route
+		router.GET("/api/v1/profile/completeness", handler.GetCompleteness)
+
+		// Create request
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/profile/completeness", nil)
+		w := httptest.NewRecorder()
+
+		// Serve request
+		router.ServeHTTP(w, req)
+
+		// Assert response
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.JSONEq(t, `{"score":77,"missing":["phone","achievements"]}`, w.Body.String())
+
+		// Verify mock expectations
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("service error", func(t *testing.T) {
+		// Setup
+		router := setupRouter()
+		mockService := new(MockResumeService)
+		handler := NewResumeHandler(mockService)
+
+		// Configure mock
+		mockService.On("GetCompleteness", mock.Anything).Return(nil, errors.New("database error"))
+
+		// Setup route
+		router.GET("/api/v1/profile/completeness", handler.GetCompleteness)
+
+		// Create request
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/profile/completeness", nil)
+		w := httptest.NewRecorder()
+
+		// Serve request
+		router.ServeHTTP(w, req)
+
+		// Assert response
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+
+		// Verify mock expectations
+		mockService.AssertExpectations(t)
+	})
+}
+
+func TestGetAchievements(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// Setup
+		router := setupRouter()
+		mockService := new(MockResumeService)
+		handler := NewResumeHandler(mockService)
+
+		description := "Improved system performance by 50%"
+		yearAchieved := 2023
+		expectedAchievements := []*models.Achievement{
+			{
+				ID:           1,
+				Title:        "Performance Improvement",
+				Description:  &description,
+				YearAchieved: &yearAchieved,
+			},
+		}
+
+		// Configure mock
+		mockService.On("GetAchievements", mock.Anything, mock.AnythingOfType("repository.AchievementFilters")).Return(expectedAchievements, nil)
+
+		// Setup route
+		router.GET("/api/v1/achievements", handler.GetAchievements)
+
+		// Create request
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/achievements?year=2023&limit=10", nil)
+		w := httptest.NewRecorder()
+
+		// Serve request
+		router.ServeHTTP(w, req)
+
+		// Assert response
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var response []*models.Achievement
+		err := json.Unmarshal(w.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		assert.Len(t, response, 1)
+		assert.Equal(t, expectedAchievements[0].ID, response[0].ID)
+		assert.Equal(t, expectedAchievements[0].Title, response[0].Title)
+		assert.Equal(t, *expectedAchievements[0].Description, *response[0].Description)
+		assert.Equal(t, *expectedAchievements[0].YearAchieved, *response[0].YearAchieved)
 
 		// Verify mock expectations
 		mockService.AssertExpectations(t)
 	})
+
+	t.Run("year range", func(t *testing.T) {
+		// Setup
+		router := setupRouter()
+		mockService := new(MockResumeService)
+		handler := NewResumeHandler(mockService)
+
+		// Configure mock
+		mockService.On("GetAchievements", mock.Anything, mock.MatchedBy(func(filters repository.AchievementFilters) bool {
+			return filters.YearFrom != nil && *filters.YearFrom == 2020 &&
+				filters.YearTo != nil && *filters.YearTo == 2023
+		})).Return([]*models.Achievement{}, nil)
+
+		// Setup route
+		router.GET("/api/v1/achievements", handler.GetAchievements)
+
+		// Create request
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/achievements?year_from=2020&year_to=2023", nil)
+		w := httptest.NewRecorder()
+
+		// Serve request
+		router.ServeHTTP(w, req)
+
+		// Assert response
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		// Verify mock expectations
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("inverted year range", func(t *testing.T) {
+		// Setup
+		router := setupRouter()
+		mockService := new(MockResumeService)
+		handler := NewResumeHandler(mockService)
+
+		// Setup route
+		router.GET("/api/v1/achievements", handler.GetAchievements)
+
+		// Create request
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/achievements?year_from=2024&year_to=2020", nil)
+		w := httptest.NewRecorder()
+
+		// Serve request
+		router.ServeHTTP(w, req)
+
+		// Assert response
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		mockService.AssertNotCalled(t, "GetAchievements", mock.Anything, mock.Anything)
+	})
+
+	t.Run("unknown category", func(t *testing.T) {
+		// Setup
+		router := setupRouter()
+		mockService := new(MockResumeService)
+		handler := NewResumeHandler(mockService)
+
+		// Setup route
+		router.GET("/api/v1/achievements", handler.GetAchievements)
+
+		// Create request
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/achievements?category=bogus", nil)
+		w := httptest.NewRecorder()
+
+		// Serve request
+		router.ServeHTTP(w, req)
+
+		// Assert response
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		mockService.AssertNotCalled(t, "GetAchievements", mock.Anything, mock.Anything)
+	})
+}
+
+func TestGetEducation(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// Setup
+		router := setupRouter()
+		mockService := new(MockResumeService)
+		handler := NewResumeHandler(mockService)
+
+		fieldOfStudy := "Computer Science"
+		expectedEducation := []*models.Education{
+			{
+				ID:                    1,
+				Institution:           "University of Example",
+				DegreeOrCertification: "Bachelor of Science",
+				FieldOfStudy:          &fieldOfStudy,
+				Type:                  "education",
+				Status:                "completed",
+			},
+		}
+
+		// Configure mock
+		mockService.On("GetEducation", mock.Anything, mock.AnythingOfType("repository.EducationFilters")).Return(expectedEducation, nil)
+
+		// Setup route
+		router.GET("/api/v1/education", handler.GetEducation)
+
+		// Create request
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/education?type=degree&limit=10", nil)
+		w := httptest.NewRecorder()
+
+		// Serve request
+		router.ServeHTTP(w, req)
+
+		// Assert response
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var response []*models.Education
+		err := json.Unmarshal(w.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		assert.Len(t, response, 1)
+		assert.Equal(t, expectedEducation[0].ID, response[0].ID)
+		assert.Equal(t, expectedEducation[0].Institution, response[0].Institution)
+		assert.Equal(t, expectedEducation[0].DegreeOrCertification, response[0].DegreeOrCertification)
+		assert.Equal(t, *expectedEducation[0].FieldOfStudy, *response[0].FieldOfStudy)
+		assert.Equal(t, expectedEducation[0].Type, response[0].Type)
+		assert.Equal(t, expectedEducation[0].Status, response[0].Status)
+
+		// Verify mock expectations
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("resolves relative credential URLs", func(t *testing.T) {
+		// Setup
+		router := setupRouter()
+		mockService := new(MockResumeService)
+		handler := NewResumeHandler(mockService)
+
+		relative, absolute := "/files/cka.pdf", "https://www.cncf.io/certification/cka/"
+		education := []*models.Education{
+			{ID: 1, Institution: "CNCF", CredentialURL: &relative},
+			{ID: 2, Institution: "AWS", CredentialURL: &absolute},
+		}
+		mockService.On("GetEducation", mock.Anything, mock.Anything).Return(education, nil)
+		router.GET("/api/v1/education", handler.GetEducation)
+
+		// Request through a reverse proxy
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/education", nil)
+		req.Header.Set("X-Forwarded-Proto", "https")
+		req.Header.Set("X-Forwarded-Host", "resume.example.com")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		// Assert response
+		assert.Equal(t, http.StatusOK, w.Code)
+		var response []*models.Education
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		require.Len(t, response, 2)
+		assert.Equal(t, "https://resume.example.com/files/cka.pdf", *response[0].CredentialURL)
+		assert.Equal(t, absolute, *response[1].CredentialURL)
+
+		// The service's entries are left untouched
+		assert.Equal(t, "/files/cka.pdf", *education[0].CredentialURL)
+	})
+}
+
+func TestGetEducationByCredentialID(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// Setup
+		router := setupRouter()
+		mockService := new(MockResumeService)
+		handler := NewResumeHandler(mockService)
+
+		// Configure mock
+		credentialID := "AWS-CSA-123456"
+		certification := &models.Education{
+			ID:                    2,
+			Institution:           "AWS",
+			DegreeOrCertification: "AWS Certified Solutions Architect",
+			Type:                  models.EducationTypeCertification,
+			Status:                models.EducationStatusCompleted,
+			CredentialID:          &credentialID,
+		}
+		mockService.On("GetEducationByCredentialID", mock.Anything, credentialID).Return(certification, nil)
+
+		// Setup route
+		router.GET("/api/v1/education/credential/:id", handler.GetEducationByCredentialID)
+
+		// Create request
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/education/credential/AWS-CSA-123456", nil)
+		w := httptest.NewRecorder()
+
+		// Serve request
+		router.ServeHTTP(w, req)
+
+		// Assert response
+		assert.Equal(t, http.StatusOK, w.Code)
+		var response models.Education
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, 2, response.ID)
+		assert.Equal(t, credentialID, *response.CredentialID)
+
+		// Verify mock expectations
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		// Setup
+		router := setupRouter()
+		mockService := new(MockResumeService)
+		handler := NewResumeHandler(mockService)
+
+		// Configure mock
+		notFound := repository.NewRepositoryError("get", "education", fmt.Errorf("certification with credential id %q %w", "UNKNOWN", repository.ErrNotFound))
+		mockService.On("GetEducationByCredentialID", mock.Anything, "UNKNOWN").Return(nil, notFound)
+
+		// Setup route
+		router.GET("/api/v1/education/credential/:id", handler.GetEducationByCredentialID)
+
+		// Create request
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/education/credential/UNKNOWN", nil)
+		w := httptest.NewRecorder()
+
+		// Serve request
+		router.ServeHTTP(w, req)
+
+		// Assert response
+		assert.Equal(t, http.StatusNotFound, w.Code)
+
+		// Verify mock expectations
+		mockService.AssertExpectations(t)
+	})
+}
+
+func TestGetSkillProjects(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// Setup
+		router := setupRouter()
+		mockService := new(MockResumeService)
+		handler := NewResumeHandler(mockService)
+
+		// Configure mock
+		projects := []*models.Project{
+			{ID: 1, Name: "Resume API", Technologies: []string{"Go", "PostgreSQL"}},
+			{ID: 2, Name: "Job Board", Technologies: []string{"Go", "React"}},
+		}
+		mockService.On("GetSkillProjects", mock.Anything, "Go").Return(projects, nil)
+
+		// Setup route
+		router.GET("/api/v1/skills/:name/projects", handler.GetSkillProjects)
+
+		// Create request
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/skills/Go/projects", nil)
+		w := httptest.NewRecorder()
+
+		// Serve request
+		router.ServeHTTP(w, req)
+
+		// Assert response
+		assert.Equal(t, http.StatusOK, w.Code)
+		var response []models.Project
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		require.Len(t, response, 2)
+		assert.Equal(t, "Resume API", response[0].Name)
+		assert.Equal(t, "Job Board", response[1].Name)
+
+		// Verify mock expectations
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("unknown skill", func(t *testing.T) {
+		// Setup
+		router := setupRouter()
+		mockService := new(MockResumeService)
+		handler := NewResumeHandler(mockService)
+
+		// Configure mock
+		notFound := repository.NewRepositoryError("get", "skill", fmt.Errorf("skill named %q %w", "COBOL", repository.ErrNotFound))
+		mockService.On("GetSkillProjects", mock.Anything, "COBOL").Return(nil, notFound)
+
+		// Setup route
+		router.GET("/api/v1/skills/:name/projects", handler.GetSkillProjects)
+
+		// Create request
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/skills/COBOL/projects", nil)
+		w := httptest.NewRecorder()
+
+		// Serve request
+		router.ServeHTTP(w, req)
+
+		// Assert response
+		assert.Equal(t, http.StatusNotFound, w.Code)
+
+		// Verify mock expectations
+		mockService.AssertExpectations(t)
+	})
+}
+
+func TestGetInstitutions(t *testing.T) {
+	// Setup
+	router := setupRouter()
+	mockService := new(MockResumeService)
+	handler := NewResumeHandler(mockService)
+
+	// Configure mock
+	mockService.On("GetInstitutions", mock.Anything).Return([]*models.InstitutionCount{
+		{Institution: "AWS", Count: 2},
+		{Institution: "Stanford University", Count: 1},
+	}, nil)
+
+	// Setup route
+	router.GET("/api/v1/education/institutions", handler.GetInstitutions)
+
+	// Create request
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/education/institutions", nil)
+	w := httptest.NewRecorder()
+
+	// Serve request
+	router.ServeHTTP(w, req)
+
+	// Assert response
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `[{"institution":"AWS","count":2},{"institution":"Stanford University","count":1}]`, w.Body.String())
+
+	// Verify mock expectations
+	mockService.AssertExpectations(t)
+}
+
+func TestGetProjects(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// Setup
+		router := setupRouter()
+		mockService := new(MockResumeService)
+		handler := NewResumeHandler(mockService)
+
+		expectedProjects := []*models.Project{
+			{
+				ID:   1,
+				Name: "Resume API",
+			},
+		}
+
+		// Configure mock
+		mockService.On("GetProjects", mock.Anything, mock.AnythingOfType("repository.ProjectFilters")).Return(expectedProjects, nil)
+
+		// Setup route
+		router.GET("/api/v1/projects", handler.GetProjects)
+
+		// Create request
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/projects?status=active&limit=10", nil)
+		w := httptest.NewRecorder()
+
+		// Serve request
+		router.ServeHTTP(w, req)
+
+		// Assert response
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var response []*models.Project
+		err := json.Unmarshal(w.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		assert.Len(t, response, 1)
+		assert.Equal(t, expectedProjects[0].ID, response[0].ID)
+		assert.Equal(t, expectedProjects[0].Name, response[0].Name)
+
+		// Verify mock expectations
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("start date range", func(t *testing.T) {
+		// Setup
+		router := setupRouter()
+		mockService := new(MockResumeService)
+		handler := NewResumeHandler(mockService)
+
+		// Configure mock
+		mockService.On("GetProjects", mock.Anything, mock.MatchedBy(func(f repository.ProjectFilters) bool {
+			return f.StartedAfter != nil && *f.StartedAfter == "2021-01-01" &&
+				f.StartedBefore != nil && *f.StartedBefore == "2023-12-31"
+		})).Return([]*models.Project{}, nil)
+
+		// Setup route
+		router.GET("/api/v1/projects", handler.GetProjects)
+
+		// Create request
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/projects?started_after=2021-01-01&started_before=2023-12-31", nil)
+		w := httptest.NewRecorder()
+
+		// Serve request
+		router.ServeHTTP(w, req)
+
+		// Assert response
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		// Verify mock expectations
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("invalid start date range", func(t *testing.T) {
+		for _, query := range []string{
+			"started_after=2024-01-01&started_before=2020-01-01",
+			"started_after=last-year",
+			"active_during=2024-13-01",
+		} {
+			// Setup
+			router := setupRouter()
+			mockService := new(MockResumeService)
+			handler := NewResumeHandler(mockService)
+
+			// Setup route
+			router.GET("/api/v1/projects", handler.GetProjects)
+
+			// Create request
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/projects?"+query, nil)
+			w := httptest.NewRecorder()
+
+			// Serve request
+			router.ServeHTTP(w, req)
+
+			// Assert response
+			assert.Equal(t, http.StatusBadRequest, w.Code, query)
+			mockService.AssertNotCalled(t, "GetProjects", mock.Anything, mock.Anything)
+		}
+	})
+	t.Run("oversized limit", func(t *testing.T) {
+		// Setup
+		router := setupRouter()
+		mockService := new(MockResumeService)
+		handler := NewResumeHandler(mockService, WithMaxPageSize(100))
+
+		// Configure mock; the service only sees the clamped limit
+		clamped := func(filters repository.ProjectFilters) bool { return filters.Limit == 100 }
+		mockService.On("GetProjects", mock.Anything, mock.MatchedBy(clamped)).Return([]*models.Project{{ID: 1, Name: "Resume API"}}, nil)
+
+		// Setup route
+		router.GET("/api/v1/projects", handler.GetProjects)
+
+		// Create request
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/projects?limit=10000000", nil)
+		w := httptest.NewRecorder()
+
+		// Serve request
+		router.ServeHTTP(w, req)
+
+		// Assert response
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, `299 - "limit 10000000 exceeds the maximum page size; clamped to 100"`, w.Header().Get("Warning"))
+
+		// Verify mock expectations
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("limit within maximum page size", func(t *testing.T) {
+		// Setup
+		router := setupRouter()
+		mockService := new(MockResumeService)
+		handler := NewResumeHandler(mockService, WithMaxPageSize(100))
+
+		// Configure mock
+		unchanged := func(filters repository.ProjectFilters) bool { return filters.Limit == 100 }
+		mockService.On("GetProjects", mock.Anything, mock.MatchedBy(unchanged)).Return([]*models.Project{}, nil)
+
+		// Setup route
+		router.GET("/api/v1/projects", handler.GetProjects)
+
+		// Create request
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/projects?limit=100", nil)
+		w := httptest.NewRecorder()
+
+		// Serve request
+		router.ServeHTTP(w, req)
+
+		// Assert response
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Empty(t, w.Header().Get("Warning"))
+
+		// Verify mock expectations
+		mockService.AssertExpectations(t)
+	})
+}
+
+// BenchmarkGetProjectsOversizedLimit measures a request for ten million
+// projects, which the maximum page size bounds to serializing a single page
+func BenchmarkGetProjectsOversizedLimit(b *testing.B) {
+	mockService := new(MockResumeService)
+	handler := NewResumeHandler(mockService, WithMaxPageSize(100))
+
+	page := make([]*models.Project, 100)
+	for i := range page {
+		page[i] = &models.Project{ID: i + 1, Name: fmt.Sprintf("Project %d", i+1), Technologies: []string{"Go", "PostgreSQL"}}
+	}
+	clamped := func(filters repository.ProjectFilters) bool { return filters.Limit == len(page) }
+	mockService.On("GetProjects", mock.Anything, mock.MatchedBy(clamped)).Return(page, nil)
+
+	router := setupRouter()
+	router.GET("/api/v1/projects", handler.GetProjects)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/projects?limit=10000000", nil))
+		if w.Code != http.StatusOK {
+			b.Fatalf("unexpected status %d", w.Code)
+		}
+	}
+}
+
+func TestGetSimilarProjects(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// Setup
+		router := setupRouter()
+		mockService := new(MockResumeService)
+		handler := NewResumeHandler(mockService)
+
+		expected := []*models.SimilarProject{
+			{Project: models.Project{ID: 2, Name: "Job Board", Technologies: []string{"Go", "PostgreSQL"}}, SharedTechnologies: 2},
+			{Project: models.Project{ID: 3, Name: "CLI Tool", Technologies: []string{"Go"}}, SharedTechnologies: 1},
+		}
+
+		// Configure mock
+		mockService.On("GetSimilarProjects", mock.Anything, 1, 2).Return(expected, nil)
+
+		// Setup route
+		router.GET("/api/v1/projects/:id/similar", handler.GetSimilarProjects)
+
+		// Create request
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/projects/1/similar?limit=2", nil)
+		w := httptest.NewRecorder()
+
+		// Serve request
+		router.ServeHTTP(w, req)
+
+		// Assert response
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var response []map[string]interface{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		require.Len(t, response, 2)
+		assert.Equal(t, "Job Board", response[0]["name"])
+		assert.Equal(t, 2.0, response[0]["shared_technologies"])
+		assert.Equal(t, "CLI Tool", response[1]["name"])
+
+		// Verify mock expectations
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("invalid id", func(t *testing.T) {
+		// Setup
+		router := setupRouter()
+		mockService := new(MockResumeService)
+		handler := NewResumeHandler(mockService)
+
+		// Setup route
+		router.GET("/api/v1/projects/:id/similar", handler.GetSimilarProjects)
+
+		// Create request
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/projects/abc/similar", nil)
+		w := httptest.NewRecorder()
+
+		// Serve request
+		router.ServeHTTP(w, req)
+
+		// Assert response
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		mockService.AssertNotCalled(t, "GetSimilarProjects", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		// Setup
+		router := setupRouter()
+		mockService := new(MockResumeService)
+		handler := NewResumeHandler(mockService)
+
+		// Configure mock
+		notFound := repository.NewRepositoryError("get", "similar projects", fmt.Errorf("project with id 999 %w", repository.ErrNotFound))
+		mockService.On("GetSimilarProjects", mock.Anything, 999, 0).Return(nil, notFound)
+
+		// Setup route
+		router.GET("/api/v1/projects/:id/similar", handler.GetSimilarProjects)
+
+		// Create request
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/projects/999/similar", nil)
+		w := httptest.NewRecorder()
+
+		// Serve request
+		router.ServeHTTP(w, req)
+
+		// Assert response
+		assert.Equal(t, http.StatusNotFound, w.Code)
+
+		// Verify mock expectations
+		mockService.AssertExpectations(t)
+	})
+}
+
+func TestDeleteProjects(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// Setup
+		router := setupRouter()
+		mockService := new(MockResumeService)
+		handler := NewResumeHandler(mockService)
+
+		// Configure mock
+		mockService.On("DeleteAllProjects", mock.Anything).Return(int64(3), nil)
+
+		// Setup route
+		router.DELETE("/api/v1/projects", handler.DeleteProjects)
+
+		// Create request
+		req := httptest.NewRequest(http.MethodDelete, "/api/v1/projects?confirm=true", nil)
+		w := httptest.NewRecorder()
+
+		// Serve request
+		router.ServeHTTP(w, req)
+
+		// Assert response
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.JSONEq(t, `{"deleted":3}`, w.Body.String())
+
+		// Verify mock expectations
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("missing confirmation", func(t *testing.T) {
+		// Setup
+		router := setupRouter()
+		mockService := new(MockResumeService)
+		handler := NewResumeHandler(mockService)
+
+		// Setup route
+		router.DELETE("/api/v1/projects", handler.DeleteProjects)
+
+		// Create request
+		req := httptest.NewRequest(http.MethodDelete, "/api/v1/projects", nil)
+		w := httptest.NewRecorder()
+
+		// Serve request
+		router.ServeHTTP(w, req)
+
+		// Assert response
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), "Bulk delete requires confirmation")
+		mockService.AssertNotCalled(t, "DeleteAllProjects", mock.Anything)
+	})
+}
+
+func TestRenameSkillCategory(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// Setup
+		router := setupRouter()
+		mockService := new(MockResumeService)
+		handler := NewResumeHandler(mockService)
+
+		// Configure mock
+		mockService.On("RenameSkillCategory", mock.Anything, "Programming Languages", "Languages").Return(int64(5), nil)
+
+		// Setup route
+		router.PUT("/api/v1/skills/category", handler.RenameSkillCategory)
+
+		// Create request
+		body := `{"from": "Programming Languages", "to": "Languages"}`
+		req := httptest.NewRequest(http.MethodPut, "/api/v1/skills/category", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+
+		// Serve request
+		router.ServeHTTP(w, req)
+
+		// Assert response
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.JSONEq(t, `{"updated":5}`, w.Body.String())
+
+		// Verify mock expectations
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("invalid body", func(t *testing.T) {
+		for _, body := range []string{
+			`{"from": "Languages"}`,
+			`{"from": "Languages", "to": "Languages"}`,
+		} {
+			// Setup
+			router := setupRouter()
+			mockService := new(MockResumeService)
+			handler := NewResumeHandler(mockService)
+
+			// Setup route
+			router.PUT("/api/v1/skills/category", handler.RenameSkillCategory)
+
+			// Create request
+			req := httptest.NewRequest(http.MethodPut, "/api/v1/skills/category", strings.NewReader(body))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			// Serve request
+			router.ServeHTTP(w, req)
+
+			// Assert response
+			assert.Equal(t, http.StatusBadRequest, w.Code, body)
+			mockService.AssertNotCalled(t, "RenameSkillCategory", mock.Anything, mock.Anything, mock.Anything)
+		}
+	})
+
+	t.Run("conflicting skill names", func(t *testing.T) {
+		// Setup
+		router := setupRouter()
+		mockService := new(MockResumeService)
+		handler := NewResumeHandler(mockService)
+
+		// Configure mock
+		conflict := repository.NewRepositoryError("update", "skill category", fmt.Errorf("category %q already has a skill with the same name: %w", "Languages", repository.ErrConflict))
+		mockService.On("RenameSkillCategory", mock.Anything, "Programming Languages", "Languages").Return(int64(0), conflict)
+
+		// Setup route
+		router.PUT("/api/v1/skills/category", handler.RenameSkillCategory)
+
+		// Create request
+		body := `{"from": "Programming Languages", "to": "Languages"}`
+		req := httptest.NewRequest(http.MethodPut, "/api/v1/skills/category", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+
+		// Serve request
+		router.ServeHTTP(w, req)
+
+		// Assert response
+		assert.Equal(t, http.StatusConflict, w.Code)
+		assert.Contains(t, w.Body.String(), "CONFLICT")
+
+		// Verify mock expectations
+		mockService.AssertExpectations(t)
+	})
+}
+
+func TestGetResumeHTML(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// Setup
+		router := setupRouter()
+		mockService := new(MockResumeService)
+		handler := NewResumeHandler(mockService)
+
+		resume := &models.Resume{
+			Profile:      &models.Profile{Name: "John Doe", Title: "Software Engineer"},
+			Experiences:  []*models.Experience{{Company: "Acme", Position: "Engineer"}},
+			Skills:       []*models.Skill{{Category: "Languages", Name: "Go"}},
+			Achievements: []*models.Achievement{{Title: "Performance Optimization"}},
+			Education:    []*models.Education{{Institution: "University of Example"}},
+			Projects:     []*models.Project{{Name: "Resume API"}},
+		}
+
+		// Configure mock
+		mockService.On("GetFullResume", mock.Anything).Return(resume, nil)
+
+		// Setup route
+		router.GET("/api/v1/resume.html", handler.GetResumeHTML)
+
+		// Create request
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/resume.html?date_format=Jan%202006", nil)
+		w := httptest.NewRecorder()
+
+		// Serve request
+		router.ServeHTTP(w, req)
+
+		// Assert response
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "text/html; charset=utf-8", w.Header().Get("Content-Type"))
+		assert.Contains(t, w.Body.String(), "John Doe")
+		for _, section := range []string{"experience", "skills", "achievements", "education", "projects"} {
+			assert.Contains(t, w.Body.String(), `<section id="`+section+`">`)
+		}
+
+		// Verify mock expectations
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("byte range", func(t *testing.T) {
+		// Setup
+		router := setupRouter()
+		mockService := new(MockResumeService)
+		handler := NewResumeHandler(mockService)
+
+		updated := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+		resume := &models.Resume{
+			Profile:  &models.Profile{Name: "John Doe", Title: "Software Engineer", UpdatedAt: updated.AddDate(0, -1, 0)},
+			Projects: []*models.Project{{Name: "Resume API", UpdatedAt: updated}},
+		}
+
+		// Configure mock
+		mockService.On("GetFullResume", mock.Anything).Return(resume, nil)
+
+		// Setup route
+		router.GET("/api/v1/resume.html", handler.GetResumeHTML)
+
+		// Fetch the full document to compare the range against
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/resume.html", nil)
+		full := httptest.NewRecorder()
+		router.ServeHTTP(full, req)
+		require.Equal(t, http.StatusOK, full.Code)
+		assert.Equal(t, "bytes", full.Header().Get("Accept-Ranges"))
+		assert.Equal(t, updated.Format(http.TimeFormat), full.Header().Get("Last-Modified"))
+
+		// Create request
+		req = httptest.NewRequest(http.MethodGet, "/api/v1/resume.html", nil)
+		req.Header.Set("Range", "bytes=0-99")
+		req.Header.Set("If-Range", updated.Format(http.TimeFormat))
+		w := httptest.NewRecorder()
+
+		// Serve request
+		router.ServeHTTP(w, req)
+
+		// Assert response
+		assert.Equal(t, http.StatusPartialContent, w.Code)
+		assert.Equal(t, "text/html; charset=utf-8", w.Header().Get("Content-Type"))
+		assert.Equal(t, fmt.Sprintf("bytes 0-99/%d", full.Body.Len()), w.Header().Get("Content-Range"))
+		assert.Equal(t, full.Body.Bytes()[:100], w.Body.Bytes())
+
+		// A stale If-Range returns the whole document
+		req = httptest.NewRequest(http.MethodGet, "/api/v1/resume.html", nil)
+		req.Header.Set("Range", "bytes=0-99")
+		req.Header.Set("If-Range", updated.AddDate(0, 0, -1).Format(http.TimeFormat))
+		w = httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, full.Body.Len(), w.Body.Len())
+
+		// Verify mock expectations
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("unknown date format", func(t *testing.T) {
+		// Setup
+		router := setupRouter()
+		mockService := new(MockResumeService)
+		handler := NewResumeHandler(mockService)
+
+		// Setup route
+		router.GET("/api/v1/resume.html", handler.GetResumeHTML)
+
+		// Create request
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/resume.html?date_format=YYYY", nil)
+		w := httptest.NewRecorder()
+
+		// Serve request
+		router.ServeHTTP(w, req)
+
+		// Assert response
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		mockService.AssertNotCalled(t, "GetFullResume", mock.Anything)
+	})
+}
+
+func TestSearch(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// Setup
+		router := setupRouter()
+		mockService := new(MockResumeService)
+		handler := NewResumeHandler(mockService)
+
+		expected := &models.SearchResults{
+			Results:   []*models.SearchResult{{Type: "skill", ID: 1, Title: "Go"}},
+			Total:     250,
+			Truncated: true,
+		}
+
+		// Configure mock
+		mockService.On("Search", mock.Anything, "go").Return(expected, nil)
+
+		// Setup route
+		router.GET("/api/v1/search", handler.Search)
+
+		// Create request
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/search?q=go", nil)
+		w := httptest.NewRecorder()
+
+		// Serve request
+		router.ServeHTTP(w, req)
+
+		// Assert response
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.JSONEq(t, `{"results":[{"type":"skill","id":1,"title":"Go"}],"total":250,"truncated":true}`, w.Body.String())
+
+		// Verify mock expectations
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("missing term", func(t *testing.T) {
+		// Setup
+		router := setupRouter()
+		mockService := new(MockResumeService)
+		handler := NewResumeHandler(mockService)
+
+		// Setup route
+		router.GET("/api/v1/search", handler.Search)
+
+		// Create request
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/search", nil)
+		w := httptest.NewRecorder()
+
+		// Serve request
+		router.ServeHTTP(w, req)
+
+		// Assert response
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		mockService.AssertNotCalled(t, "Search", mock.Anything, mock.Anything)
+	})
+}
+
+func TestExport(t *testing.T) {
+	t.Run("since", func(t *testing.T) {
+		// Setup
+		router := setupRouter()
+		mockService := new(MockResumeService)
+		handler := NewResumeHandler(mockService)
+
+		since := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		expected := &models.ResumeChanges{
+			Since: since,
+			Resume: models.Resume{
+				Skills: []*models.Skill{{ID: 4, Name: "Go"}},
+			},
+		}
+
+		// Configure mock
+		mockService.On("GetChangesSince", mock.Anything, mock.MatchedBy(since.Equal)).Return(expected, nil)
+
+		// Setup route
+		router.GET("/api/v1/export", handler.Export)
+
+		// Create request
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/export?since=2024-01-01T00:00:00Z", nil)
+		w := httptest.NewRecorder()
+
+		// Serve request
+		router.ServeHTTP(w, req)
+
+		// Assert response
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var response models.ResumeChanges
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.True(t, since.Equal(response.Since))
+		assert.Nil(t, response.Profile)
+		require.Len(t, response.Skills, 1)
+		assert.Equal(t, "Go", response.Skills[0].Name)
+
+		// Verify mock expectations
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("next link uses the public base URL", func(t *testing.T) {
+		// Setup
+		router := setupRouter()
+		mockService := new(MockResumeService)
+		handler := NewResumeHandler(mockService, WithPublicBaseURL("https://api.example.com/"))
+		mockService.On("GetChangesSince", mock.Anything, mock.Anything).Return(&models.ResumeChanges{}, nil)
+		router.GET("/api/v1/export", handler.Export)
+
+		// Serve request
+		before := time.Now().UTC().Truncate(time.Second)
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/export?since=2024-01-01T00:00:00Z", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		// The next export starts from when this one was taken
+		assert.Equal(t, http.StatusOK, w.Code)
+		var response models.ResumeChanges
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		require.NotNil(t, response.Links)
+		next, err := url.Parse(response.Links.Next)
+		require.NoError(t, err)
+		assert.Equal(t, "https://api.example.com/api/v1/export", next.Scheme+"://"+next.Host+next.Path)
+		since, err := time.Parse(time.RFC3339, next.Query().Get("since"))
+		require.NoError(t, err)
+		assert.False(t, since.Before(before))
+	})
+
+	t.Run("without since", func(t *testing.T) {
+		// Setup
+		router := setupRouter()
+		mockService := new(MockResumeService)
+		handler := NewResumeHandler(mockService)
+
+		// Configure mock
+		mockService.On("GetChangesSince", mock.Anything, time.Time{}).Return(&models.ResumeChanges{}, nil)
+
+		// Setup route
+		router.GET("/api/v1/export", handler.Export)
+
+		// Create request
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/export", nil)
+		w := httptest.NewRecorder()
+
+		// Serve request
+		router.ServeHTTP(w, req)
+
+		// Assert response
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		// Verify mock expectations
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("invalid since", func(t *testing.T) {
+		// Setup
+		router := setupRouter()
+		mockService := new(MockResumeService)
+		handler := NewResumeHandler(mockService)
+
+		// Setup route
+		router.GET("/api/v1/export", handler.Export)
+
+		// Create request
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/export?since=yesterday", nil)
+		w := httptest.NewRecorder()
+
+		// Serve request
+		router.ServeHTTP(w, req)
+
+		// Assert response
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		mockService.AssertNotCalled(t, "GetChangesSince", mock.Anything, mock.Anything)
+	})
 }
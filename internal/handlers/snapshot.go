@@ -0,0 +1,54 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/npmulder/resume-api/internal/models"
+)
+
+// ServedFromHeader marks responses that weren't built from live data
+const ServedFromHeader = "X-Served-From"
+
+// ResumeSnapshot holds the last full resume read successfully, so the
+// resume can still be served while the database is unavailable
+type ResumeSnapshot struct {
+	mu     sync.RWMutex
+	resume *models.Resume
+}
+
+// NewResumeSnapshot creates an empty snapshot, filled by the first
+// successful read
+func NewResumeSnapshot() *ResumeSnapshot {
+	return &ResumeSnapshot{}
+}
+
+// LoadResumeSnapshot creates a snapshot from the resume JSON file at path
+func LoadResumeSnapshot(path string) (*ResumeSnapshot, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read resume snapshot: %w", err)
+	}
+
+	var resume models.Resume
+	if err := json.Unmarshal(data, &resume); err != nil {
+		return nil, fmt.Errorf("failed to parse resume snapshot %s: %w", path, err)
+	}
+	return &ResumeSnapshot{resume: &resume}, nil
+}
+
+// Store replaces the snapshot with resume
+func (s *ResumeSnapshot) Store(resume *models.Resume) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.resume = resume
+}
+
+// Resume returns the snapshot, or nil when there is none yet
+func (s *ResumeSnapshot) Resume() *models.Resume {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.resume
+}
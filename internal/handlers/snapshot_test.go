@@ -0,0 +1,158 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/npmulder/resume-api/internal/models"
+	"github.com/npmulder/resume-api/internal/repository"
+)
+
+// writeSnapshot writes a resume snapshot file and returns its path
+func writeSnapshot(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "snapshot.json")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func TestLoadResumeSnapshot(t *testing.T) {
+	t.Run("valid file", func(t *testing.T) {
+		snapshot, err := LoadResumeSnapshot(writeSnapshot(t, `{"profile":{"name":"Snapshot Doe"},"skills":[{"name":"Go"}]}`))
+		require.NoError(t, err)
+		require.NotNil(t, snapshot.Resume())
+		assert.Equal(t, "Snapshot Doe", snapshot.Resume().Profile.Name)
+		assert.Len(t, snapshot.Resume().Skills, 1)
+	})
+
+	t.Run("missing file", func(t *testing.T) {
+		_, err := LoadResumeSnapshot(filepath.Join(t.TempDir(), "missing.json"))
+		assert.Error(t, err)
+	})
+
+	t.Run("invalid JSON", func(t *testing.T) {
+		_, err := LoadResumeSnapshot(writeSnapshot(t, `{"profile":`))
+		assert.Error(t, err)
+	})
+}
+
+func TestGetResumeHTMLSnapshot(t *testing.T) {
+	dbErr := errors.New("failed to connect to database")
+
+	t.Run("serves the snapshot when the database fails", func(t *testing.T) {
+		// Setup
+		router := setupRouter()
+		mockService := new(MockResumeService)
+		snapshot, err := LoadResumeSnapshot(writeSnapshot(t, `{"profile":{"name":"Snapshot Doe","title":"Engineer"}}`))
+		require.NoError(t, err)
+		handler := NewResumeHandler(mockService, WithResumeSnapshot(snapshot))
+
+		// Configure mock
+		mockService.On("GetFullResume", mock.Anything).Return(nil, dbErr)
+
+		// Setup route
+		router.GET("/api/v1/resume.html", handler.GetResumeHTML)
+
+		// Create request
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/resume.html", nil)
+		w := httptest.NewRecorder()
+
+		// Serve request
+		router.ServeHTTP(w, req)
+
+		// Assert response
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "snapshot", w.Header().Get(ServedFromHeader))
+		assert.Contains(t, w.Body.String(), "Snapshot Doe")
+
+		// Verify mock expectations
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("refreshes the snapshot on successful reads", func(t *testing.T) {
+		// Setup
+		router := setupRouter()
+		mockService := new(MockResumeService)
+		handler := NewResumeHandler(mockService, WithResumeSnapshot(NewResumeSnapshot()))
+
+		// Configure mock
+		mockService.On("GetFullResume", mock.Anything).Return(&models.Resume{Profile: &models.Profile{Name: "Live Doe"}}, nil).Once()
+		mockService.On("GetFullResume", mock.Anything).Return(nil, dbErr).Once()
+
+		// Setup route
+		router.GET("/api/v1/resume.html", handler.GetResumeHTML)
+
+		// The first request reads live data
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/resume.html", nil))
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Empty(t, w.Header().Get(ServedFromHeader))
+
+		// The second is served from what the first read
+		w = httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/resume.html", nil))
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "snapshot", w.Header().Get(ServedFromHeader))
+		assert.Contains(t, w.Body.String(), "Live Doe")
+
+		// Verify mock expectations
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("fails without a snapshot", func(t *testing.T) {
+		// Setup
+		router := setupRouter()
+		mockService := new(MockResumeService)
+		handler := NewResumeHandler(mockService, WithResumeSnapshot(NewResumeSnapshot()))
+
+		// Configure mock
+		mockService.On("GetFullResume", mock.Anything).Return(nil, dbErr)
+
+		// Setup route
+		router.GET("/api/v1/resume.html", handler.GetResumeHTML)
+
+		// Create request
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/resume.html", nil)
+		w := httptest.NewRecorder()
+
+		// Serve request
+		router.ServeHTTP(w, req)
+
+		// Assert response
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+		assert.Empty(t, w.Header().Get(ServedFromHeader))
+	})
+
+	t.Run("does not hide a missing profile", func(t *testing.T) {
+		// Setup
+		router := setupRouter()
+		mockService := new(MockResumeService)
+		snapshot, err := LoadResumeSnapshot(writeSnapshot(t, `{"profile":{"name":"Snapshot Doe"}}`))
+		require.NoError(t, err)
+		handler := NewResumeHandler(mockService, WithResumeSnapshot(snapshot))
+
+		// Configure mock
+		mockService.On("GetFullResume", mock.Anything).Return(nil, repository.ErrNotFound)
+
+		// Setup route
+		router.GET("/api/v1/resume.html", handler.GetResumeHTML)
+
+		// Create request
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/resume.html", nil)
+		w := httptest.NewRecorder()
+
+		// Serve request
+		router.ServeHTTP(w, req)
+
+		// Assert response
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
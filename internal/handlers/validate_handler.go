@@ -0,0 +1,49 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/npmulder/resume-api/internal/seed"
+	"github.com/npmulder/resume-api/internal/utils"
+)
+
+// ValidationReport is the outcome of validating a resume payload
+type ValidationReport struct {
+	Valid    bool     `json:"valid" example:"false"`
+	Problems []string `json:"problems" example:"experiences[0] (Acme): end_date 2021-12-31 is before start_date 2022-01-01"`
+}
+
+// ValidateResume handles the request to validate a resume payload without
+// importing it. It runs the same schema and business rules as the seed
+// import and reports every problem at once; nothing is written.
+// @Summary Validate a resume payload
+// @Description Check a resume JSON in the seed/import format against the import schema and business rules without touching the database
+// @Tags validate
+// @Accept json
+// @Produce json
+// @Param resume body object true "Resume payload in the seed data format"
+// @Success 200 {object} ValidationReport "Payload is valid"
+// @Failure 400 {object} models.APIError "Bad request"
+// @Failure 422 {object} ValidationReport "Payload is invalid"
+// @Router /api/v1/validate [post]
+func ValidateResume(c *gin.Context) {
+	data, err := c.GetRawData()
+	if err != nil {
+		utils.ValidationError(c, "Invalid request body", err.Error())
+		return
+	}
+
+	err = seed.Validate(data)
+	var validationErr *seed.ValidationError
+	switch {
+	case err == nil:
+		utils.Respond(c, http.StatusOK, ValidationReport{Valid: true, Problems: []string{}})
+	case errors.As(err, &validationErr):
+		utils.Respond(c, http.StatusUnprocessableEntity, ValidationReport{Problems: validationErr.Problems})
+	default:
+		utils.HandleError(c, err)
+	}
+}
@@ -0,0 +1,88 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateResume(t *testing.T) {
+	// Setup
+	router := setupRouter()
+
+	// Setup route
+	router.POST("/api/v1/validate", ValidateResume)
+
+	t.Run("valid payload", func(t *testing.T) {
+		// Create request
+		body := `{
+			"profile": {"name": "Jane Doe", "title": "Engineer", "email": "jane@example.com"},
+			"experiences": [{"company": "Acme", "position": "Engineer", "start_date": "2020-01-01", "end_date": "2022-06-30"}],
+			"skills": [{"category": "Languages", "name": "Go", "level": "expert"}],
+			"achievements": [{"title": "Uptime", "category": "performance"}]
+		}`
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/validate", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+
+		// Serve request
+		router.ServeHTTP(w, req)
+
+		// Assert response
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var report ValidationReport
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &report))
+		assert.True(t, report.Valid)
+		assert.Empty(t, report.Problems)
+	})
+
+	t.Run("reports every violation", func(t *testing.T) {
+		// Create request
+		body := `{
+			"profile": {"name": "Jane Doe", "email": "jane@example.com"},
+			"experiences": [{"company": "Acme", "position": "Engineer", "start_date": "2022-01-01", "end_date": "2021-12-31"}],
+			"skills": [{"category": "Languages", "name": "Go", "level": "guru"}],
+			"achievements": [{"title": "Support", "category": "customer"}]
+		}`
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/validate", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+
+		// Serve request
+		router.ServeHTTP(w, req)
+
+		// Assert response
+		assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+
+		var report ValidationReport
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &report))
+		assert.False(t, report.Valid)
+		require.Len(t, report.Problems, 4)
+
+		problems := strings.Join(report.Problems, "\n")
+		assert.Contains(t, problems, "/profile")
+		assert.Contains(t, problems, "/skills/0/level")
+		assert.Contains(t, problems, "experiences[0] (Acme): end_date 2021-12-31 is before start_date 2022-01-01")
+		assert.Contains(t, problems, `achievements[0] (Support): invalid achievement category: "customer"`)
+	})
+
+	t.Run("malformed JSON", func(t *testing.T) {
+		// Create request
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/validate", strings.NewReader(`{"profile": `))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+
+		// Serve request
+		router.ServeHTTP(w, req)
+
+		// Assert response
+		assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+		assert.Contains(t, w.Body.String(), "invalid JSON")
+	})
+}
@@ -0,0 +1,93 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/npmulder/resume-api/internal/repository"
+	"github.com/npmulder/resume-api/internal/utils"
+)
+
+// WebhookReplayer sends a stored webhook payload to its URL again
+type WebhookReplayer interface {
+	Replay(ctx context.Context, url string, payload []byte) error
+}
+
+// WebhookHandler handles inspection and replay of failed webhook deliveries.
+type WebhookHandler struct {
+	failures repository.WebhookFailureRepository
+	replayer WebhookReplayer
+}
+
+// NewWebhookHandler creates a new WebhookHandler.
+func NewWebhookHandler(failures repository.WebhookFailureRepository, replayer WebhookReplayer) *WebhookHandler {
+	return &WebhookHandler{failures: failures, replayer: replayer}
+}
+
+// WebhookFailureURI defines the path parameters of single webhook failure routes
+type WebhookFailureURI struct {
+	ID int `uri:"id" binding:"required,min=1"`
+}
+
+// GetFailures handles the request to list failed webhook deliveries.
+// @Summary List failed webhook deliveries
+// @Description Get the webhook events whose delivery failed after every retry, most recent first
+// @Tags admin
+// @Produce json
+// @Param X-API-Key header string true "API key"
+// @Success 200 {array} models.WebhookFailure "Failed webhook deliveries"
+// @Failure 401 {object} models.APIError "Unauthorized"
+// @Failure 500 {object} models.APIError "Internal server error"
+// @Router /api/v1/admin/webhooks/failures [get]
+func (h *WebhookHandler) GetFailures(c *gin.Context) {
+	failures, err := h.failures.GetFailures(c.Request.Context())
+	if err != nil {
+		utils.HandleError(c, err)
+		return
+	}
+
+	utils.Respond(c, http.StatusOK, failures)
+}
+
+// ReplayFailure handles the request to deliver a failed webhook event again.
+// @Summary Replay a failed webhook delivery
+// @Description Send a failed webhook event to its URL once more, removing it from the failure log when delivery succeeds
+// @Tags admin
+// @Produce json
+// @Param id path int true "Webhook failure ID"
+// @Param X-API-Key header string true "API key"
+// @Success 200 {object} map[string]interface{} "Replayed failure"
+// @Failure 400 {object} models.APIError "Bad request"
+// @Failure 401 {object} models.APIError "Unauthorized"
+// @Failure 404 {object} models.APIError "Failure not found"
+// @Failure 502 {object} models.APIError "Webhook delivery failed again"
+// @Failure 500 {object} models.APIError "Internal server error"
+// @Router /api/v1/admin/webhooks/failures/{id}/replay [post]
+// @Response 200 {object} map[string]interface{} "Example response" {"id":3,"replayed":true}
+func (h *WebhookHandler) ReplayFailure(c *gin.Context) {
+	var uri WebhookFailureURI
+	if err := c.ShouldBindUri(&uri); err != nil {
+		utils.ValidationError(c, "Invalid webhook failure ID", err.Error())
+		return
+	}
+
+	ctx := c.Request.Context()
+	failure, err := h.failures.GetFailureByID(ctx, uri.ID)
+	if err != nil {
+		utils.HandleError(c, err)
+		return
+	}
+
+	if err := h.replayer.Replay(ctx, failure.URL, failure.Payload); err != nil {
+		utils.BadGateway(c, "Webhook delivery failed", err.Error())
+		return
+	}
+
+	if err := h.failures.DeleteFailure(ctx, failure.ID); err != nil {
+		utils.HandleError(c, err)
+		return
+	}
+
+	utils.Respond(c, http.StatusOK, gin.H{"id": failure.ID, "replayed": true})
+}
@@ -0,0 +1,161 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/npmulder/resume-api/internal/models"
+	"github.com/npmulder/resume-api/internal/repository"
+)
+
+// failureLog is an in-memory webhook failure repository
+type failureLog struct {
+	failures map[int]*models.WebhookFailure
+}
+
+func newFailureLog(failures ...*models.WebhookFailure) *failureLog {
+	l := &failureLog{failures: make(map[int]*models.WebhookFailure)}
+	for _, failure := range failures {
+		l.failures[failure.ID] = failure
+	}
+	return l
+}
+
+func (l *failureLog) RecordFailure(ctx context.Context, failure *models.WebhookFailure) error {
+	failure.ID = len(l.failures) + 1
+	l.failures[failure.ID] = failure
+	return nil
+}
+
+func (l *failureLog) GetFailures(ctx context.Context) ([]*models.WebhookFailure, error) {
+	failures := []*models.WebhookFailure{}
+	for id := len(l.failures); id > 0; id-- {
+		if failure, ok := l.failures[id]; ok {
+			failures = append(failures, failure)
+		}
+	}
+	return failures, nil
+}
+
+func (l *failureLog) GetFailureByID(ctx context.Context, id int) (*models.WebhookFailure, error) {
+	failure, ok := l.failures[id]
+	if !ok {
+		return nil, repository.NewRepositoryError("get", "webhook failure", fmt.Errorf("webhook failure with id %d %w", id, repository.ErrNotFound))
+	}
+	return failure, nil
+}
+
+func (l *failureLog) DeleteFailure(ctx context.Context, id int) error {
+	if _, ok := l.failures[id]; !ok {
+		return repository.NewRepositoryError("delete", "webhook failure", fmt.Errorf("webhook failure with id %d %w", id, repository.ErrNotFound))
+	}
+	delete(l.failures, id)
+	return nil
+}
+
+// stubReplayer records replayed deliveries and fails them with err
+type stubReplayer struct {
+	err      error
+	replayed []string
+}
+
+func (r *stubReplayer) Replay(ctx context.Context, url string, payload []byte) error {
+	r.replayed = append(r.replayed, url+" "+string(payload))
+	return r.err
+}
+
+func testWebhookFailure(id int) *models.WebhookFailure {
+	return &models.WebhookFailure{
+		ID:       id,
+		URL:      "https://hooks.example.com/resume",
+		Payload:  json.RawMessage(`{"entity":"profile","action":"updated","id":1}`),
+		Error:    "unexpected status 500",
+		Attempts: 3,
+		FailedAt: time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestGetWebhookFailures(t *testing.T) {
+	// Setup
+	router := setupRouter()
+	handler := NewWebhookHandler(newFailureLog(testWebhookFailure(1), testWebhookFailure(2)), &stubReplayer{})
+	router.GET("/api/v1/admin/webhooks/failures", handler.GetFailures)
+
+	// Create request
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/admin/webhooks/failures", nil)
+	w := httptest.NewRecorder()
+
+	// Serve request
+	router.ServeHTTP(w, req)
+
+	// Assert response
+	assert.Equal(t, http.StatusOK, w.Code)
+	var response []models.WebhookFailure
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	require.Len(t, response, 2)
+	assert.Equal(t, 2, response[0].ID)
+	assert.Equal(t, "https://hooks.example.com/resume", response[0].URL)
+	assert.JSONEq(t, `{"entity":"profile","action":"updated","id":1}`, string(response[0].Payload))
+	assert.Equal(t, 3, response[0].Attempts)
+}
+
+func TestReplayWebhookFailure(t *testing.T) {
+	serve := func(handler *WebhookHandler, path string) *httptest.ResponseRecorder {
+		router := setupRouter()
+		router.POST("/api/v1/admin/webhooks/failures/:id/replay", handler.ReplayFailure)
+
+		req := httptest.NewRequest(http.MethodPost, path, nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	t.Run("success removes the failure", func(t *testing.T) {
+		failures := newFailureLog(testWebhookFailure(1))
+		replayer := &stubReplayer{}
+
+		w := serve(NewWebhookHandler(failures, replayer), "/api/v1/admin/webhooks/failures/1/replay")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.JSONEq(t, `{"id":1,"replayed":true}`, w.Body.String())
+		assert.Equal(t, []string{`https://hooks.example.com/resume {"entity":"profile","action":"updated","id":1}`}, replayer.replayed)
+		assert.Empty(t, failures.failures)
+	})
+
+	t.Run("delivery failure keeps the failure", func(t *testing.T) {
+		failures := newFailureLog(testWebhookFailure(1))
+		replayer := &stubReplayer{err: errors.New("unexpected status 503")}
+
+		w := serve(NewWebhookHandler(failures, replayer), "/api/v1/admin/webhooks/failures/1/replay")
+
+		assert.Equal(t, http.StatusBadGateway, w.Code)
+		var response models.APIError
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, models.ErrCodeBadGateway, response.Code)
+		assert.Len(t, failures.failures, 1)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		replayer := &stubReplayer{}
+
+		w := serve(NewWebhookHandler(newFailureLog(), replayer), "/api/v1/admin/webhooks/failures/7/replay")
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Empty(t, replayer.replayed)
+	})
+
+	t.Run("invalid id", func(t *testing.T) {
+		w := serve(NewWebhookHandler(newFailureLog(), &stubReplayer{}), "/api/v1/admin/webhooks/failures/abc/replay")
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/npmulder/resume-api/internal/config"
 	"github.com/npmulder/resume-api/internal/database"
 	"github.com/npmulder/resume-api/internal/handlers"
+	"github.com/npmulder/resume-api/internal/middleware"
 	"github.com/npmulder/resume-api/internal/models"
 	"github.com/npmulder/resume-api/internal/repository"
 	"github.com/npmulder/resume-api/internal/repository/postgres"
@@ -82,6 +83,9 @@ func (tdb *TestDB) CleanupTables(t *testing.T) {
 
 	// Clean tables in correct order due to potential foreign keys
 	tables := []string{
+		"contact_messages",
+		"webhook_failures",
+		"profile_history",
 		"projects",
 		"education",
 		"achievements",
@@ -115,6 +119,9 @@ func getTestPortFromEnv(key string, fallback int) int {
 	return fallback
 }
 
+// testAPIKey is the API key protecting write endpoints in the test application
+const testAPIKey = "test-api-key"
+
 // setupTestApp creates a test application with real repositories, services, and handlers
 func setupTestApp(t *testing.T, db *database.DB) (*gin.Engine, *repository.Repositories) {
 	// Create repositories
@@ -152,6 +159,9 @@ func setupTestApp(t *testing.T, db *database.DB) (*gin.Engine, *repository.Repos
 	router.GET("/api/v1/achievements", resumeHandler.GetAchievements)
 	router.GET("/api/v1/education", resumeHandler.GetEducation)
 	router.GET("/api/v1/projects", resumeHandler.GetProjects)
+	router.GET("/api/v1/recent", resumeHandler.GetRecent)
+	router.GET("/api/v1/profile/history", middleware.APIKeyMiddleware(testAPIKey), resumeHandler.GetProfileHistory)
+	router.DELETE("/api/v1/projects", middleware.APIKeyMiddleware(testAPIKey), resumeHandler.DeleteProjects)
 
 	return router, repos
 }
@@ -215,6 +225,54 @@ func TestProfileEndToEnd(t *testing.T) {
 	assert.Equal(t, *profile.Summary, *responseProfile.Summary)
 }
 
+func TestProfileHistoryEndToEnd(t *testing.T) {
+	testDB := setupTestDB(t)
+	defer testDB.Close()
+	testDB.CleanupTables(t)
+
+	router, repos := setupTestApp(t, testDB.DB)
+
+	ctx := context.Background()
+	profile := &models.Profile{
+		Name:  "John Doe",
+		Title: "Software Engineer",
+		Email: "john.doe@example.com",
+	}
+	require.NoError(t, repos.Profile.CreateProfile(ctx, profile))
+
+	// Update the profile twice
+	profile.Title = "Senior Software Engineer"
+	require.NoError(t, repos.Profile.UpdateProfile(ctx, profile))
+	profile.Title = "Staff Software Engineer"
+	require.NoError(t, repos.Profile.UpdateProfile(ctx, profile))
+
+	// History requires the API key
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/profile/history", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+
+	req = httptest.NewRequest(http.MethodGet, "/api/v1/profile/history", nil)
+	req.Header.Set(middleware.APIKeyHeader, testAPIKey)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var history []*models.ProfileVersion
+	err := json.Unmarshal(w.Body.Bytes(), &history)
+	require.NoError(t, err)
+
+	// Each update records the version it replaced, newest first
+	require.Len(t, history, 2)
+	assert.Equal(t, "Senior Software Engineer", history[0].Title)
+	assert.Equal(t, "Software Engineer", history[1].Title)
+	for _, version := range history {
+		assert.Equal(t, profile.ID, version.ProfileID)
+		assert.False(t, version.ReplacedAt.IsZero())
+	}
+}
+
 func TestExperiencesEndToEnd(t *testing.T) {
 	testDB := setupTestDB(t)
 	defer testDB.Close()
@@ -372,6 +430,14 @@ func TestSkillsEndToEnd(t *testing.T) {
 	assert.Len(t, responseSkills, 1)
 	assert.Equal(t, "Go", responseSkills[0].Name)
 
+	// A filter matching nothing is an empty list, not a 404
+	req = httptest.NewRequest(http.MethodGet, "/api/v1/skills?category=Nonexistent", nil)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `[]`, w.Body.String())
+
 	// Test filtering by featured
 	req = httptest.NewRequest(http.MethodGet, "/api/v1/skills?featured=true", nil)
 	w = httptest.NewRecorder()
@@ -402,7 +468,7 @@ func TestAchievementsEndToEnd(t *testing.T) {
 			Title:        "Performance Optimization",
 			Description:  stringPtr("Improved system performance by 50%"),
 			YearAchieved: intPtr(2023),
-			Category:     stringPtr("Technical"),
+			Category:     stringPtr(models.AchievementCategoryInnovation),
 			IsFeatured:   true,
 			OrderIndex:   0,
 		},
@@ -410,7 +476,7 @@ func TestAchievementsEndToEnd(t *testing.T) {
 			Title:        "Team Leadership",
 			Description:  stringPtr("Led a team of 5 engineers"),
 			YearAchieved: intPtr(2022),
-			Category:     stringPtr("Leadership"),
+			Category:     stringPtr(models.AchievementCategoryLeadership),
 			IsFeatured:   true,
 			OrderIndex:   1,
 		},
@@ -418,7 +484,7 @@ func TestAchievementsEndToEnd(t *testing.T) {
 			Title:        "Conference Speaker",
 			Description:  stringPtr("Spoke at GopherCon 2021"),
 			YearAchieved: intPtr(2021),
-			Category:     stringPtr("Community"),
+			Category:     stringPtr(models.AchievementCategoryTeamwork),
 			IsFeatured:   false,
 			OrderIndex:   2,
 		},
@@ -652,3 +718,112 @@ func TestProjectsEndToEnd(t *testing.T) {
 		assert.True(t, project.IsFeatured)
 	}
 }
+
+func TestProjectsBulkDeleteEndToEnd(t *testing.T) {
+	testDB := setupTestDB(t)
+	defer testDB.Close()
+	testDB.CleanupTables(t)
+
+	router, repos := setupTestApp(t, testDB.DB)
+
+	// Create test projects
+	ctx := context.Background()
+	for i, name := range []string{"Resume API", "Personal Website", "Side Project"} {
+		project := &models.Project{
+			Name:       name,
+			Status:     models.ProjectStatusActive,
+			OrderIndex: i,
+		}
+		err := repos.Project.CreateProject(ctx, project)
+		require.NoError(t, err)
+	}
+
+	// Without confirmation nothing is deleted
+	req := httptest.NewRequest(http.MethodDelete, "/api/v1/projects", nil)
+	req.Header.Set(middleware.APIKeyHeader, testAPIKey)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	// Without an API key the request is rejected
+	req = httptest.NewRequest(http.MethodDelete, "/api/v1/projects?confirm=true", nil)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+
+	// Test DELETE /api/v1/projects?confirm=true
+	req = httptest.NewRequest(http.MethodDelete, "/api/v1/projects?confirm=true", nil)
+	req.Header.Set(middleware.APIKeyHeader, testAPIKey)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response map[string]int64
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), response["deleted"])
+
+	// The table should now be empty
+	count, err := testDB.CountRows(ctx, "projects")
+	require.NoError(t, err)
+	assert.Zero(t, count)
+}
+
+func TestRecentEndToEnd(t *testing.T) {
+	testDB := setupTestDB(t)
+	defer testDB.Close()
+	testDB.CleanupTables(t)
+
+	router, repos := setupTestApp(t, testDB.DB)
+
+	// Create test data across sections
+	ctx := context.Background()
+	skills := []*models.Skill{
+		{Category: "Languages", Name: "Go"},
+		{Category: "Languages", Name: "Python"},
+	}
+	for _, skill := range skills {
+		err := repos.Skill.CreateSkill(ctx, skill)
+		require.NoError(t, err)
+	}
+
+	projects := []*models.Project{
+		{Name: "Resume API", Status: models.ProjectStatusActive},
+		{Name: "Homelab", Status: models.ProjectStatusActive},
+	}
+	for _, project := range projects {
+		err := repos.Project.CreateProject(ctx, project)
+		require.NoError(t, err)
+	}
+
+	// Update a couple of items so they become the most recent
+	time.Sleep(10 * time.Millisecond)
+	skills[0].IsFeatured = true
+	require.NoError(t, repos.Skill.UpdateSkill(ctx, skills[0]))
+
+	time.Sleep(10 * time.Millisecond)
+	projects[0].IsFeatured = true
+	require.NoError(t, repos.Project.UpdateProject(ctx, projects[0]))
+
+	// Test GET /api/v1/recent
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/recent?limit=3", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var items []*models.RecentItem
+	err := json.Unmarshal(w.Body.Bytes(), &items)
+	require.NoError(t, err)
+
+	require.Len(t, items, 3)
+	assert.Equal(t, models.RecentItemTypeProject, items[0].Type)
+	assert.Equal(t, projects[0].ID, items[0].ID)
+	assert.Equal(t, "Resume API", items[0].Title)
+	assert.Equal(t, models.RecentItemTypeSkill, items[1].Type)
+	assert.Equal(t, skills[0].ID, items[1].ID)
+	assert.False(t, items[1].UpdatedAt.After(items[0].UpdatedAt))
+}
@@ -0,0 +1,102 @@
+// Package cache records cache lookup metrics. It only depends on the global
+// OpenTelemetry meter provider, so the service layer can record lookups
+// without importing the HTTP middleware that sets the provider up.
+package cache
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"sync/atomic"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// meterName matches the meter the HTTP metrics are recorded with
+const meterName = "github.com/npmulder/resume-api"
+
+var (
+	hitsTotal   metric.Int64Counter
+	missesTotal metric.Int64Counter
+
+	// Running lookup totals read by the cache_hit_ratio callback
+	hits   atomic.Int64
+	misses atomic.Int64
+
+	// Guards creating the instruments on the first lookup
+	initOnce sync.Once
+	disabled bool
+)
+
+// initInstruments creates the cache metrics on the global meter provider.
+// Instruments created before the provider is set up are forwarded to it.
+func initInstruments() error {
+	meter := otel.Meter(meterName)
+
+	var err error
+	hitsTotal, err = meter.Int64Counter(
+		"cache_hits_total",
+		metric.WithDescription("Total number of cache hits"),
+	)
+	if err != nil {
+		return err
+	}
+
+	missesTotal, err = meter.Int64Counter(
+		"cache_misses_total",
+		metric.WithDescription("Total number of cache misses"),
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = meter.Float64ObservableGauge(
+		"cache_hit_ratio",
+		metric.WithDescription("Ratio of cache hits to total cache lookups"),
+		metric.WithFloat64Callback(func(_ context.Context, o metric.Float64Observer) error {
+			o.Observe(HitRatio())
+			return nil
+		}),
+	)
+	return err
+}
+
+// enabled creates the instruments on the first call and reports whether they
+// are available. A failure is logged once and disables tracking.
+func enabled() bool {
+	initOnce.Do(func() {
+		if err := initInstruments(); err != nil {
+			disabled = true
+			slog.Warn("cache metrics disabled", "error", err)
+		}
+	})
+	return !disabled
+}
+
+// TrackLookup records the outcome of a cache lookup
+func TrackLookup(ctx context.Context, hit bool) {
+	if !enabled() {
+		return
+	}
+
+	if hit {
+		hits.Add(1)
+		hitsTotal.Add(ctx, 1)
+		return
+	}
+
+	misses.Add(1)
+	missesTotal.Add(ctx, 1)
+}
+
+// HitRatio returns the ratio of cache hits to lookups, or 0 when there have
+// been no lookups yet
+func HitRatio() float64 {
+	h := hits.Load()
+	total := h + misses.Load()
+	if total == 0 {
+		return 0
+	}
+	return float64(h) / float64(total)
+}
@@ -0,0 +1,58 @@
+package cache
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// collectGauge returns the current value of the named gauge from reader
+func collectGauge(t *testing.T, reader sdkmetric.Reader, name string) float64 {
+	t.Helper()
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(context.Background(), &rm))
+
+	for _, scope := range rm.ScopeMetrics {
+		for _, m := range scope.Metrics {
+			if m.Name != name {
+				continue
+			}
+			gauge, ok := m.Data.(metricdata.Gauge[float64])
+			require.True(t, ok, "metric %s is not a float64 gauge", name)
+			require.NotEmpty(t, gauge.DataPoints)
+			return gauge.DataPoints[0].Value
+		}
+	}
+
+	t.Fatalf("metric %s not found", name)
+	return 0
+}
+
+func TestHitRatioGauge(t *testing.T) {
+	reader := sdkmetric.NewManualReader()
+	otel.SetMeterProvider(sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader)))
+	require.True(t, enabled())
+	hits.Store(0)
+	misses.Store(0)
+
+	ctx := context.Background()
+	assert.Equal(t, 0.0, collectGauge(t, reader, "cache_hit_ratio"))
+
+	for i := 0; i < 3; i++ {
+		TrackLookup(ctx, true)
+	}
+	TrackLookup(ctx, false)
+
+	assert.InDelta(t, 0.75, collectGauge(t, reader, "cache_hit_ratio"), 1e-9)
+
+	TrackLookup(ctx, false)
+	TrackLookup(ctx, false)
+
+	assert.InDelta(t, 0.5, collectGauge(t, reader, "cache_hit_ratio"), 1e-9)
+}
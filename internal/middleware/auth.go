@@ -0,0 +1,107 @@
+package middleware
+
+import (
+	"crypto/subtle"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/npmulder/resume-api/internal/utils"
+)
+
+// APIKeyHeader is the request header carrying the API key
+const APIKeyHeader = "X-API-Key"
+
+// APIKeyMiddleware returns a middleware that requires a matching API key in the
+// X-API-Key header. When no key is configured, every request is rejected so that
+// protected endpoints are never left open by accident. Responses are marked
+// "no-store" so shared caches never keep what only key holders may read.
+func APIKeyMiddleware(apiKey string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Header("Cache-Control", "no-store")
+
+		if apiKey == "" {
+			utils.Forbidden(c, "Write access is disabled")
+			return
+		}
+
+		provided := c.GetHeader(APIKeyHeader)
+		if provided == "" {
+			utils.Unauthorized(c, "Missing API key")
+			return
+		}
+
+		if subtle.ConstantTimeCompare([]byte(provided), []byte(apiKey)) != 1 {
+			utils.Unauthorized(c, "Invalid API key")
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// HasAPIKey reports whether the request carries apiKey in the X-API-Key
+// header, for public routes that show more to authenticated clients. It is
+// always false when no key is configured.
+func HasAPIKey(c *gin.Context, apiKey string) bool {
+	if apiKey == "" {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(c.GetHeader(APIKeyHeader)), []byte(apiKey)) == 1
+}
+
+// BearerTokenMiddleware returns a middleware that requires an
+// "Authorization: Bearer <token>" header matching token. When token is empty
+// the middleware allows every request, so protection is opt-in.
+func BearerTokenMiddleware(token string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if token == "" {
+			c.Next()
+			return
+		}
+
+		provided, ok := strings.CutPrefix(c.GetHeader("Authorization"), "Bearer ")
+		if !ok || provided == "" {
+			c.Header("WWW-Authenticate", "Bearer")
+			utils.Unauthorized(c, "Missing bearer token")
+			return
+		}
+
+		if subtle.ConstantTimeCompare([]byte(provided), []byte(token)) != 1 {
+			c.Header("WWW-Authenticate", "Bearer")
+			utils.Unauthorized(c, "Invalid bearer token")
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// BasicAuthMiddleware returns a middleware that requires HTTP basic auth
+// credentials matching username and password. When either is empty the
+// middleware allows every request, so protection is opt-in.
+func BasicAuthMiddleware(username, password, realm string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if username == "" || password == "" {
+			c.Next()
+			return
+		}
+
+		user, pass, ok := c.Request.BasicAuth()
+		if !ok {
+			c.Header("WWW-Authenticate", `Basic realm="`+realm+`"`)
+			utils.Unauthorized(c, "Missing credentials")
+			return
+		}
+
+		// Compare both fields so the response time doesn't reveal which one was wrong
+		userMatch := subtle.ConstantTimeCompare([]byte(user), []byte(username))
+		passMatch := subtle.ConstantTimeCompare([]byte(pass), []byte(password))
+		if userMatch&passMatch != 1 {
+			c.Header("WWW-Authenticate", `Basic realm="`+realm+`"`)
+			utils.Unauthorized(c, "Invalid credentials")
+			return
+		}
+
+		c.Next()
+	}
+}
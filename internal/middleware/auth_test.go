@@ -0,0 +1,176 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPIKeyMiddleware(t *testing.T) {
+	// Set Gin to test mode
+	gin.SetMode(gin.TestMode)
+
+	newRouter := func(apiKey string) *gin.Engine {
+		router := gin.New()
+		router.Use(APIKeyMiddleware(apiKey))
+		router.DELETE("/protected", func(c *gin.Context) {
+			c.Status(http.StatusNoContent)
+		})
+		return router
+	}
+
+	tests := []struct {
+		name       string
+		apiKey     string
+		header     string
+		wantStatus int
+	}{
+		{name: "valid key", apiKey: "secret", header: "secret", wantStatus: http.StatusNoContent},
+		{name: "missing key", apiKey: "secret", wantStatus: http.StatusUnauthorized},
+		{name: "invalid key", apiKey: "secret", header: "wrong", wantStatus: http.StatusUnauthorized},
+		{name: "no key configured", header: "secret", wantStatus: http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodDelete, "/protected", nil)
+			if tt.header != "" {
+				req.Header.Set(APIKeyHeader, tt.header)
+			}
+			w := httptest.NewRecorder()
+
+			newRouter(tt.apiKey).ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			assert.Equal(t, "no-store", w.Header().Get("Cache-Control"))
+		})
+	}
+
+	t.Run("overrides a public max-age", func(t *testing.T) {
+		router := gin.New()
+		router.Use(func(c *gin.Context) { c.Header("Cache-Control", "public, max-age=60") })
+		router.GET("/protected", APIKeyMiddleware("secret"), func(c *gin.Context) {
+			c.Status(http.StatusOK)
+		})
+
+		req := httptest.NewRequest(http.MethodGet, "/protected", nil)
+		req.Header.Set(APIKeyHeader, "secret")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "no-store", w.Header().Get("Cache-Control"))
+	})
+}
+
+func TestHasAPIKey(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	hasKey := func(apiKey, header string) bool {
+		c, _ := gin.CreateTestContext(httptest.NewRecorder())
+		c.Request = httptest.NewRequest(http.MethodGet, "/api/v1/profile", nil)
+		if header != "" {
+			c.Request.Header.Set(APIKeyHeader, header)
+		}
+		return HasAPIKey(c, apiKey)
+	}
+
+	assert.True(t, hasKey("secret", "secret"))
+	assert.False(t, hasKey("secret", "wrong"))
+	assert.False(t, hasKey("secret", ""))
+	// Without a configured key nobody is authenticated
+	assert.False(t, hasKey("", ""))
+}
+
+func TestBearerTokenMiddleware(t *testing.T) {
+	// Set Gin to test mode
+	gin.SetMode(gin.TestMode)
+
+	newRouter := func(token string) *gin.Engine {
+		router := gin.New()
+		router.Use(BearerTokenMiddleware(token))
+		router.GET("/protected", func(c *gin.Context) {
+			c.Status(http.StatusOK)
+		})
+		return router
+	}
+
+	tests := []struct {
+		name          string
+		token         string
+		authorization string
+		wantStatus    int
+	}{
+		{name: "valid token", token: "secret", authorization: "Bearer secret", wantStatus: http.StatusOK},
+		{name: "missing token", token: "secret", wantStatus: http.StatusUnauthorized},
+		{name: "invalid token", token: "secret", authorization: "Bearer wrong", wantStatus: http.StatusUnauthorized},
+		{name: "wrong scheme", token: "secret", authorization: "Basic secret", wantStatus: http.StatusUnauthorized},
+		{name: "no token configured", wantStatus: http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/protected", nil)
+			if tt.authorization != "" {
+				req.Header.Set("Authorization", tt.authorization)
+			}
+			w := httptest.NewRecorder()
+
+			newRouter(tt.token).ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			if tt.wantStatus == http.StatusUnauthorized {
+				assert.Equal(t, "Bearer", w.Header().Get("WWW-Authenticate"))
+			}
+		})
+	}
+}
+
+func TestBasicAuthMiddleware(t *testing.T) {
+	// Set Gin to test mode
+	gin.SetMode(gin.TestMode)
+
+	newRouter := func(username, password string) *gin.Engine {
+		router := gin.New()
+		router.Use(BasicAuthMiddleware(username, password, "docs"))
+		router.GET("/protected", func(c *gin.Context) {
+			c.Status(http.StatusOK)
+		})
+		return router
+	}
+
+	tests := []struct {
+		name       string
+		username   string
+		password   string
+		user       string
+		pass       string
+		wantStatus int
+	}{
+		{name: "valid credentials", username: "admin", password: "secret", user: "admin", pass: "secret", wantStatus: http.StatusOK},
+		{name: "missing credentials", username: "admin", password: "secret", wantStatus: http.StatusUnauthorized},
+		{name: "wrong password", username: "admin", password: "secret", user: "admin", pass: "wrong", wantStatus: http.StatusUnauthorized},
+		{name: "wrong username", username: "admin", password: "secret", user: "root", pass: "secret", wantStatus: http.StatusUnauthorized},
+		{name: "no credentials configured", wantStatus: http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/protected", nil)
+			if tt.user != "" {
+				req.SetBasicAuth(tt.user, tt.pass)
+			}
+			w := httptest.NewRecorder()
+
+			newRouter(tt.username, tt.password).ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			if tt.wantStatus == http.StatusUnauthorized {
+				assert.Equal(t, `Basic realm="docs"`, w.Header().Get("WWW-Authenticate"))
+			}
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// CacheControlMiddleware returns a middleware that lets clients and CDNs cache
+// reads. GET and HEAD responses under a configured path prefix get
+// "public, max-age=<n>" using the longest matching prefix, or "no-store" when
+// its age is zero. Responses to writes are always "no-store".
+func CacheControlMiddleware(maxAges map[string]time.Duration) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		switch c.Request.Method {
+		case http.MethodGet, http.MethodHead:
+			if maxAge, ok := longestPrefixMatch(maxAges, c.Request.URL.Path); ok {
+				if maxAge > 0 {
+					c.Header("Cache-Control", fmt.Sprintf("public, max-age=%d", int(maxAge.Seconds())))
+				} else {
+					c.Header("Cache-Control", "no-store")
+				}
+			}
+		case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+			c.Header("Cache-Control", "no-store")
+		}
+
+		c.Next()
+	}
+}
+
+// longestPrefixMatch returns the max-age of the longest prefix matching path
+// on a path segment boundary
+func longestPrefixMatch(maxAges map[string]time.Duration, path string) (time.Duration, bool) {
+	var (
+		best  time.Duration
+		bestN = -1
+	)
+	for prefix, maxAge := range maxAges {
+		trimmed := strings.TrimSuffix(prefix, "/")
+		if path != trimmed && !strings.HasPrefix(path, trimmed+"/") {
+			continue
+		}
+		if len(trimmed) > bestN {
+			best, bestN = maxAge, len(trimmed)
+		}
+	}
+	return best, bestN >= 0
+}
@@ -0,0 +1,60 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCacheControlMiddleware(t *testing.T) {
+	// Set Gin to test mode
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+	router.Use(CacheControlMiddleware(map[string]time.Duration{
+		"/api/v1":                 time.Minute,
+		"/api/v1/profile":         5 * time.Minute,
+		"/api/v1/profile/history": 0,
+	}))
+	handler := func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	}
+	router.GET("/health", handler)
+	router.GET("/api/v1/skills", handler)
+	router.GET("/api/v1/profile", handler)
+	router.GET("/api/v1/profile/history", handler)
+	router.GET("/api/v1/profiles", handler)
+	router.POST("/api/v1/batch", handler)
+	router.PUT("/api/v1/profile", handler)
+
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		want   string
+	}{
+		{name: "get", method: http.MethodGet, path: "/api/v1/skills", want: "public, max-age=60"},
+		{name: "longest prefix wins", method: http.MethodGet, path: "/api/v1/profile", want: "public, max-age=300"},
+		{name: "prefix matches whole segments", method: http.MethodGet, path: "/api/v1/profiles", want: "public, max-age=60"},
+		{name: "zero age", method: http.MethodGet, path: "/api/v1/profile/history", want: "no-store"},
+		{name: "unconfigured path", method: http.MethodGet, path: "/health", want: ""},
+		{name: "post", method: http.MethodPost, path: "/api/v1/batch", want: "no-store"},
+		{name: "put", method: http.MethodPut, path: "/api/v1/profile", want: "no-store"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusOK, w.Code)
+			assert.Equal(t, tt.want, w.Header().Get("Cache-Control"))
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package middleware
+
+import (
+	"net/http"
+	"strings"
+)
+
+// CanonicalHostHandler wraps next so requests for any host other than host,
+// such as www. or the bare IP, are redirected to the same path and query on
+// host. GET and HEAD requests get a 301; other methods get a 308 so clients
+// resend the body. The host is read from X-Forwarded-Host when a reverse proxy
+// sets it. Requests for skipPaths, such as health checks and metrics scrapes
+// that address the server directly, are always served. An empty host disables
+// the redirect.
+func CanonicalHostHandler(next http.Handler, host string, skipPaths ...string) http.Handler {
+	if host == "" {
+		return next
+	}
+
+	skip := make(map[string]struct{}, len(skipPaths))
+	for _, path := range skipPaths {
+		skip[path] = struct{}{}
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, ok := skip[r.URL.Path]; ok || strings.EqualFold(requestHost(r), host) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		status := http.StatusMovedPermanently
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			status = http.StatusPermanentRedirect
+		}
+		http.Redirect(w, r, requestScheme(r)+"://"+host+r.URL.RequestURI(), status)
+	})
+}
+
+// requestHost returns the host the client addressed, preferring the first
+// X-Forwarded-Host entry over the Host header
+func requestHost(r *http.Request) string {
+	if forwarded, _, _ := strings.Cut(r.Header.Get("X-Forwarded-Host"), ","); strings.TrimSpace(forwarded) != "" {
+		return strings.TrimSpace(forwarded)
+	}
+	return r.Host
+}
+
+// requestScheme returns the scheme the client used, preferring the first
+// X-Forwarded-Proto entry over the connection's own
+func requestScheme(r *http.Request) string {
+	if forwarded, _, _ := strings.Cut(r.Header.Get("X-Forwarded-Proto"), ","); strings.TrimSpace(forwarded) != "" {
+		return strings.TrimSpace(forwarded)
+	}
+	if r.TLS != nil {
+		return "https"
+	}
+	return "http"
+}
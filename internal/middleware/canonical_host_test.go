@@ -0,0 +1,76 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCanonicalHostHandler(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+	router.GET("/api/v1/skills", func(c *gin.Context) {
+		c.String(http.StatusOK, "skills")
+	})
+	router.GET("/health", func(c *gin.Context) {
+		c.String(http.StatusOK, "healthy")
+	})
+	handler := CanonicalHostHandler(router, "api.example.com", "/health", "/metrics")
+
+	serve := func(handler http.Handler, method, host, target string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, target, nil)
+		req.Host = host
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, req)
+		return w
+	}
+
+	t.Run("redirects a non-canonical host with 301", func(t *testing.T) {
+		w := serve(handler, http.MethodGet, "www.example.com", "/api/v1/skills?category=Backend&limit=5")
+
+		assert.Equal(t, http.StatusMovedPermanently, w.Code)
+		assert.Equal(t, "http://api.example.com/api/v1/skills?category=Backend&limit=5", w.Header().Get("Location"))
+	})
+
+	t.Run("redirects the bare IP and keeps the forwarded scheme", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/skills", nil)
+		req.Host = "203.0.113.10:8080"
+		req.Header.Set("X-Forwarded-Proto", "https")
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusMovedPermanently, w.Code)
+		assert.Equal(t, "https://api.example.com/api/v1/skills", w.Header().Get("Location"))
+	})
+
+	t.Run("redirects other methods with 308", func(t *testing.T) {
+		w := serve(handler, http.MethodPost, "www.example.com", "/api/v1/contact")
+
+		assert.Equal(t, http.StatusPermanentRedirect, w.Code)
+		assert.Equal(t, "http://api.example.com/api/v1/contact", w.Header().Get("Location"))
+	})
+
+	t.Run("serves the canonical host", func(t *testing.T) {
+		w := serve(handler, http.MethodGet, "API.example.com", "/api/v1/skills")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "skills", w.Body.String())
+	})
+
+	t.Run("serves skipped paths on any host", func(t *testing.T) {
+		w := serve(handler, http.MethodGet, "10.0.0.5:8080", "/health")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "healthy", w.Body.String())
+	})
+
+	t.Run("empty host disables the redirect", func(t *testing.T) {
+		w := serve(CanonicalHostHandler(router, ""), http.MethodGet, "www.example.com", "/api/v1/skills")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+}
@@ -0,0 +1,30 @@
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/npmulder/resume-api/internal/utils"
+)
+
+// concurrencyRetryAfter is the Retry-After value, in seconds, sent with
+// requests rejected by ConcurrencyLimitMiddleware
+const concurrencyRetryAfter = "1"
+
+// ConcurrencyLimitMiddleware returns a middleware that handles at most
+// maxInFlight requests at once across all clients, protecting the database
+// pool from bursts the per-client rate limiter lets through. Requests over
+// the limit are rejected with 503 and a Retry-After header rather than queued.
+func ConcurrencyLimitMiddleware(maxInFlight int) gin.HandlerFunc {
+	slots := make(chan struct{}, maxInFlight)
+
+	return func(c *gin.Context) {
+		select {
+		case slots <- struct{}{}:
+			defer func() { <-slots }()
+			c.Next()
+		default:
+			c.Header("Retry-After", concurrencyRetryAfter)
+			utils.ServiceUnavailable(c, "The server is handling too many requests, please retry later")
+		}
+	}
+}
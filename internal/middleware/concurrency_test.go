@@ -0,0 +1,70 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConcurrencyLimitMiddleware(t *testing.T) {
+	// Set Gin to test mode
+	gin.SetMode(gin.TestMode)
+
+	const limit, requests = 2, 6
+
+	// Slow handlers hold their slot until release is closed
+	var inFlight atomic.Int32
+	release := make(chan struct{})
+
+	router := gin.New()
+	router.Use(ConcurrencyLimitMiddleware(limit))
+	router.GET("/api/v1/profile", func(c *gin.Context) {
+		inFlight.Add(1)
+		<-release
+		c.JSON(http.StatusOK, gin.H{"status": "success"})
+	})
+
+	var wg sync.WaitGroup
+	responses := make([]*httptest.ResponseRecorder, requests)
+	for i := 0; i < requests; i++ {
+		responses[i] = httptest.NewRecorder()
+		wg.Add(1)
+		go func(w *httptest.ResponseRecorder) {
+			defer wg.Done()
+			router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/profile", nil))
+		}(responses[i])
+	}
+
+	// Wait until the limit is reached, then give the remaining requests time
+	// to be rejected before letting the slow ones finish
+	require.Eventually(t, func() bool { return inFlight.Load() == limit }, time.Second, time.Millisecond)
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	var succeeded, rejected int
+	for _, w := range responses {
+		switch w.Code {
+		case http.StatusOK:
+			succeeded++
+		case http.StatusServiceUnavailable:
+			rejected++
+			assert.Equal(t, "1", w.Header().Get("Retry-After"))
+		}
+	}
+	assert.Equal(t, limit, succeeded)
+	assert.Equal(t, requests-limit, rejected)
+	assert.Equal(t, int32(limit), inFlight.Load())
+
+	// Slots are released once requests complete
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/profile", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+}
@@ -0,0 +1,37 @@
+package middleware
+
+import (
+	"mime"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/npmulder/resume-api/internal/utils"
+)
+
+// RequireJSONMiddleware returns a middleware that rejects POST, PUT and PATCH
+// requests whose Content-Type is not application/json with 415 Unsupported
+// Media Type. Requests without a body may omit the Content-Type.
+func RequireJSONMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		switch c.Request.Method {
+		case http.MethodPost, http.MethodPut, http.MethodPatch:
+		default:
+			c.Next()
+			return
+		}
+
+		contentType := c.GetHeader("Content-Type")
+		if contentType == "" && c.Request.ContentLength == 0 {
+			c.Next()
+			return
+		}
+
+		mediaType, _, err := mime.ParseMediaType(contentType)
+		if err != nil || mediaType != gin.MIMEJSON {
+			utils.UnsupportedMediaType(c, "Content-Type must be application/json")
+			return
+		}
+
+		c.Next()
+	}
+}
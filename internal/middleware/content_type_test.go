@@ -0,0 +1,59 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/npmulder/resume-api/internal/models"
+)
+
+func TestRequireJSONMiddleware(t *testing.T) {
+	// Set Gin to test mode
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+	router.Use(RequireJSONMiddleware())
+	handler := func(c *gin.Context) {
+		c.Status(http.StatusNoContent)
+	}
+	router.POST("/write", handler)
+	router.PATCH("/write", handler)
+	router.DELETE("/write", handler)
+
+	tests := []struct {
+		name        string
+		method      string
+		contentType string
+		body        string
+		wantStatus  int
+	}{
+		{name: "json", method: http.MethodPost, contentType: "application/json", body: `{"name":"Go"}`, wantStatus: http.StatusNoContent},
+		{name: "json with charset", method: http.MethodPatch, contentType: "application/json; charset=utf-8", body: `{}`, wantStatus: http.StatusNoContent},
+		{name: "form", method: http.MethodPost, contentType: "application/x-www-form-urlencoded", body: "name=Go", wantStatus: http.StatusUnsupportedMediaType},
+		{name: "body without content type", method: http.MethodPost, body: `{"name":"Go"}`, wantStatus: http.StatusUnsupportedMediaType},
+		{name: "missing body", method: http.MethodPost, wantStatus: http.StatusNoContent},
+		{name: "other methods are not checked", method: http.MethodDelete, contentType: "text/plain", body: "x", wantStatus: http.StatusNoContent},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, "/write", strings.NewReader(tt.body))
+			if tt.contentType != "" {
+				req.Header.Set("Content-Type", tt.contentType)
+			}
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			if tt.wantStatus == http.StatusUnsupportedMediaType {
+				assert.Contains(t, w.Body.String(), models.ErrCodeUnsupportedMediaType)
+			}
+		})
+	}
+}
@@ -84,4 +84,13 @@ func RequestIDMiddleware() gin.HandlerFunc {
 
 		c.Next()
 	}
-}
\ No newline at end of file
+}
+
+// PrettyJSONMiddleware indents JSON responses by default; clients can still
+// ask for compact output with ?pretty=false
+func PrettyJSONMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Set(utils.PrettyJSONKey, true)
+		c.Next()
+	}
+}
@@ -1,25 +1,130 @@
 package middleware
 
 import (
+	"fmt"
+	"io"
 	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/gin-gonic/gin"
 )
 
-// LoggingMiddleware returns a new logging middleware.
-func LoggingMiddleware(logger *slog.Logger) gin.HandlerFunc {
+// redactedHeaderValue replaces the value of sensitive headers in logs
+const redactedHeaderValue = "***"
+
+// Access log formats accepted by the logging configuration
+const (
+	// AccessLogJSON logs each request as a structured entry through the logger
+	AccessLogJSON = "json"
+	// AccessLogCombined writes each request as an Apache Combined Log Format line
+	AccessLogCombined = "combined"
+)
+
+// combinedTimeLayout is the timestamp layout of Combined Log Format lines
+const combinedTimeLayout = "02/Jan/2006:15:04:05 -0700"
+
+// LoggingMiddleware returns a new logging middleware. Requests taking longer
+// than slowThreshold are logged at warn level; zero disables the slow request
+// log. Request headers are logged when the logger is enabled at debug level,
+// with the values of sensitiveHeaders replaced by "***". When accessLog is
+// set, every request is written to it as a Combined Log Format line instead
+// of the info-level request entry; slow requests are still logged at warn.
+func LoggingMiddleware(logger *slog.Logger, slowThreshold time.Duration, sensitiveHeaders []string, accessLog io.Writer) gin.HandlerFunc {
+	sensitive := make(map[string]bool, len(sensitiveHeaders))
+	for _, name := range sensitiveHeaders {
+		sensitive[http.CanonicalHeaderKey(name)] = true
+	}
+
 	return func(c *gin.Context) {
 		start := time.Now()
 
 		c.Next()
 
+		latency := time.Since(start)
+		if accessLog != nil {
+			writeCombinedLine(accessLog, c, start)
+		}
+		if slowThreshold > 0 && latency > slowThreshold {
+			logger.Warn("slow request",
+				"method", c.Request.Method,
+				"path", c.Request.URL.Path,
+				"status", c.Writer.Status(),
+				"duration", latency,
+				"threshold", slowThreshold,
+				"ip", c.ClientIP(),
+				headersAttr(logger, c, sensitive),
+			)
+			return
+		}
+		if accessLog != nil {
+			return
+		}
+
 		logger.Info("request",
 			"method", c.Request.Method,
 			"path", c.Request.URL.Path,
 			"status", c.Writer.Status(),
-			"latency", time.Since(start),
+			"latency", latency,
 			"ip", c.ClientIP(),
+			headersAttr(logger, c, sensitive),
 		)
 	}
 }
+
+// headersAttr returns the request headers as a log attribute with sensitive
+// values redacted, or an empty attribute, which slog drops, unless the logger
+// is enabled at debug level
+func headersAttr(logger *slog.Logger, c *gin.Context, sensitive map[string]bool) slog.Attr {
+	if !logger.Enabled(c.Request.Context(), slog.LevelDebug) {
+		return slog.Attr{}
+	}
+
+	headers := make(map[string]string, len(c.Request.Header))
+	for name, values := range c.Request.Header {
+		if sensitive[http.CanonicalHeaderKey(name)] {
+			headers[name] = redactedHeaderValue
+			continue
+		}
+		headers[name] = strings.Join(values, ", ")
+	}
+	return slog.Any("headers", headers)
+}
+
+// writeCombinedLine writes the request to w in Apache Combined Log Format:
+// client IP, identity, user, time, request line, status, response size,
+// referer and user agent, with "-" for unknown fields
+func writeCombinedLine(w io.Writer, c *gin.Context, start time.Time) {
+	size := "-"
+	if c.Writer.Size() > 0 {
+		size = strconv.Itoa(c.Writer.Size())
+	}
+
+	fmt.Fprintf(w, "%s - - [%s] \"%s %s %s\" %d %s \"%s\" \"%s\"\n",
+		c.ClientIP(),
+		start.Format(combinedTimeLayout),
+		c.Request.Method,
+		escapeLogField(c.Request.URL.RequestURI()),
+		c.Request.Proto,
+		c.Writer.Status(),
+		size,
+		escapeLogField(orDash(c.Request.Referer())),
+		escapeLogField(orDash(c.Request.UserAgent())),
+	)
+}
+
+// escapeLogField escapes backslashes and quotes so a field can't break out of
+// its quotes in an access log line
+func escapeLogField(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s)
+}
+
+// orDash returns s, or "-" when it is empty
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
@@ -0,0 +1,137 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoggingMiddleware(t *testing.T) {
+	// Set Gin to test mode
+	gin.SetMode(gin.TestMode)
+
+	// serve runs one request through the middleware and returns the log entry
+	serve := func(t *testing.T, slowThreshold, handlerDelay time.Duration) map[string]any {
+		var buf bytes.Buffer
+		logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+		router := gin.New()
+		router.Use(LoggingMiddleware(logger, slowThreshold, nil, nil))
+		router.GET("/api/v1/profile", func(c *gin.Context) {
+			time.Sleep(handlerDelay)
+			c.JSON(http.StatusOK, gin.H{"status": "success"})
+		})
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/profile", nil))
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var entry map[string]any
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &entry))
+		return entry
+	}
+
+	t.Run("logs normal requests at info", func(t *testing.T) {
+		entry := serve(t, time.Second, 0)
+
+		assert.Equal(t, "INFO", entry["level"])
+		assert.Equal(t, "request", entry["msg"])
+	})
+
+	t.Run("logs slow requests at warn", func(t *testing.T) {
+		entry := serve(t, 10*time.Millisecond, 50*time.Millisecond)
+
+		assert.Equal(t, "WARN", entry["level"])
+		assert.Equal(t, "slow request", entry["msg"])
+		assert.Equal(t, http.MethodGet, entry["method"])
+		assert.Equal(t, "/api/v1/profile", entry["path"])
+		assert.EqualValues(t, http.StatusOK, entry["status"])
+		assert.GreaterOrEqual(t, entry["duration"], float64(50*time.Millisecond))
+	})
+
+	t.Run("zero threshold disables the slow request log", func(t *testing.T) {
+		entry := serve(t, 0, 20*time.Millisecond)
+
+		assert.Equal(t, "INFO", entry["level"])
+	})
+
+	t.Run("redacts sensitive headers", func(t *testing.T) {
+		var buf bytes.Buffer
+		logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+		router := gin.New()
+		router.Use(LoggingMiddleware(logger, 0, []string{"authorization", APIKeyHeader}, nil))
+		router.GET("/api/v1/profile", func(c *gin.Context) {
+			c.Status(http.StatusOK)
+		})
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/profile", nil)
+		req.Header.Set("Authorization", "Bearer secret-token")
+		req.Header.Set(APIKeyHeader, "secret-key")
+		req.Header.Set("Accept", "application/json")
+		router.ServeHTTP(httptest.NewRecorder(), req)
+
+		assert.NotContains(t, buf.String(), "secret-token")
+		assert.NotContains(t, buf.String(), "secret-key")
+
+		var entry map[string]any
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &entry))
+		headers, ok := entry["headers"].(map[string]any)
+		require.True(t, ok, "expected headers in log entry")
+		assert.Equal(t, "***", headers["Authorization"])
+		assert.Equal(t, "***", headers[http.CanonicalHeaderKey(APIKeyHeader)])
+		assert.Equal(t, "application/json", headers["Accept"])
+	})
+
+	t.Run("omits headers above debug level", func(t *testing.T) {
+		var buf bytes.Buffer
+		logger := slog.New(slog.NewJSONHandler(&buf, nil))
+
+		router := gin.New()
+		router.Use(LoggingMiddleware(logger, 0, nil, nil))
+		router.GET("/api/v1/profile", func(c *gin.Context) {
+			c.Status(http.StatusOK)
+		})
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/profile", nil)
+		req.Header.Set("Authorization", "Bearer secret-token")
+		router.ServeHTTP(httptest.NewRecorder(), req)
+
+		var entry map[string]any
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &entry))
+		assert.NotContains(t, entry, "headers")
+	})
+
+	t.Run("writes combined log format lines", func(t *testing.T) {
+		var buf, accessLog bytes.Buffer
+		logger := slog.New(slog.NewJSONHandler(&buf, nil))
+
+		router := gin.New()
+		router.Use(LoggingMiddleware(logger, 0, nil, &accessLog))
+		router.GET("/api/v1/profile", func(c *gin.Context) {
+			c.String(http.StatusOK, "hello")
+		})
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/profile?fields=name", nil)
+		req.Header.Set("Referer", "https://example.com/")
+		req.Header.Set("User-Agent", `curl/8.0 "test"`)
+		router.ServeHTTP(httptest.NewRecorder(), req)
+
+		line := accessLog.String()
+		assert.Regexp(t, `^192\.0\.2\.1 - - \[\d{2}/\w{3}/\d{4}:\d{2}:\d{2}:\d{2} [+-]\d{4}\] `, line)
+		assert.Contains(t, line, `"GET /api/v1/profile?fields=name HTTP/1.1" 200 5 `)
+		assert.True(t, strings.HasSuffix(line, ` "https://example.com/" "curl/8.0 \"test\""`+"\n"), line)
+
+		// The combined line replaces the structured request entry
+		assert.Empty(t, buf.String())
+	})
+}
@@ -3,9 +3,12 @@ package middleware
 import (
 	"context"
 	"fmt"
+	"log/slog"
+	"net/http"
 	"runtime"
 	"strconv"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/gin-gonic/gin"
@@ -30,6 +33,9 @@ var (
 	dbOperationsTotal       metric.Int64Counter
 	dbOperationDuration     metric.Float64Histogram
 
+	// Rate limiting metrics
+	rateLimitRejectionsTotal metric.Int64Counter
+
 	// System metrics
 	memoryUsage             metric.Float64ObservableGauge
 	goroutinesCount         metric.Int64ObservableGauge
@@ -107,6 +113,16 @@ func initMetrics() error {
 		return fmt.Errorf("failed to create database_operation_duration_seconds histogram: %w", err)
 	}
 
+	// Create rate limiting metrics; the Prometheus exporter appends _total,
+	// so this is scraped as rate_limit_rejections_total
+	rateLimitRejectionsTotal, err = meter.Int64Counter(
+		"rate_limit_rejections",
+		metric.WithDescription("Total number of requests rejected by the rate limiter"),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create rate_limit_rejections_total counter: %w", err)
+	}
+
 	// Create system metrics
 	memoryUsage, err = meter.Float64ObservableGauge(
 		"memory_usage_bytes",
@@ -150,16 +166,42 @@ func initMetrics() error {
 	return nil
 }
 
-// MetricsMiddleware returns a middleware that collects HTTP metrics
-func MetricsMiddleware() gin.HandlerFunc {
+// initMetricsFunc initializes the metrics for MetricsMiddleware; tests replace
+// it to simulate initialization failures
+var initMetricsFunc = initMetrics
+
+var (
+	// Guards the metrics initialization done by the Track helpers
+	trackOnce     sync.Once
+	trackDisabled bool
+)
+
+// trackingEnabled initializes the metrics on the first call from a Track
+// helper and reports whether they are available. A failure is logged once
+// and disables the helpers rather than being retried on every call.
+func trackingEnabled() bool {
+	trackOnce.Do(func() {
+		if err := initMetricsFunc(); err != nil {
+			trackDisabled = true
+			slog.Warn("metrics tracking disabled", "error", err)
+		}
+	})
+	return !trackDisabled
+}
+
+// MetricsMiddleware returns a middleware that collects HTTP metrics for every
+// request except scrapes of metricsPath. It returns an error when the metrics
+// can't be initialized, leaving the caller to decide whether to continue
+// without them using NoopMetricsMiddleware.
+func MetricsMiddleware(metricsPath string) (gin.HandlerFunc, error) {
 	// Initialize metrics
-	if err := initMetrics(); err != nil {
-		panic(fmt.Sprintf("failed to initialize metrics: %v", err))
+	if err := initMetricsFunc(); err != nil {
+		return nil, fmt.Errorf("failed to initialize metrics: %w", err)
 	}
 
 	return func(c *gin.Context) {
 		// Skip metrics endpoint to avoid circular measurements
-		if c.Request.URL.Path == "/metrics" {
+		if c.Request.URL.Path == metricsPath {
 			c.Next()
 			return
 		}
@@ -172,9 +214,20 @@ func MetricsMiddleware() gin.HandlerFunc {
 		// Record start time
 		start := time.Now()
 
+		// Report the handler and database time in a Server-Timing header,
+		// added just before the response headers are sent
+		timing := &requestTiming{start: start}
+		c.Request = c.Request.WithContext(context.WithValue(ctx, requestTimingKey{}, timing))
+		c.Writer = &serverTimingWriter{ResponseWriter: c.Writer, timing: timing}
+
 		// Process request
 		c.Next()
 
+		// Responses without a body haven't sent their headers yet
+		if !c.Writer.Written() {
+			timing.setHeader(c.Writer.Header())
+		}
+
 		// Record metrics after request is processed
 		duration := time.Since(start).Seconds()
 		status := strconv.Itoa(c.Writer.Status())
@@ -192,15 +245,80 @@ func MetricsMiddleware() gin.HandlerFunc {
 
 		httpRequestsTotal.Add(ctx, 1, metric.WithAttributes(attrs...))
 		httpRequestDuration.Record(ctx, duration, metric.WithAttributes(attrs...))
+	}, nil
+}
+
+// NoopMetricsMiddleware returns a middleware that collects nothing, used in
+// place of MetricsMiddleware when metrics are unavailable
+func NoopMetricsMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Next()
+	}
+}
+
+// requestTimingKey is the context key of the current request's timing
+type requestTimingKey struct{}
+
+// requestTiming accumulates the time spent serving a single request
+type requestTiming struct {
+	start     time.Time
+	dbNanos   atomic.Int64
+	dbQueries atomic.Int64
+	once      sync.Once
+}
+
+// setHeader adds the Server-Timing header, e.g. "db;dur=12.3, total;dur=15.0".
+// The db entry is omitted when the request ran no queries.
+func (t *requestTiming) setHeader(header http.Header) {
+	t.once.Do(func() {
+		value := fmt.Sprintf("total;dur=%.1f", milliseconds(time.Since(t.start)))
+		if t.dbQueries.Load() > 0 {
+			value = fmt.Sprintf("db;dur=%.1f, %s", milliseconds(time.Duration(t.dbNanos.Load())), value)
+		}
+		header.Set("Server-Timing", value)
+	})
+}
+
+// milliseconds converts d to fractional milliseconds
+func milliseconds(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
+
+// serverTimingWriter adds the Server-Timing header before the first byte of
+// the body is written
+type serverTimingWriter struct {
+	gin.ResponseWriter
+	timing *requestTiming
+}
+
+func (w *serverTimingWriter) WriteHeaderNow() {
+	w.timing.setHeader(w.Header())
+	w.ResponseWriter.WriteHeaderNow()
+}
+
+func (w *serverTimingWriter) Write(data []byte) (int, error) {
+	w.timing.setHeader(w.Header())
+	return w.ResponseWriter.Write(data)
+}
+
+func (w *serverTimingWriter) WriteString(s string) (int, error) {
+	w.timing.setHeader(w.Header())
+	return w.ResponseWriter.WriteString(s)
+}
+
+// TrackDatabaseTime adds the duration of a query to the Server-Timing db entry
+// of the request ctx belongs to. It does nothing outside a request.
+func TrackDatabaseTime(ctx context.Context, duration time.Duration) {
+	if timing, ok := ctx.Value(requestTimingKey{}).(*requestTiming); ok {
+		timing.dbNanos.Add(int64(duration))
+		timing.dbQueries.Add(1)
 	}
 }
 
 // TrackDatabaseOperation is a utility function to track database operations
 func TrackDatabaseOperation(operation string, f func() error) error {
-	// Initialize metrics if not already initialized
-	if err := initMetrics(); err != nil {
-		// Log the error but don't fail the operation
-		fmt.Printf("failed to initialize metrics: %v\n", err)
+	// Run the operation untracked when metrics are unavailable
+	if !trackingEnabled() {
 		return f()
 	}
 
@@ -219,3 +337,13 @@ func TrackDatabaseOperation(operation string, f func() error) error {
 
 	return err
 }
+
+// TrackRateLimitRejection records a request rejected by the rate limiter,
+// labeled by the kind of key the client was identified by
+func TrackRateLimitRejection(ctx context.Context, keyType string) {
+	if !trackingEnabled() {
+		return
+	}
+
+	rateLimitRejectionsTotal.Add(ctx, 1, metric.WithAttributes(attribute.String("key_type", keyType)))
+}
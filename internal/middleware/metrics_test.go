@@ -0,0 +1,188 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// gatherCounter returns the current value of the named counter with the given
+// label from the default Prometheus registry, or 0 when it hasn't been recorded
+func gatherCounter(t *testing.T, name, label, value string) float64 {
+	t.Helper()
+
+	families, err := prometheus.DefaultGatherer.Gather()
+	require.NoError(t, err)
+
+	for _, family := range families {
+		if family.GetName() != name {
+			continue
+		}
+		for _, m := range family.GetMetric() {
+			for _, pair := range m.GetLabel() {
+				if pair.GetName() == label && pair.GetValue() == value {
+					return m.GetCounter().GetValue()
+				}
+			}
+		}
+	}
+	return 0
+}
+
+// serverTimingPattern matches a Server-Timing entry such as "db;dur=12.3"
+var serverTimingPattern = regexp.MustCompile(`^(\w+);dur=(\d+(?:\.\d+)?)$`)
+
+// parseServerTiming returns the durations of the Server-Timing entries by name
+func parseServerTiming(t *testing.T, header string) map[string]float64 {
+	t.Helper()
+
+	durations := map[string]float64{}
+	for _, entry := range regexp.MustCompile(`,\s*`).Split(header, -1) {
+		match := serverTimingPattern.FindStringSubmatch(entry)
+		require.NotNil(t, match, "malformed Server-Timing entry %q", entry)
+
+		duration, err := strconv.ParseFloat(match[2], 64)
+		require.NoError(t, err)
+		durations[match[1]] = duration
+	}
+	return durations
+}
+
+func TestMetricsMiddlewareServerTiming(t *testing.T) {
+	// Set Gin to test mode
+	gin.SetMode(gin.TestMode)
+
+	metrics, err := MetricsMiddleware("/metrics")
+	require.NoError(t, err)
+
+	router := gin.New()
+	router.Use(metrics)
+	router.GET("/api/v1/skills", func(c *gin.Context) {
+		TrackDatabaseTime(c.Request.Context(), 4*time.Millisecond)
+		TrackDatabaseTime(c.Request.Context(), 8*time.Millisecond)
+		c.JSON(http.StatusOK, []string{"Go"})
+	})
+	router.GET("/api/v1/profile", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"name": "John Doe"})
+	})
+	router.DELETE("/api/v1/projects", func(c *gin.Context) {
+		c.Status(http.StatusNoContent)
+	})
+
+	t.Run("reports database and total time", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/skills", nil))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		durations := parseServerTiming(t, w.Header().Get("Server-Timing"))
+		assert.InDelta(t, 12.0, durations["db"], 0.1)
+		assert.Contains(t, durations, "total")
+	})
+
+	t.Run("omits database time when no queries ran", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/profile", nil))
+
+		durations := parseServerTiming(t, w.Header().Get("Server-Timing"))
+		assert.NotContains(t, durations, "db")
+		assert.Contains(t, durations, "total")
+	})
+
+	t.Run("responses without a body", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodDelete, "/api/v1/projects", nil))
+
+		assert.Equal(t, http.StatusNoContent, w.Code)
+		assert.Contains(t, parseServerTiming(t, w.Header().Get("Server-Timing")), "total")
+	})
+}
+
+func TestMetricsMiddlewareInitFailure(t *testing.T) {
+	// Set Gin to test mode
+	gin.SetMode(gin.TestMode)
+
+	// Inject an initialization failure
+	initMetricsFunc = func() error { return errors.New("exporter unavailable") }
+	defer func() { initMetricsFunc = initMetrics }()
+
+	metrics, err := MetricsMiddleware("/metrics")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "exporter unavailable")
+	assert.Nil(t, metrics)
+
+	// The server keeps serving requests with metrics disabled
+	router := gin.New()
+	router.Use(NoopMetricsMiddleware())
+	router.GET("/api/v1/profile", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"name": "John Doe"})
+	})
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/profile", nil))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Empty(t, w.Header().Get("Server-Timing"))
+}
+
+func TestTrackHelpersInitFailure(t *testing.T) {
+	// Inject an initialization failure into a fresh guard
+	var calls int
+	initMetricsFunc = func() error {
+		calls++
+		return errors.New("exporter unavailable")
+	}
+	trackOnce, trackDisabled = sync.Once{}, false
+	defer func() {
+		initMetricsFunc = initMetrics
+		trackOnce, trackDisabled = sync.Once{}, false
+	}()
+
+	ctx := context.Background()
+	TrackRateLimitRejection(ctx, rateLimitKeyTypeIP)
+
+	// The operation still runs and its error is returned
+	ran := false
+	err := TrackDatabaseOperation("get_profile", func() error {
+		ran = true
+		return errors.New("connection refused")
+	})
+	assert.True(t, ran)
+	assert.EqualError(t, err, "connection refused")
+
+	// Initialization is attempted once, not on every call
+	assert.Equal(t, 1, calls)
+}
+
+func TestRateLimitRejectionsCounter(t *testing.T) {
+	require.NoError(t, initMetrics())
+	before := gatherCounter(t, "rate_limit_rejections_total", "key_type", rateLimitKeyTypeIP)
+
+	router := gin.New()
+	router.Use(RateLimiterMiddleware(RateLimiterConfig{RequestsPerSecond: 1, BurstSize: 2, TTL: time.Minute}))
+	router.GET("/test", func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+
+	// Exhaust the bucket, then get rejected twice
+	codes := make([]int, 4)
+	for i := range codes {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/test", nil))
+		codes[i] = w.Code
+	}
+	assert.Equal(t, []int{http.StatusOK, http.StatusOK, http.StatusTooManyRequests, http.StatusTooManyRequests}, codes)
+
+	after := gatherCounter(t, "rate_limit_rejections_total", "key_type", rateLimitKeyTypeIP)
+	assert.Equal(t, 2.0, after-before)
+}
@@ -0,0 +1,59 @@
+package middleware
+
+import (
+	"math"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/npmulder/resume-api/internal/utils"
+)
+
+// MinIntervalMiddleware returns a middleware that rejects a client's request
+// with 429 when it arrives less than interval after the same client IP's
+// previous accepted request, e.g. to stop scripted repeat form submissions.
+// Only requests answered with a 2xx status start the interval, so a client
+// can correct and resend a submission that failed validation.
+func MinIntervalMiddleware(interval time.Duration) gin.HandlerFunc {
+	var (
+		lastSeen  = make(map[string]time.Time)
+		lastPrune time.Time
+		mu        sync.Mutex
+	)
+
+	return func(c *gin.Context) {
+		ip := c.ClientIP()
+		now := time.Now()
+
+		mu.Lock()
+		// Forget clients whose interval has passed, at most once per interval
+		if now.Sub(lastPrune) >= interval {
+			for client, seen := range lastSeen {
+				if now.Sub(seen) >= interval {
+					delete(lastSeen, client)
+				}
+			}
+			lastPrune = now
+		}
+
+		if seen, found := lastSeen[ip]; found && now.Sub(seen) < interval {
+			wait := int(math.Ceil(seen.Add(interval).Sub(now).Seconds()))
+			mu.Unlock()
+			TrackRateLimitRejection(c.Request.Context(), rateLimitKeyTypeIP)
+			c.Header("Retry-After", strconv.Itoa(max(wait, 1)))
+			utils.TooManyRequests(c, "Please wait before submitting again")
+			return
+		}
+		mu.Unlock()
+
+		c.Next()
+
+		if status := c.Writer.Status(); status >= 200 && status < 300 {
+			mu.Lock()
+			lastSeen[ip] = now
+			mu.Unlock()
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMinIntervalMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	newRouter := func(interval time.Duration) *gin.Engine {
+		router := gin.New()
+		router.POST("/contact", MinIntervalMiddleware(interval), func(c *gin.Context) {
+			c.Status(http.StatusOK)
+		})
+		return router
+	}
+
+	submit := func(router *gin.Engine, ip string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/contact", nil)
+		req.RemoteAddr = ip + ":1234"
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	t.Run("rejects a repeat within the interval", func(t *testing.T) {
+		router := newRouter(time.Minute)
+
+		require.Equal(t, http.StatusOK, submit(router, "192.0.2.1").Code)
+
+		w := submit(router, "192.0.2.1")
+		assert.Equal(t, http.StatusTooManyRequests, w.Code)
+		retryAfter, err := strconv.Atoi(w.Header().Get("Retry-After"))
+		require.NoError(t, err)
+		assert.Greater(t, retryAfter, 55)
+		assert.LessOrEqual(t, retryAfter, 60)
+	})
+
+	t.Run("ignores rejected submissions", func(t *testing.T) {
+		router := gin.New()
+		status := http.StatusBadRequest
+		router.POST("/contact", MinIntervalMiddleware(time.Minute), func(c *gin.Context) {
+			c.Status(status)
+		})
+
+		require.Equal(t, http.StatusBadRequest, submit(router, "192.0.2.1").Code)
+
+		status = http.StatusOK
+		assert.Equal(t, http.StatusOK, submit(router, "192.0.2.1").Code)
+		assert.Equal(t, http.StatusTooManyRequests, submit(router, "192.0.2.1").Code)
+	})
+
+	t.Run("tracks clients separately", func(t *testing.T) {
+		router := newRouter(time.Minute)
+
+		assert.Equal(t, http.StatusOK, submit(router, "192.0.2.1").Code)
+		assert.Equal(t, http.StatusOK, submit(router, "192.0.2.2").Code)
+	})
+
+	t.Run("allows a repeat after the interval", func(t *testing.T) {
+		router := newRouter(20 * time.Millisecond)
+
+		require.Equal(t, http.StatusOK, submit(router, "192.0.2.1").Code)
+		time.Sleep(30 * time.Millisecond)
+		assert.Equal(t, http.StatusOK, submit(router, "192.0.2.1").Code)
+	})
+}
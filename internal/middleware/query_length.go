@@ -0,0 +1,22 @@
+package middleware
+
+import (
+	"fmt"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/npmulder/resume-api/internal/utils"
+)
+
+// QueryLengthLimitMiddleware returns a middleware that rejects requests whose
+// raw query string is longer than maxBytes with 414, before handlers spend
+// time binding hundreds of repeated filter parameters.
+func QueryLengthLimitMiddleware(maxBytes int) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if len(c.Request.URL.RawQuery) > maxBytes {
+			utils.URITooLong(c, fmt.Sprintf("Query string exceeds %d bytes", maxBytes))
+			return
+		}
+		c.Next()
+	}
+}
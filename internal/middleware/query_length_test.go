@@ -0,0 +1,40 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/npmulder/resume-api/internal/models"
+)
+
+func TestQueryLengthLimitMiddleware(t *testing.T) {
+	// Set Gin to test mode
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+	router.Use(QueryLengthLimitMiddleware(64))
+	router.GET("/api/v1/projects", func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+
+	t.Run("allows queries within the limit", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/projects?technology=Go", nil))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("rejects queries over the limit", func(t *testing.T) {
+		query := strings.Repeat("technology=Go&", 10)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/projects?"+query, nil))
+
+		assert.Equal(t, http.StatusRequestURITooLong, w.Code)
+		assert.Contains(t, w.Body.String(), models.ErrCodeURITooLong)
+	})
+}
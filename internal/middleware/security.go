@@ -1,7 +1,10 @@
 package middleware
 
 import (
+	"context"
+	"math"
 	"net/http"
+	"strconv"
 	"sync"
 	"time"
 
@@ -20,6 +23,21 @@ type RateLimiterConfig struct {
 	BurstSize int
 	// TTL defines how long to keep client entries in the limiter map
 	TTL time.Duration
+	// Period stretches the rate to RequestsPerSecond requests per Period, for
+	// limits slower than one request per second; zero means one second
+	Period time.Duration
+}
+
+// tokenInterval returns how often a client gains a token, or zero when it never does
+func (c RateLimiterConfig) tokenInterval() time.Duration {
+	if c.RequestsPerSecond <= 0 {
+		return 0
+	}
+	period := c.Period
+	if period <= 0 {
+		period = time.Second
+	}
+	return period / time.Duration(c.RequestsPerSecond)
 }
 
 // DefaultRateLimiterConfig returns a default configuration for the rate limiter
@@ -31,6 +49,9 @@ func DefaultRateLimiterConfig() RateLimiterConfig {
 	}
 }
 
+// rateLimitKeyTypeIP labels rate limit rejections of clients identified by IP
+const rateLimitKeyTypeIP = "ip"
+
 // client represents a client in the rate limiter
 type client struct {
 	tokens     int       // Current token count
@@ -38,78 +59,126 @@ type client struct {
 	lastSeen   time.Time // Last time client was seen
 }
 
-// RateLimiterMiddleware returns a middleware that limits the number of requests per client IP
-func RateLimiterMiddleware(config RateLimiterConfig) gin.HandlerFunc {
-	var (
-		clients = make(map[string]*client)
-		mu      sync.Mutex
-	)
+// RateLimiter is a token bucket per client key, e.g. a client IP, used by
+// RateLimiterMiddleware and usable by other transports such as gRPC
+type RateLimiter struct {
+	config   RateLimiterConfig
+	interval time.Duration
+	clients  map[string]*client
+	mu       sync.Mutex
+}
+
+// NewRateLimiter creates a rate limiter for config. Client entries unused for
+// config.TTL are cleaned up in the background.
+func NewRateLimiter(config RateLimiterConfig) *RateLimiter {
+	l := &RateLimiter{
+		config:   config,
+		interval: config.tokenInterval(),
+		clients:  make(map[string]*client),
+	}
 
 	// Start a goroutine to clean up old clients
 	go func() {
 		for {
 			time.Sleep(time.Minute)
 
-			mu.Lock()
-			for ip, client := range clients {
+			l.mu.Lock()
+			for ip, client := range l.clients {
 				if time.Since(client.lastSeen) > config.TTL {
-					delete(clients, ip)
+					delete(l.clients, ip)
 				}
 			}
-			mu.Unlock()
+			l.mu.Unlock()
 		}
 	}()
 
-	return func(c *gin.Context) {
-		ip := c.ClientIP()
-		now := time.Now()
+	return l
+}
 
-		mu.Lock()
+// Allow consumes a token for the client identified by ip. When none is left
+// it records the rejection and returns false with the whole seconds until the
+// next token.
+func (l *RateLimiter) Allow(ctx context.Context, ip string) (bool, int) {
+	now := time.Now()
 
-		// Create new client if not exists
-		if _, found := clients[ip]; !found {
-			clients[ip] = &client{
-				tokens:     config.BurstSize, // Start with full tokens
-				lastAccess: now,
-				lastSeen:   now,
-			}
+	l.mu.Lock()
+
+	// Create new client if not exists
+	if _, found := l.clients[ip]; !found {
+		l.clients[ip] = &client{
+			tokens:     l.config.BurstSize, // Start with full tokens
+			lastAccess: now,
+			lastSeen:   now,
 		}
+	}
 
-		// Update last seen time
-		clients[ip].lastSeen = now
+	// Update last seen time
+	l.clients[ip].lastSeen = now
 
-		// Calculate tokens to add based on time elapsed
-		elapsed := now.Sub(clients[ip].lastAccess).Seconds()
-		tokensToAdd := int(elapsed * float64(config.RequestsPerSecond))
+	// Calculate tokens to add based on time elapsed
+	tokensToAdd := 0
+	if l.interval > 0 {
+		tokensToAdd = int(now.Sub(l.clients[ip].lastAccess) / l.interval)
+	}
 
-		// Update tokens and last access time
-		if tokensToAdd > 0 {
-			// Use if statement instead of min function
-			newTokens := clients[ip].tokens + tokensToAdd
-			if newTokens > config.BurstSize {
-				newTokens = config.BurstSize
-			}
-			clients[ip].tokens = newTokens
-			clients[ip].lastAccess = now
+	// Update tokens and last access time
+	if tokensToAdd > 0 {
+		// Use if statement instead of min function
+		newTokens := l.clients[ip].tokens + tokensToAdd
+		if newTokens > l.config.BurstSize {
+			newTokens = l.config.BurstSize
 		}
+		l.clients[ip].tokens = newTokens
+		l.clients[ip].lastAccess = now
+	}
+
+	// Check if request can be allowed
+	if l.clients[ip].tokens <= 0 {
+		retryAfter := retryAfterSeconds(l.interval, l.clients[ip].lastAccess, now)
+		l.mu.Unlock()
+		TrackRateLimitRejection(ctx, rateLimitKeyTypeIP)
+		return false, retryAfter
+	}
+
+	// Consume a token
+	l.clients[ip].tokens--
+
+	l.mu.Unlock()
+	return true, 0
+}
+
+// RateLimiterMiddleware returns a middleware that limits the number of requests per client IP
+func RateLimiterMiddleware(config RateLimiterConfig) gin.HandlerFunc {
+	limiter := NewRateLimiter(config)
 
-		// Check if request can be allowed
-		if clients[ip].tokens <= 0 {
-			mu.Unlock()
+	return func(c *gin.Context) {
+		if ok, retryAfter := limiter.Allow(c.Request.Context(), c.ClientIP()); !ok {
+			c.Header("Retry-After", strconv.Itoa(retryAfter))
 			c.AbortWithStatusJSON(http.StatusTooManyRequests, gin.H{
 				"error": "Rate limit exceeded",
 			})
 			return
 		}
 
-		// Consume a token
-		clients[ip].tokens--
-
-		mu.Unlock()
 		c.Next()
 	}
 }
 
+// retryAfterSeconds returns the whole seconds until the bucket refilled at
+// lastAccess gains its next token, one every interval, rounded up and at
+// least one so clients never retry immediately.
+func retryAfterSeconds(interval time.Duration, lastAccess, now time.Time) int {
+	if interval <= 0 {
+		return 1
+	}
+	next := lastAccess.Add(interval)
+	seconds := int(math.Ceil(next.Sub(now).Seconds()))
+	if seconds < 1 {
+		return 1
+	}
+	return seconds
+}
+
 // SecurityHeadersMiddleware adds security-related headers to all responses
 func SecurityHeadersMiddleware() gin.HandlerFunc {
 	return func(c *gin.Context) {
@@ -0,0 +1,62 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimiterRetryAfter(t *testing.T) {
+	router := gin.New()
+	router.Use(RateLimiterMiddleware(RateLimiterConfig{RequestsPerSecond: 1, BurstSize: 2, TTL: time.Minute}))
+	router.GET("/test", func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+
+	// Exhaust the bucket; allowed requests carry no Retry-After
+	for i := 0; i < 2; i++ {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/test", nil))
+		require.Equal(t, http.StatusOK, w.Code)
+		assert.Empty(t, w.Header().Get("Retry-After"))
+	}
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/test", nil))
+	require.Equal(t, http.StatusTooManyRequests, w.Code)
+
+	// One token per second, so the next one is at most a second away
+	retryAfter, err := strconv.Atoi(w.Header().Get("Retry-After"))
+	require.NoError(t, err)
+	assert.Equal(t, 1, retryAfter)
+}
+
+func TestRateLimiterPeriod(t *testing.T) {
+	router := gin.New()
+	router.Use(RateLimiterMiddleware(RateLimiterConfig{RequestsPerSecond: 2, BurstSize: 2, TTL: time.Hour, Period: time.Hour}))
+	router.POST("/contact", func(c *gin.Context) {
+		c.Status(http.StatusAccepted)
+	})
+
+	for i := 0; i < 2; i++ {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/contact", nil))
+		require.Equal(t, http.StatusAccepted, w.Code)
+	}
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/contact", nil))
+	require.Equal(t, http.StatusTooManyRequests, w.Code)
+
+	// Two requests per hour, so the next token is up to half an hour away
+	retryAfter, err := strconv.Atoi(w.Header().Get("Retry-After"))
+	require.NoError(t, err)
+	assert.Greater(t, retryAfter, 1790)
+	assert.LessOrEqual(t, retryAfter, 1800)
+}
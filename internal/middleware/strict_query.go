@@ -0,0 +1,51 @@
+package middleware
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/npmulder/resume-api/internal/utils"
+)
+
+// QueryParamRegistry lists the query parameters each route accepts, keyed by
+// the method and route path, e.g. "GET /api/v1/projects"
+type QueryParamRegistry map[string][]string
+
+// StrictQueryMiddleware returns a middleware that rejects requests carrying
+// query parameters their route doesn't accept, so typos such as
+// ?featrued=true fail loudly instead of silently being ignored. Parameters in
+// global are accepted on every route. Routes missing from the registry are
+// not checked.
+func StrictQueryMiddleware(registry QueryParamRegistry, global ...string) gin.HandlerFunc {
+	allowed := make(map[string]map[string]struct{}, len(registry))
+	for route, params := range registry {
+		set := make(map[string]struct{}, len(params)+len(global))
+		for _, param := range append(params, global...) {
+			set[param] = struct{}{}
+		}
+		allowed[route] = set
+	}
+
+	return func(c *gin.Context) {
+		params, ok := allowed[c.Request.Method+" "+c.FullPath()]
+		if !ok {
+			c.Next()
+			return
+		}
+
+		var unknown []string
+		for key := range c.Request.URL.Query() {
+			if _, ok := params[key]; !ok {
+				unknown = append(unknown, key)
+			}
+		}
+		if len(unknown) > 0 {
+			sort.Strings(unknown)
+			utils.ValidationError(c, "Unknown query parameters: "+strings.Join(unknown, ", "), gin.H{"unknown": unknown})
+			return
+		}
+
+		c.Next()
+	}
+}
@@ -0,0 +1,60 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/npmulder/resume-api/internal/models"
+)
+
+func TestStrictQueryMiddleware(t *testing.T) {
+	// Set Gin to test mode
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+	router.Use(StrictQueryMiddleware(QueryParamRegistry{
+		"GET /api/v1/projects":    {"status", "featured"},
+		"DELETE /api/v1/projects": {"confirm"},
+	}, "version"))
+	handler := func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	}
+	router.GET("/api/v1/projects", handler)
+	router.DELETE("/api/v1/projects", handler)
+	router.GET("/health", handler)
+
+	tests := []struct {
+		name       string
+		method     string
+		target     string
+		wantStatus int
+		wantBody   string
+	}{
+		{name: "known params", method: http.MethodGet, target: "/api/v1/projects?status=active&featured=true", wantStatus: http.StatusOK},
+		{name: "global param", method: http.MethodGet, target: "/api/v1/projects?version=v1", wantStatus: http.StatusOK},
+		{name: "no params", method: http.MethodGet, target: "/api/v1/projects", wantStatus: http.StatusOK},
+		{name: "unknown param", method: http.MethodGet, target: "/api/v1/projects?featrued=true&status=active", wantStatus: http.StatusBadRequest, wantBody: "featrued"},
+		{name: "params are per method", method: http.MethodGet, target: "/api/v1/projects?confirm=true", wantStatus: http.StatusBadRequest, wantBody: "confirm"},
+		{name: "other method's params", method: http.MethodDelete, target: "/api/v1/projects?confirm=true", wantStatus: http.StatusOK},
+		{name: "unregistered route", method: http.MethodGet, target: "/health?verbose=1", wantStatus: http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.target, nil)
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			if tt.wantStatus == http.StatusBadRequest {
+				assert.Contains(t, w.Body.String(), models.ErrCodeValidationFailed)
+				assert.Contains(t, w.Body.String(), tt.wantBody)
+			}
+		})
+	}
+}
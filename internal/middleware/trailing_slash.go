@@ -0,0 +1,49 @@
+package middleware
+
+import (
+	"net/http"
+	"strings"
+)
+
+// Trailing slash modes accepted by TrailingSlashHandler
+const (
+	// TrailingSlashRewrite serves /path/ as if /path had been requested
+	TrailingSlashRewrite = "rewrite"
+	// TrailingSlashRedirect answers /path/ with a 308 redirect to /path
+	TrailingSlashRedirect = "redirect"
+	// TrailingSlashOff leaves paths as requested, as does an empty mode
+	TrailingSlashOff = "off"
+)
+
+// TrailingSlashHandler wraps next so requests under prefix with a trailing
+// slash reach the route registered without one. It runs before routing, as
+// Gin middleware only runs once a route has matched. Paths outside prefix are
+// left alone, so catch-all routes such as /swagger/*any keep their slash.
+func TrailingSlashHandler(next http.Handler, mode, prefix string) http.Handler {
+	if mode != TrailingSlashRewrite && mode != TrailingSlashRedirect {
+		return next
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path := r.URL.Path
+		if !strings.HasPrefix(path, prefix) || !strings.HasSuffix(path, "/") {
+			next.ServeHTTP(w, r)
+			return
+		}
+		path = strings.TrimRight(path, "/")
+
+		if mode == TrailingSlashRedirect {
+			location := *r.URL
+			location.Path = path
+			location.RawPath = ""
+			http.Redirect(w, r, location.RequestURI(), http.StatusPermanentRedirect)
+			return
+		}
+
+		r2 := r.Clone(r.Context())
+		r2.URL.Path = path
+		r2.URL.RawPath = ""
+		r2.RequestURI = r2.URL.RequestURI()
+		next.ServeHTTP(w, r2)
+	})
+}
@@ -0,0 +1,66 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTrailingSlashHandler(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	// newHandler builds a router with one API route and one catch-all route
+	newHandler := func(mode string) http.Handler {
+		router := gin.New()
+		router.RedirectTrailingSlash = false
+		router.GET("/api/v1/skills", func(c *gin.Context) {
+			c.String(http.StatusOK, "skills "+c.Query("category"))
+		})
+		router.GET("/swagger/*any", func(c *gin.Context) {
+			c.String(http.StatusOK, "swagger "+c.Param("any"))
+		})
+		return TrailingSlashHandler(router, mode, "/api/")
+	}
+
+	serve := func(handler http.Handler, method, target string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest(method, target, nil))
+		return w
+	}
+
+	t.Run("rewrite serves the route without the slash", func(t *testing.T) {
+		w := serve(newHandler(TrailingSlashRewrite), http.MethodGet, "/api/v1/skills/?category=Backend")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "skills Backend", w.Body.String())
+	})
+
+	t.Run("redirect answers with 308 to the canonical path", func(t *testing.T) {
+		w := serve(newHandler(TrailingSlashRedirect), http.MethodPost, "/api/v1/skills//?category=Backend")
+
+		assert.Equal(t, http.StatusPermanentRedirect, w.Code)
+		assert.Equal(t, "/api/v1/skills?category=Backend", w.Header().Get("Location"))
+	})
+
+	t.Run("paths without a trailing slash are untouched", func(t *testing.T) {
+		w := serve(newHandler(TrailingSlashRedirect), http.MethodGet, "/api/v1/skills")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("paths outside the prefix keep their slash", func(t *testing.T) {
+		w := serve(newHandler(TrailingSlashRewrite), http.MethodGet, "/swagger/")
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "swagger /", w.Body.String())
+	})
+
+	t.Run("off leaves the path as requested", func(t *testing.T) {
+		w := serve(newHandler(TrailingSlashOff), http.MethodGet, "/api/v1/skills/")
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
@@ -1,6 +1,8 @@
 package models
 
 import (
+	"errors"
+	"fmt"
 	"time"
 )
 
@@ -28,4 +30,42 @@ const (
 	AchievementCategoryInnovation  = "innovation"
 	AchievementCategoryEfficiency  = "efficiency"
 	AchievementCategoryTeamwork    = "teamwork"
-)
\ No newline at end of file
+)
+
+// ErrInvalidAchievementCategory is returned when an achievement category is not
+// one of ValidAchievementCategories
+var ErrInvalidAchievementCategory = errors.New("invalid achievement category")
+
+// ValidAchievementCategories returns valid achievement categories
+func ValidAchievementCategories() []string {
+	return []string{
+		AchievementCategoryPerformance,
+		AchievementCategorySecurity,
+		AchievementCategoryLeadership,
+		AchievementCategoryInnovation,
+		AchievementCategoryEfficiency,
+		AchievementCategoryTeamwork,
+	}
+}
+
+// ValidateAchievementCategory checks that category is a known achievement
+// category. The category is optional, so an empty value is valid.
+func ValidateAchievementCategory(category string) error {
+	if category == "" {
+		return nil
+	}
+	for _, valid := range ValidAchievementCategories() {
+		if category == valid {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: %q", ErrInvalidAchievementCategory, category)
+}
+
+// Validate checks the achievement's fields before it is stored
+func (a *Achievement) Validate() error {
+	if a.Category != nil {
+		return ValidateAchievementCategory(*a.Category)
+	}
+	return nil
+}
@@ -0,0 +1,31 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateAchievementCategory(t *testing.T) {
+	for _, category := range ValidAchievementCategories() {
+		assert.NoError(t, ValidateAchievementCategory(category), category)
+	}
+
+	assert.NoError(t, ValidateAchievementCategory(""), "category is optional")
+
+	err := ValidateAchievementCategory("customer")
+	assert.ErrorIs(t, err, ErrInvalidAchievementCategory)
+	assert.Contains(t, err.Error(), `"customer"`)
+
+	// Categories are matched exactly
+	assert.ErrorIs(t, ValidateAchievementCategory("Leadership"), ErrInvalidAchievementCategory)
+}
+
+func TestAchievementValidate(t *testing.T) {
+	known := AchievementCategorySecurity
+	unknown := "community"
+
+	assert.NoError(t, (&Achievement{Title: "No category"}).Validate())
+	assert.NoError(t, (&Achievement{Title: "Known", Category: &known}).Validate())
+	assert.ErrorIs(t, (&Achievement{Title: "Unknown", Category: &unknown}).Validate(), ErrInvalidAchievementCategory)
+}
@@ -0,0 +1,7 @@
+package models
+
+// Completeness scores how much of the resume is filled in
+type Completeness struct {
+	Score   int      `json:"score"`   // Percentage of items present, 0-100
+	Missing []string `json:"missing"` // Items still to fill in, e.g. "summary" or "skills"
+}
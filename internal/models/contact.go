@@ -0,0 +1,12 @@
+package models
+
+import "time"
+
+// ContactMessage is a submission of the public contact form
+type ContactMessage struct {
+	ID        int       `json:"id" db:"id"`
+	Name      string    `json:"name" db:"name"`
+	Email     string    `json:"email" db:"email"`
+	Message   string    `json:"message" db:"message"`
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+}
@@ -1,6 +1,7 @@
 package models
 
 import (
+	"encoding/json"
 	"time"
 )
 
@@ -30,6 +31,12 @@ type Education struct {
 	Grade        *string    `json:"grade,omitempty" db:"-"`
 }
 
+// InstitutionCount is an institution and the number of education entries from it
+type InstitutionCount struct {
+	Institution string `json:"institution"`
+	Count       int    `json:"count"`
+}
+
 // Education type constants
 const (
 	EducationTypeEducation     = "education"
@@ -55,4 +62,25 @@ func ValidEducationStatuses() []string {
 		EducationStatusInProgress,
 		EducationStatusPlanned,
 	}
-}
\ No newline at end of file
+}
+// DurationYears returns the number of years between YearStarted and YearCompleted,
+// or nil when either year is missing or the range is invalid
+func (e Education) DurationYears() *int {
+	if e.YearStarted == nil || e.YearCompleted == nil || *e.YearCompleted < *e.YearStarted {
+		return nil
+	}
+	years := *e.YearCompleted - *e.YearStarted
+	return &years
+}
+
+// MarshalJSON adds the computed duration_years field to the JSON representation
+func (e Education) MarshalJSON() ([]byte, error) {
+	type education Education // avoids recursing into MarshalJSON
+	return json.Marshal(struct {
+		education
+		DurationYears *int `json:"duration_years,omitempty"`
+	}{
+		education:     education(e),
+		DurationYears: e.DurationYears(),
+	})
+}
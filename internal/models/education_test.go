@@ -0,0 +1,54 @@
+package models
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func intPtr(i int) *int {
+	return &i
+}
+
+func TestEducationDurationYears(t *testing.T) {
+	tests := []struct {
+		name      string
+		education Education
+		expected  *int
+	}{
+		{
+			name:      "completed",
+			education: Education{YearStarted: intPtr(2015), YearCompleted: intPtr(2018), Status: EducationStatusCompleted},
+			expected:  intPtr(3),
+		},
+		{
+			name:      "in progress without completion year",
+			education: Education{YearStarted: intPtr(2023), Status: EducationStatusInProgress},
+		},
+		{
+			name:      "missing start year",
+			education: Education{YearCompleted: intPtr(2020), Status: EducationStatusCompleted},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.education.DurationYears())
+
+			data, err := json.Marshal(&tt.education)
+			require.NoError(t, err)
+
+			var fields map[string]interface{}
+			require.NoError(t, json.Unmarshal(data, &fields))
+			assert.Equal(t, tt.education.Status, fields["status"])
+
+			if tt.expected == nil {
+				assert.NotContains(t, fields, "duration_years")
+			} else {
+				assert.Equal(t, float64(*tt.expected), fields["duration_years"])
+			}
+		})
+	}
+}
@@ -8,14 +8,14 @@ import (
 
 // APIError represents a standardized API error response
 type APIError struct {
-	Status     int       `json:"status"`               // HTTP status code
-	Code       string    `json:"code"`                 // Application-specific error code
-	Message    string    `json:"message"`              // Human-readable error message
-	Details    any       `json:"details,omitempty"`    // Additional error details (optional)
-	RequestID  string    `json:"request_id,omitempty"` // Request ID for tracing (optional)
-	Timestamp  time.Time `json:"timestamp"`            // Time when the error occurred
-	Path       string    `json:"path,omitempty"`       // Request path that caused the error
-	Suggestion string    `json:"suggestion,omitempty"` // Suggested action to resolve the error (optional)
+	Status     int       `json:"status" yaml:"status"`                             // HTTP status code
+	Code       string    `json:"code" yaml:"code"`                                 // Application-specific error code
+	Message    string    `json:"message" yaml:"message"`                           // Human-readable error message
+	Details    any       `json:"details,omitempty" yaml:"details,omitempty"`       // Additional error details (optional)
+	RequestID  string    `json:"request_id,omitempty" yaml:"request_id,omitempty"` // Request ID for tracing (optional)
+	Timestamp  time.Time `json:"timestamp" yaml:"timestamp"`                       // Time when the error occurred
+	Path       string    `json:"path,omitempty" yaml:"path,omitempty"`             // Request path that caused the error
+	Suggestion string    `json:"suggestion,omitempty" yaml:"suggestion,omitempty"` // Suggested action to resolve the error (optional)
 }
 
 // Error codes
@@ -30,6 +30,12 @@ const (
 	ErrCodeTooManyRequests   = "TOO_MANY_REQUESTS"
 	ErrCodeMethodNotAllowed  = "METHOD_NOT_ALLOWED"
 	ErrCodeServiceUnavailable = "SERVICE_UNAVAILABLE"
+	ErrCodeUnsupportedMediaType = "UNSUPPORTED_MEDIA_TYPE"
+	ErrCodePreconditionFailed = "PRECONDITION_FAILED"
+	ErrCodePreconditionRequired = "PRECONDITION_REQUIRED"
+	ErrCodeConflict          = "CONFLICT"
+	ErrCodeURITooLong        = "URI_TOO_LONG"
+	ErrCodeBadGateway        = "BAD_GATEWAY"
 	
 	// Resource-specific errors
 	ErrCodeProfileNotFound   = "PROFILE_NOT_FOUND"
@@ -50,6 +56,12 @@ var statusToErrorCode = map[int]string{
 	http.StatusInternalServerError: ErrCodeInternalError,
 	http.StatusServiceUnavailable:  ErrCodeServiceUnavailable,
 	http.StatusTooManyRequests:     ErrCodeTooManyRequests,
+	http.StatusUnsupportedMediaType: ErrCodeUnsupportedMediaType,
+	http.StatusPreconditionFailed:   ErrCodePreconditionFailed,
+	http.StatusPreconditionRequired: ErrCodePreconditionRequired,
+	http.StatusConflict:             ErrCodeConflict,
+	http.StatusRequestURITooLong:    ErrCodeURITooLong,
+	http.StatusBadGateway:           ErrCodeBadGateway,
 }
 
 // GetErrorCodeForStatus returns the appropriate error code for a given HTTP status
@@ -23,4 +23,37 @@ type Experience struct {
 // IsCurrentPosition returns true if this is a current position (end_date is nil)
 func (e *Experience) IsCurrentPosition() bool {
 	return e.EndDate == nil
-}
\ No newline at end of file
+}
+// TenureSummary aggregates how long the user has worked, overall and per company
+type TenureSummary struct {
+	TotalMonths         int              `json:"total_months"` // Overlapping roles are counted once
+	AverageTenureMonths float64          `json:"average_tenure_months"`
+	Companies           []*CompanyTenure `json:"companies"`
+}
+
+// CompanyTenure is the number of months worked at a single company
+type CompanyTenure struct {
+	Company string `json:"company"`
+	Months  int    `json:"months"`
+}
+
+// YearsOfExperience returns the number of whole years between the earliest
+// experience start date and now, or zero when there are no experiences
+func YearsOfExperience(experiences []*Experience, now time.Time) int {
+	if len(experiences) == 0 {
+		return 0
+	}
+
+	earliest := experiences[0].StartDate
+	for _, e := range experiences[1:] {
+		if e.StartDate.Before(earliest) {
+			earliest = e.StartDate
+		}
+	}
+
+	years := now.Year() - earliest.Year()
+	if now.Month() < earliest.Month() || (now.Month() == earliest.Month() && now.Day() < earliest.Day()) {
+		years--
+	}
+	return max(years, 0)
+}
@@ -0,0 +1,56 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestYearsOfExperience(t *testing.T) {
+	now := time.Date(2024, 6, 15, 0, 0, 0, 0, time.UTC)
+	date := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name        string
+		experiences []*Experience
+		expected    int
+	}{
+		{
+			name:     "no experiences",
+			expected: 0,
+		},
+		{
+			name: "uses the earliest start date",
+			experiences: []*Experience{
+				{StartDate: date(2020, 1, 1)},
+				{StartDate: date(2016, 3, 1)},
+				{StartDate: date(2018, 9, 1)},
+			},
+			expected: 8,
+		},
+		{
+			name:        "anniversary not yet reached",
+			experiences: []*Experience{{StartDate: date(2016, 6, 16)}},
+			expected:    7,
+		},
+		{
+			name:        "on the anniversary",
+			experiences: []*Experience{{StartDate: date(2016, 6, 15)}},
+			expected:    8,
+		},
+		{
+			name:        "start date in the future",
+			experiences: []*Experience{{StartDate: date(2025, 1, 1)}},
+			expected:    0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, YearsOfExperience(tt.experiences, now))
+		})
+	}
+}
@@ -0,0 +1,19 @@
+package models
+
+import "time"
+
+// ResumeChanges holds the resume entries updated after Since, for syncing to
+// an external system. Sections without changes are empty and the profile is
+// null when it hasn't changed. Entries are never deleted, so there are no
+// deletions to report.
+type ResumeChanges struct {
+	Since time.Time `json:"since"`
+	Resume
+	Links *ExportLinks `json:"links,omitempty"`
+}
+
+// ExportLinks holds the absolute URLs returned with an export
+type ExportLinks struct {
+	// Next fetches the changes made after this export was taken
+	Next string `json:"next" example:"https://api.example.com/api/v1/export?since=2024-01-01T00%3A00%3A00Z"`
+}
@@ -0,0 +1,43 @@
+package models
+
+import "time"
+
+// HealthResponse is the response body of the health endpoint
+type HealthResponse struct {
+	Status       string             `json:"status" example:"ok"`     // ok, degraded or unhealthy
+	Version      string             `json:"version" example:"1.4.0"` // Build version of the running API
+	Uptime       int64              `json:"uptime" example:"86400"`  // Seconds since the process started
+	Dependencies HealthDependencies `json:"dependencies"`
+}
+
+// HealthDependencies reports the health of the services the API relies on
+type HealthDependencies struct {
+	Database *DatabaseHealth `json:"database,omitempty"`
+	Cache    *CacheHealth    `json:"cache,omitempty"`
+}
+
+// DatabaseHealth represents the health status of the database
+type DatabaseHealth struct {
+	Status       string          `json:"status" example:"healthy"`
+	Timestamp    time.Time       `json:"timestamp"`
+	ResponseTime time.Duration   `json:"response_time" example:"1200000"` // Nanoseconds
+	Version      string          `json:"version,omitempty"`
+	Connections  ConnectionStats `json:"connections"`
+	Error        string          `json:"error,omitempty"`
+}
+
+// ConnectionStats represents connection pool statistics
+type ConnectionStats struct {
+	Total     int `json:"total"`
+	Idle      int `json:"idle"`
+	Used      int `json:"used"`
+	Maximum   int `json:"maximum"`
+	Acquiring int `json:"acquiring"`
+}
+
+// CacheHealth represents the health status of the cache
+type CacheHealth struct {
+	Status       string        `json:"status" example:"healthy"`
+	ResponseTime time.Duration `json:"response_time" example:"350000"` // Nanoseconds
+	Error        string        `json:"error,omitempty"`
+}
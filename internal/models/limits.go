@@ -0,0 +1,40 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrTooManyItems is returned when a list field holds more items than its
+// configured limit
+var ErrTooManyItems = errors.New("too many items")
+
+// ListLimits caps the number of items in the list fields of resume entries.
+// A zero limit leaves the field unlimited.
+type ListLimits struct {
+	Highlights   int // experience highlights
+	Technologies int // project technologies
+	KeyFeatures  int // project key features
+}
+
+// ValidateLimits checks the experience's highlights against limits
+func (e *Experience) ValidateLimits(limits ListLimits) error {
+	return checkListLength("highlights", e.Highlights, limits.Highlights)
+}
+
+// ValidateLimits checks the project's technologies and key features against limits
+func (p *Project) ValidateLimits(limits ListLimits) error {
+	if err := checkListLength("technologies", p.Technologies, limits.Technologies); err != nil {
+		return err
+	}
+	return checkListLength("key_features", p.KeyFeatures, limits.KeyFeatures)
+}
+
+// checkListLength returns ErrTooManyItems when items holds more than max
+// entries and max is set
+func checkListLength(field string, items []string, max int) error {
+	if max > 0 && len(items) > max {
+		return fmt.Errorf("%w: %s has %d items, the maximum is %d", ErrTooManyItems, field, len(items), max)
+	}
+	return nil
+}
@@ -0,0 +1,37 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateLimits(t *testing.T) {
+	limits := ListLimits{Highlights: 2, Technologies: 3, KeyFeatures: 1}
+
+	t.Run("experience", func(t *testing.T) {
+		assert.NoError(t, (&Experience{Highlights: []string{"a", "b"}}).ValidateLimits(limits))
+
+		err := (&Experience{Highlights: []string{"a", "b", "c"}}).ValidateLimits(limits)
+		assert.ErrorIs(t, err, ErrTooManyItems)
+		assert.Contains(t, err.Error(), "highlights has 3 items, the maximum is 2")
+	})
+
+	t.Run("project", func(t *testing.T) {
+		assert.NoError(t, (&Project{Technologies: []string{"Go", "PostgreSQL", "Redis"}, KeyFeatures: []string{"Caching"}}).ValidateLimits(limits))
+
+		err := (&Project{Technologies: []string{"Go", "PostgreSQL", "Redis", "Docker"}}).ValidateLimits(limits)
+		assert.ErrorIs(t, err, ErrTooManyItems)
+		assert.Contains(t, err.Error(), "technologies")
+
+		err = (&Project{KeyFeatures: []string{"Caching", "Metrics"}}).ValidateLimits(limits)
+		assert.ErrorIs(t, err, ErrTooManyItems)
+		assert.Contains(t, err.Error(), "key_features")
+	})
+
+	t.Run("zero limits are unlimited", func(t *testing.T) {
+		highlights := make([]string, 1000)
+		assert.NoError(t, (&Experience{Highlights: highlights}).ValidateLimits(ListLimits{}))
+		assert.NoError(t, (&Project{Technologies: highlights, KeyFeatures: highlights}).ValidateLimits(ListLimits{}))
+	})
+}
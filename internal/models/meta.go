@@ -0,0 +1,15 @@
+package models
+
+import "time"
+
+// Meta holds when each resume section was last updated, so clients can tell
+// whether to refetch. Sections without entries have a null timestamp.
+type Meta struct {
+	ProfileUpdatedAt      *time.Time `json:"profile_updated_at"`
+	ExperiencesUpdatedAt  *time.Time `json:"experiences_updated_at"`
+	SkillsUpdatedAt       *time.Time `json:"skills_updated_at"`
+	AchievementsUpdatedAt *time.Time `json:"achievements_updated_at"`
+	EducationUpdatedAt    *time.Time `json:"education_updated_at"`
+	ProjectsUpdatedAt     *time.Time `json:"projects_updated_at"`
+	ETag                  string     `json:"etag"` // Changes whenever any timestamp does
+}
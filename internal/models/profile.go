@@ -1,7 +1,10 @@
 package models
 
 import (
+	"strings"
 	"time"
+	"unicode"
+	"unicode/utf8"
 )
 
 // Profile represents the user's personal information and summary
@@ -17,4 +20,76 @@ type Profile struct {
 	Summary   *string   `json:"summary,omitempty" db:"summary"`
 	CreatedAt time.Time `json:"created_at" db:"created_at"`
 	UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
-}
\ No newline at end of file
+}
+
+// ProfileVersion is a past version of the profile, recorded when it was replaced by an update
+type ProfileVersion struct {
+	ID         int       `json:"id" db:"id"`
+	ProfileID  int       `json:"profile_id" db:"profile_id"`
+	Name       string    `json:"name" db:"name"`
+	Title      string    `json:"title" db:"title"`
+	Email      string    `json:"email" db:"email"`
+	Phone      *string   `json:"phone,omitempty" db:"phone"`
+	Location   *string   `json:"location,omitempty" db:"location"`
+	LinkedIn   *string   `json:"linkedin,omitempty" db:"linkedin"`
+	GitHub     *string   `json:"github,omitempty" db:"github"`
+	Summary    *string   `json:"summary,omitempty" db:"summary"`
+	UpdatedAt  time.Time `json:"updated_at" db:"updated_at"`   // When this version was written
+	ReplacedAt time.Time `json:"replaced_at" db:"replaced_at"` // When this version was replaced
+}
+
+// Masked returns a copy of the profile with the email address and phone
+// number partly hidden, e.g. j***@example.com and +*-***-***-4567
+func (p *Profile) Masked() *Profile {
+	masked := *p
+	masked.Email = MaskEmail(p.Email)
+	if p.Phone != nil {
+		phone := MaskPhone(*p.Phone)
+		masked.Phone = &phone
+	}
+	return &masked
+}
+
+// MaskEmail keeps the first character of the local part and the domain of an
+// email address, e.g. j***@example.com. Values without a domain are hidden
+// entirely.
+func MaskEmail(email string) string {
+	at := strings.LastIndex(email, "@")
+	if at < 0 {
+		return "***"
+	}
+	first, _ := utf8.DecodeRuneInString(email[:at])
+	if first == utf8.RuneError {
+		return "***" + email[at:]
+	}
+	return string(first) + "***" + email[at:]
+}
+
+// MaskPhone replaces every digit of a phone number but the last four with *,
+// keeping its formatting, e.g. +*-***-***-4567. Numbers of four digits or
+// fewer are hidden entirely.
+func MaskPhone(phone string) string {
+	digits := 0
+	for _, r := range phone {
+		if unicode.IsDigit(r) {
+			digits++
+		}
+	}
+	keep := 4
+	if digits <= keep {
+		keep = 0
+	}
+
+	var b strings.Builder
+	seen := 0
+	for _, r := range phone {
+		if unicode.IsDigit(r) {
+			seen++
+			if seen <= digits-keep {
+				r = '*'
+			}
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
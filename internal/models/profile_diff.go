@@ -0,0 +1,87 @@
+package models
+
+import "time"
+
+// ProfileFieldChange is a profile field whose value differs between two
+// versions. Old is null for a value that was added and New for one that was
+// removed.
+type ProfileFieldChange struct {
+	Field string  `json:"field"`
+	Old   *string `json:"old"`
+	New   *string `json:"new"`
+}
+
+// ProfileDiff lists the profile fields that changed between two points in time
+type ProfileDiff struct {
+	From    time.Time            `json:"from"`
+	To      time.Time            `json:"to"`
+	Changes []ProfileFieldChange `json:"changes"`
+}
+
+// profileDiffFields lists the compared profile fields by JSON name, in the
+// order their changes are reported
+var profileDiffFields = []struct {
+	name  string
+	value func(*Profile) *string
+}{
+	{"name", func(p *Profile) *string { return copyString(&p.Name) }},
+	{"title", func(p *Profile) *string { return copyString(&p.Title) }},
+	{"email", func(p *Profile) *string { return copyString(&p.Email) }},
+	{"phone", func(p *Profile) *string { return copyString(p.Phone) }},
+	{"location", func(p *Profile) *string { return copyString(p.Location) }},
+	{"linkedin", func(p *Profile) *string { return copyString(p.LinkedIn) }},
+	{"github", func(p *Profile) *string { return copyString(p.GitHub) }},
+	{"summary", func(p *Profile) *string { return copyString(p.Summary) }},
+}
+
+// DiffProfiles returns the fields whose values differ between from and to.
+// A nil profile has no values, so every field set on the other side is
+// reported as added or removed. IDs and timestamps are not compared.
+func DiffProfiles(from, to *Profile) []ProfileFieldChange {
+	changes := []ProfileFieldChange{}
+	for _, field := range profileDiffFields {
+		var oldValue, newValue *string
+		if from != nil {
+			oldValue = field.value(from)
+		}
+		if to != nil {
+			newValue = field.value(to)
+		}
+		if equalStrings(oldValue, newValue) {
+			continue
+		}
+		changes = append(changes, ProfileFieldChange{Field: field.name, Old: oldValue, New: newValue})
+	}
+	return changes
+}
+
+// Profile returns the profile as it was in this version
+func (v *ProfileVersion) Profile() *Profile {
+	return &Profile{
+		ID:        v.ProfileID,
+		Name:      v.Name,
+		Title:     v.Title,
+		Email:     v.Email,
+		Phone:     v.Phone,
+		Location:  v.Location,
+		LinkedIn:  v.LinkedIn,
+		GitHub:    v.GitHub,
+		Summary:   v.Summary,
+		UpdatedAt: v.UpdatedAt,
+	}
+}
+
+func copyString(s *string) *string {
+	if s == nil {
+		return nil
+	}
+	c := *s
+	return &c
+}
+
+func equalStrings(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
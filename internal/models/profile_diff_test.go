@@ -0,0 +1,50 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffProfiles(t *testing.T) {
+	str := func(s string) *string { return &s }
+
+	t.Run("changed fields", func(t *testing.T) {
+		from := &Profile{ID: 1, Name: "John Doe", Title: "Software Engineer", Email: "john@example.com", Location: str("London")}
+		to := &Profile{ID: 1, Name: "John Doe", Title: "Senior Software Engineer", Email: "john@example.com", Location: str("Amsterdam")}
+
+		assert.Equal(t, []ProfileFieldChange{
+			{Field: "title", Old: str("Software Engineer"), New: str("Senior Software Engineer")},
+			{Field: "location", Old: str("London"), New: str("Amsterdam")},
+		}, DiffProfiles(from, to))
+	})
+
+	t.Run("added and removed fields", func(t *testing.T) {
+		from := &Profile{Name: "John Doe", Title: "Engineer", Email: "john@example.com", Phone: str("+1-555-0100")}
+		to := &Profile{Name: "John Doe", Title: "Engineer", Email: "john@example.com", GitHub: str("github.com/johndoe")}
+
+		assert.Equal(t, []ProfileFieldChange{
+			{Field: "phone", Old: str("+1-555-0100"), New: nil},
+			{Field: "github", Old: nil, New: str("github.com/johndoe")},
+		}, DiffProfiles(from, to))
+	})
+
+	t.Run("unchanged", func(t *testing.T) {
+		profile := &Profile{Name: "John Doe", Title: "Engineer", Email: "john@example.com", Summary: str("Builds APIs")}
+		same := *profile
+		same.Summary = str("Builds APIs")
+		same.ID = 2
+
+		assert.Empty(t, DiffProfiles(profile, &same))
+	})
+
+	t.Run("missing profile", func(t *testing.T) {
+		to := &Profile{Name: "John Doe", Title: "Engineer", Email: "john@example.com"}
+
+		assert.Equal(t, []ProfileFieldChange{
+			{Field: "name", New: str("John Doe")},
+			{Field: "title", New: str("Engineer")},
+			{Field: "email", New: str("john@example.com")},
+		}, DiffProfiles(nil, to))
+	})
+}
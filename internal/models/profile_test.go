@@ -0,0 +1,36 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMaskEmail(t *testing.T) {
+	assert.Equal(t, "j***@example.com", MaskEmail("john.doe@example.com"))
+	assert.Equal(t, "é***@example.com", MaskEmail("élodie@example.com"))
+	assert.Equal(t, "***@example.com", MaskEmail("@example.com"))
+	assert.Equal(t, "***", MaskEmail("not-an-email"))
+}
+
+func TestMaskPhone(t *testing.T) {
+	assert.Equal(t, "+*-***-***-4567", MaskPhone("+1-555-123-4567"))
+	assert.Equal(t, "(***) ***-4567", MaskPhone("(555) 123-4567"))
+	assert.Equal(t, "***", MaskPhone("123"))
+}
+
+func TestProfileMasked(t *testing.T) {
+	phone := "+1-555-123-4567"
+	profile := &Profile{ID: 1, Name: "John Doe", Email: "john@example.com", Phone: &phone}
+
+	masked := profile.Masked()
+	assert.Equal(t, "j***@example.com", masked.Email)
+	assert.Equal(t, "+*-***-***-4567", *masked.Phone)
+	assert.Equal(t, "John Doe", masked.Name)
+
+	// The original, possibly cached, profile is left untouched
+	assert.Equal(t, "john@example.com", profile.Email)
+	assert.Equal(t, "+1-555-123-4567", *profile.Phone)
+
+	assert.Nil(t, (&Profile{Email: "john@example.com"}).Masked().Phone)
+}
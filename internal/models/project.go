@@ -1,6 +1,7 @@
 package models
 
 import (
+	"strings"
 	"time"
 )
 
@@ -8,9 +9,10 @@ import (
 type Project struct {
 	ID               int       `json:"id" db:"id"`
 	Name             string    `json:"name" db:"name"`
+	Slug             string    `json:"slug" db:"slug"` // Unique, derived from Name when empty
 	Description      *string   `json:"description,omitempty" db:"description"`
 	ShortDescription *string   `json:"short_description,omitempty" db:"short_description"`
-	Technologies     []string  `json:"technologies,omitempty" db:"technologies"` // JSONB in DB
+	Technologies     []string  `json:"technologies" db:"technologies"` // JSONB in DB
 	GitHubURL        *string   `json:"github_url,omitempty" db:"github_url"`
 	DemoURL          *string   `json:"demo_url,omitempty" db:"demo_url"`
 	StartDate        *time.Time `json:"start_date,omitempty" db:"start_date"`
@@ -18,12 +20,19 @@ type Project struct {
 	Status           string    `json:"status" db:"status"` // active, completed, archived, planned
 	IsFeatured       bool      `json:"is_featured" db:"is_featured"`
 	OrderIndex       int       `json:"order_index" db:"order_index"`
-	KeyFeatures      []string  `json:"key_features,omitempty" db:"key_features"` // TEXT[] in DB
+	KeyFeatures      []string  `json:"key_features" db:"key_features"` // TEXT[] in DB
 	Highlights       []string  `json:"highlights,omitempty" db:"-"` // For interface compatibility
 	CreatedAt        time.Time `json:"created_at" db:"created_at"`
 	UpdatedAt        time.Time `json:"updated_at" db:"updated_at"`
 }
 
+// SimilarProject is a project with the number of technologies it shares with
+// another project
+type SimilarProject struct {
+	Project
+	SharedTechnologies int `json:"shared_technologies"`
+}
+
 // Project status constants
 const (
 	ProjectStatusActive    = "active"
@@ -45,4 +54,30 @@ func ValidProjectStatuses() []string {
 // IsOngoing returns true if the project is currently active (end_date is nil and status is active)
 func (p *Project) IsOngoing() bool {
 	return p.EndDate == nil && p.Status == ProjectStatusActive
-}
\ No newline at end of file
+}
+
+// Slugify converts a name into a lowercase, hyphen-separated slug
+// (e.g. "Cloud-Native Resume API" becomes "cloud-native-resume-api")
+func Slugify(name string) string {
+	var b strings.Builder
+	pendingHyphen := false
+	for _, r := range strings.ToLower(name) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			if pendingHyphen && b.Len() > 0 {
+				b.WriteByte('-')
+			}
+			b.WriteRune(r)
+			pendingHyphen = false
+			continue
+		}
+		pendingHyphen = true
+	}
+	return b.String()
+}
+
+// EnsureSlug derives the project's slug from its name if it hasn't been set
+func (p *Project) EnsureSlug() {
+	if p.Slug == "" {
+		p.Slug = Slugify(p.Name)
+	}
+}
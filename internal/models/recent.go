@@ -0,0 +1,23 @@
+package models
+
+import (
+	"time"
+)
+
+// RecentItem is a summary of a recently updated resume entry from any section
+type RecentItem struct {
+	Type      string    `json:"type"` // profile, experience, skill, achievement, education, project
+	ID        int       `json:"id"`
+	Title     string    `json:"title"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Recent item type constants
+const (
+	RecentItemTypeProfile     = "profile"
+	RecentItemTypeExperience  = "experience"
+	RecentItemTypeSkill       = "skill"
+	RecentItemTypeAchievement = "achievement"
+	RecentItemTypeEducation   = "education"
+	RecentItemTypeProject     = "project"
+)
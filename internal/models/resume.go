@@ -0,0 +1,44 @@
+package models
+
+import "time"
+
+// Resume bundles the profile with every resume section
+type Resume struct {
+	Profile      *Profile       `json:"profile"`
+	Experiences  []*Experience  `json:"experiences"`
+	Skills       []*Skill       `json:"skills"`
+	Achievements []*Achievement `json:"achievements"`
+	Education    []*Education   `json:"education"`
+	Projects     []*Project     `json:"projects"`
+}
+
+// LastModified returns the latest updated_at across the profile and every
+// section, or the zero time when the resume is empty
+func (r *Resume) LastModified() time.Time {
+	var latest time.Time
+	track := func(t time.Time) {
+		if t.After(latest) {
+			latest = t
+		}
+	}
+
+	if r.Profile != nil {
+		track(r.Profile.UpdatedAt)
+	}
+	for _, e := range r.Experiences {
+		track(e.UpdatedAt)
+	}
+	for _, s := range r.Skills {
+		track(s.UpdatedAt)
+	}
+	for _, a := range r.Achievements {
+		track(a.UpdatedAt)
+	}
+	for _, e := range r.Education {
+		track(e.UpdatedAt)
+	}
+	for _, p := range r.Projects {
+		track(p.UpdatedAt)
+	}
+	return latest
+}
@@ -0,0 +1,24 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResumeLastModified(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	resume := &Resume{
+		Profile:      &Profile{UpdatedAt: base},
+		Experiences:  []*Experience{{UpdatedAt: base.AddDate(0, 1, 0)}},
+		Skills:       []*Skill{{UpdatedAt: base.AddDate(0, 3, 0)}},
+		Achievements: []*Achievement{{UpdatedAt: base.AddDate(0, 2, 0)}},
+		Education:    []*Education{{UpdatedAt: base}},
+		Projects:     []*Project{{UpdatedAt: base.AddDate(0, 0, 5)}},
+	}
+
+	assert.Equal(t, base.AddDate(0, 3, 0), resume.LastModified())
+	assert.True(t, (&Resume{}).LastModified().IsZero())
+}
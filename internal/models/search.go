@@ -0,0 +1,15 @@
+package models
+
+// SearchResult is a resume entry from any section that matched a search term
+type SearchResult struct {
+	Type  string `json:"type"` // experience, skill, achievement, education, project
+	ID    int    `json:"id"`
+	Title string `json:"title"`
+}
+
+// SearchResults is a capped list of search matches
+type SearchResults struct {
+	Results   []*SearchResult `json:"results"`
+	Total     int             `json:"total"`     // Number of matches before the cap was applied
+	Truncated bool            `json:"truncated"` // True when Total exceeds the number of results returned
+}
@@ -34,4 +34,34 @@ func ValidSkillLevels() []string {
 		SkillLevelAdvanced,
 		SkillLevelExpert,
 	}
-}
\ No newline at end of file
+}
+
+// SkillLevelHistogram counts skills per proficiency level
+type SkillLevelHistogram struct {
+	Beginner     int `json:"beginner"`
+	Intermediate int `json:"intermediate"`
+	Advanced     int `json:"advanced"`
+	Expert       int `json:"expert"`
+	Unspecified  int `json:"unspecified"` // Skills without a level
+}
+
+// Add adds count skills at level to the histogram. Unknown or empty levels
+// are counted as unspecified.
+func (h *SkillLevelHistogram) Add(level *string, count int) {
+	if level == nil {
+		h.Unspecified += count
+		return
+	}
+	switch *level {
+	case SkillLevelBeginner:
+		h.Beginner += count
+	case SkillLevelIntermediate:
+		h.Intermediate += count
+	case SkillLevelAdvanced:
+		h.Advanced += count
+	case SkillLevelExpert:
+		h.Expert += count
+	default:
+		h.Unspecified += count
+	}
+}
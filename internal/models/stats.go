@@ -0,0 +1,9 @@
+package models
+
+// Stats holds headline figures about the resume
+type Stats struct {
+	YearsExperience int `json:"years_experience"` // Whole years since the earliest experience started
+	Skills          int `json:"skills"`
+	Projects        int `json:"projects"`
+	Certifications  int `json:"certifications"`
+}
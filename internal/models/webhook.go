@@ -0,0 +1,16 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// WebhookFailure is a change event whose webhook delivery failed after every retry
+type WebhookFailure struct {
+	ID       int             `json:"id" db:"id"`
+	URL      string          `json:"url" db:"url"`
+	Payload  json.RawMessage `json:"payload" db:"payload"` // The encoded event
+	Error    string          `json:"error" db:"error"`     // Error of the last attempt
+	Attempts int             `json:"attempts" db:"attempts"`
+	FailedAt time.Time       `json:"failed_at" db:"failed_at"`
+}
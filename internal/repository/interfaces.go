@@ -5,6 +5,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/npmulder/resume-api/internal/models"
 )
@@ -12,16 +13,34 @@ import (
 // ErrNotFound is a standard error for when a resource is not found.
 var ErrNotFound = errors.New("not found")
 
+// ErrConflict is returned when a write would violate a uniqueness constraint.
+var ErrConflict = errors.New("conflict")
+
+// ErrPreconditionFailed is returned when a conditional write finds the stored
+// entry changed since the version it was based on.
+var ErrPreconditionFailed = errors.New("precondition failed")
+
 // ProfileRepository defines operations for profile data
 type ProfileRepository interface {
 	// GetProfile retrieves the user's profile information
 	GetProfile(ctx context.Context) (*models.Profile, error)
 	
-	// UpdateProfile updates the user's profile information
+	// UpdateProfile updates the user's profile information, recording the
+	// replaced version in the profile history. It only applies while the
+	// stored profile's updated_at equals profile.UpdatedAt and returns
+	// ErrPreconditionFailed otherwise, so concurrent edits can't overwrite
+	// each other. A zero profile.UpdatedAt applies to any version. On
+	// success profile.UpdatedAt holds the new version.
 	UpdateProfile(ctx context.Context, profile *models.Profile) error
 	
+	// GetProfileHistory retrieves past profile versions, newest first
+	GetProfileHistory(ctx context.Context) ([]*models.ProfileVersion, error)
+	
 	// CreateProfile creates a new profile (typically only used once)
 	CreateProfile(ctx context.Context, profile *models.Profile) error
+	
+	// GetMaxUpdatedAt returns when the profile was last updated, or nil when there is no profile
+	GetMaxUpdatedAt(ctx context.Context) (*time.Time, error)
 }
 
 // ExperienceRepository defines operations for work experience data
@@ -40,6 +59,9 @@ type ExperienceRepository interface {
 	
 	// DeleteExperience deletes an experience by ID
 	DeleteExperience(ctx context.Context, id int) error
+	
+	// GetMaxUpdatedAt returns when any experience was last updated, or nil when there are none
+	GetMaxUpdatedAt(ctx context.Context) (*time.Time, error)
 }
 
 // SkillRepository defines operations for skills data
@@ -50,8 +72,14 @@ type SkillRepository interface {
 	// GetSkillsByCategory retrieves skills grouped by category
 	GetSkillsByCategory(ctx context.Context, category string) ([]*models.Skill, error)
 	
-	// GetFeaturedSkills retrieves only featured skills
-	GetFeaturedSkills(ctx context.Context) ([]*models.Skill, error)
+	// GetSkillByName retrieves the skill with the given name, ignoring case
+	GetSkillByName(ctx context.Context, name string) (*models.Skill, error)
+	
+	// GetFeaturedSkills retrieves only featured skills, applying the remaining filters
+	GetFeaturedSkills(ctx context.Context, filters SkillFilters) ([]*models.Skill, error)
+	
+	// GetSkillLevelHistogram counts skills per proficiency level
+	GetSkillLevelHistogram(ctx context.Context) (*models.SkillLevelHistogram, error)
 	
 	// CreateSkill creates a new skill entry
 	CreateSkill(ctx context.Context, skill *models.Skill) error
@@ -61,6 +89,13 @@ type SkillRepository interface {
 	
 	// DeleteSkill deletes a skill by ID
 	DeleteSkill(ctx context.Context, id int) error
+	
+	// RenameSkillCategory moves every skill in category from to category to
+	// and returns the number of skills changed
+	RenameSkillCategory(ctx context.Context, from, to string) (int64, error)
+	
+	// GetMaxUpdatedAt returns when any skill was last updated, or nil when there are none
+	GetMaxUpdatedAt(ctx context.Context) (*time.Time, error)
 }
 
 // AchievementRepository defines operations for achievements data
@@ -68,8 +103,8 @@ type AchievementRepository interface {
 	// GetAchievements retrieves all achievements with optional filtering
 	GetAchievements(ctx context.Context, filters AchievementFilters) ([]*models.Achievement, error)
 	
-	// GetFeaturedAchievements retrieves only featured achievements
-	GetFeaturedAchievements(ctx context.Context) ([]*models.Achievement, error)
+	// GetFeaturedAchievements retrieves only featured achievements, applying the remaining filters
+	GetFeaturedAchievements(ctx context.Context, filters AchievementFilters) ([]*models.Achievement, error)
 	
 	// CreateAchievement creates a new achievement entry
 	CreateAchievement(ctx context.Context, achievement *models.Achievement) error
@@ -79,6 +114,9 @@ type AchievementRepository interface {
 	
 	// DeleteAchievement deletes an achievement by ID
 	DeleteAchievement(ctx context.Context, id int) error
+	
+	// GetMaxUpdatedAt returns when any achievement was last updated, or nil when there are none
+	GetMaxUpdatedAt(ctx context.Context) (*time.Time, error)
 }
 
 // EducationRepository defines operations for education and certification data
@@ -89,8 +127,14 @@ type EducationRepository interface {
 	// GetEducationByType retrieves education entries by type (education, certification)
 	GetEducationByType(ctx context.Context, eduType string) ([]*models.Education, error)
 	
-	// GetFeaturedEducation retrieves only featured education entries
-	GetFeaturedEducation(ctx context.Context) ([]*models.Education, error)
+	// GetFeaturedEducation retrieves only featured education entries, applying the remaining filters
+	GetFeaturedEducation(ctx context.Context, filters EducationFilters) ([]*models.Education, error)
+	
+	// GetInstitutions lists the distinct institutions with their number of entries
+	GetInstitutions(ctx context.Context) ([]*models.InstitutionCount, error)
+	
+	// GetEducationByCredentialID retrieves the certification with the given credential ID
+	GetEducationByCredentialID(ctx context.Context, credentialID string) (*models.Education, error)
 	
 	// CreateEducation creates a new education entry
 	CreateEducation(ctx context.Context, education *models.Education) error
@@ -100,6 +144,9 @@ type EducationRepository interface {
 	
 	// DeleteEducation deletes an education entry by ID
 	DeleteEducation(ctx context.Context, id int) error
+	
+	// GetMaxUpdatedAt returns when any education entry was last updated, or nil when there are none
+	GetMaxUpdatedAt(ctx context.Context) (*time.Time, error)
 }
 
 // ProjectRepository defines operations for project data
@@ -110,8 +157,12 @@ type ProjectRepository interface {
 	// GetProjectByID retrieves a specific project by ID
 	GetProjectByID(ctx context.Context, id int) (*models.Project, error)
 	
-	// GetFeaturedProjects retrieves only featured projects
-	GetFeaturedProjects(ctx context.Context) ([]*models.Project, error)
+	// GetFeaturedProjects retrieves only featured projects, applying the remaining filters
+	GetFeaturedProjects(ctx context.Context, filters ProjectFilters) ([]*models.Project, error)
+	
+	// GetSimilarProjects retrieves up to limit other projects sharing technologies
+	// with the given project, most shared technologies first
+	GetSimilarProjects(ctx context.Context, id int, limit int) ([]*models.SimilarProject, error)
 	
 	// CreateProject creates a new project entry
 	CreateProject(ctx context.Context, project *models.Project) error
@@ -119,58 +170,147 @@ type ProjectRepository interface {
 	// UpdateProject updates an existing project
 	UpdateProject(ctx context.Context, project *models.Project) error
 	
+	// UpsertProject creates a project or updates the one with the same slug,
+	// reporting whether a new project was inserted
+	UpsertProject(ctx context.Context, project *models.Project) (bool, error)
+	
 	// DeleteProject deletes a project by ID
 	DeleteProject(ctx context.Context, id int) error
+	
+	// DeleteAllProjects deletes every project and returns the number of rows deleted
+	DeleteAllProjects(ctx context.Context) (int64, error)
+	
+	// GetMaxUpdatedAt returns when any project was last updated, or nil when there are none
+	GetMaxUpdatedAt(ctx context.Context) (*time.Time, error)
+}
+
+// SearchRepository defines full-resume text search
+type SearchRepository interface {
+	// Search finds entries in every section containing term, most recently
+	// updated first. The number of results is capped by the implementation.
+	Search(ctx context.Context, term string) (*models.SearchResults, error)
+}
+
+// WebhookFailureRepository stores webhook events whose delivery failed after every retry
+type WebhookFailureRepository interface {
+	// RecordFailure stores a failed delivery
+	RecordFailure(ctx context.Context, failure *models.WebhookFailure) error
+
+	// GetFailures lists the failed deliveries, most recent first
+	GetFailures(ctx context.Context) ([]*models.WebhookFailure, error)
+
+	// GetFailureByID retrieves a failed delivery by ID
+	GetFailureByID(ctx context.Context, id int) (*models.WebhookFailure, error)
+
+	// DeleteFailure removes a failed delivery, e.g. once it has been replayed
+	DeleteFailure(ctx context.Context, id int) error
+}
+
+// ContactRepository stores contact form submissions
+type ContactRepository interface {
+	// CreateContactMessage stores a submission
+	CreateContactMessage(ctx context.Context, message *models.ContactMessage) error
 }
 
 // Filter types for repository queries
 
+// FallbackRecent requests the most recent entries when a featured-only query
+// returns no rows. It is handled by the service layer, not the repositories.
+const FallbackRecent = "recent"
+
+// SortRecent orders list queries by most recently updated first
+const SortRecent = "updated_at desc"
+
 // ExperienceFilters defines filtering options for experience queries
 type ExperienceFilters struct {
-	Company    string
-	Position   string
-	DateFrom   *string // ISO date string
-	DateTo     *string // ISO date string
-	IsCurrent  *bool   // Filter for current positions (end_date IS NULL)
-	Limit      int
-	Offset     int
+	Company    string  `form:"company"`
+	Position   string  `form:"position"`
+	DateFrom   *string `form:"date_from"`  // ISO date string
+	DateTo     *string `form:"date_to"`    // ISO date string
+	IsCurrent  *bool   `form:"is_current"` // Filter for current positions (end_date IS NULL)
+	// HighlightContains matches experiences with a highlight containing the text
+	HighlightContains string `form:"highlight"`
+	Limit             int    `form:"limit"`
+	Offset            int    `form:"offset"`
+	// UpdatedSince restricts results to entries updated after this time; it is
+	// set by the incremental export rather than bound from the query string
+	UpdatedSince *time.Time `form:"-"`
 }
 
 // SkillFilters defines filtering options for skill queries
 type SkillFilters struct {
-	Category string
-	Level    string
-	Featured *bool
-	Limit    int
-	Offset   int
+	Category string `form:"category"`
+	Level    string `form:"level"`
+	Featured *bool  `form:"featured"`
+	Fallback string `form:"fallback" binding:"omitempty,oneof=recent"` // 'recent' when no featured rows exist
+	Limit    int    `form:"limit"`
+	Offset   int    `form:"offset"`
+	// Categories matches any of several categories and takes precedence over
+	// Category; a repeated ?category= binds every value to it
+	Categories []string `form:"category"`
+	// UpdatedSince restricts results to entries updated after this time; it is
+	// set by the incremental export rather than bound from the query string
+	UpdatedSince *time.Time `form:"-"`
+	// Sort overrides the list order with a "column [asc|desc]" spec; it is set
+	// by the service layer rather than bound from the query string
+	Sort string `form:"-"`
 }
 
 // AchievementFilters defines filtering options for achievement queries
 type AchievementFilters struct {
-	Category string
-	Year     *int
-	Featured *bool
-	Limit    int
-	Offset   int
+	Category string `form:"category"`
+	Year     *int   `form:"year"`
+	YearFrom *int   `form:"year_from"` // inclusive lower bound on year_achieved
+	YearTo   *int   `form:"year_to"`   // inclusive upper bound on year_achieved
+	Featured *bool  `form:"featured"`
+	Fallback string `form:"fallback" binding:"omitempty,oneof=recent"` // 'recent' when no featured rows exist
+	Limit    int    `form:"limit"`
+	Offset   int    `form:"offset"`
+	// UpdatedSince restricts results to entries updated after this time; it is
+	// set by the incremental export rather than bound from the query string
+	UpdatedSince *time.Time `form:"-"`
+	// Sort overrides the list order with a "column [asc|desc]" spec; it is set
+	// by the service layer rather than bound from the query string
+	Sort string `form:"-"`
 }
 
 // EducationFilters defines filtering options for education queries
 type EducationFilters struct {
-	Type         string // 'education' or 'certification'
-	Institution  string
-	Status       string // 'completed', 'in_progress', 'planned'
-	Featured     *bool
-	Limit        int
-	Offset       int
+	Type         string `form:"type"`   // 'education' or 'certification'
+	Institution  string `form:"institution"`
+	Status       string `form:"status"` // 'completed', 'in_progress', 'planned'
+	Featured     *bool  `form:"featured"`
+	Fallback     string `form:"fallback" binding:"omitempty,oneof=recent"` // 'recent' when no featured rows exist
+	Limit        int    `form:"limit"`
+	Offset       int    `form:"offset"`
+	// UpdatedSince restricts results to entries updated after this time; it is
+	// set by the incremental export rather than bound from the query string
+	UpdatedSince *time.Time `form:"-"`
+	// Sort overrides the list order with a "column [asc|desc]" spec; it is set
+	// by the service layer rather than bound from the query string
+	Sort string `form:"-"`
 }
 
 // ProjectFilters defines filtering options for project queries
 type ProjectFilters struct {
-	Status       string // 'active', 'completed', 'archived', 'planned'
-	Technology   string // Search in technologies JSONB
-	Featured     *bool
-	Limit        int
-	Offset       int
+	Status        string  `form:"status"`     // 'active', 'completed', 'archived', 'planned'
+	Technology    string  `form:"technology"` // Search in technologies JSONB
+	Featured      *bool   `form:"featured"`
+	Ongoing       *bool   `form:"ongoing"`                                                // Active projects without an end date
+	HasDemo       *bool   `form:"has_demo"`                                               // Projects with (or without) a demo_url
+	HasRepo       *bool   `form:"has_repo"`                                               // Projects with (or without) a github_url
+	StartedAfter  *string `form:"started_after" binding:"omitempty,datetime=2006-01-02"`  // inclusive lower bound on start_date
+	StartedBefore *string `form:"started_before" binding:"omitempty,datetime=2006-01-02"` // inclusive upper bound on start_date
+	ActiveDuring  *string `form:"active_during" binding:"omitempty,datetime=2006-01-02"`  // started by and not ended before this date
+	Fallback      string  `form:"fallback" binding:"omitempty,oneof=recent"`              // 'recent' when no featured rows exist
+	Limit         int     `form:"limit"`
+	Offset        int     `form:"offset"`
+	// UpdatedSince restricts results to entries updated after this time; it is
+	// set by the incremental export rather than bound from the query string
+	UpdatedSince *time.Time `form:"-"`
+	// Sort overrides the list order with a "column [asc|desc]" spec; it is set
+	// by the service layer rather than bound from the query string
+	Sort string `form:"-"`
 }
 
 // Repositories aggregates all repository interfaces
@@ -181,6 +321,7 @@ type Repositories struct {
 	Achievement AchievementRepository
 	Education   EducationRepository
 	Project     ProjectRepository
+	Search      SearchRepository
 }
 
 // RepositoryError represents a repository-specific error
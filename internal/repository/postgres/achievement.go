@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -14,12 +15,15 @@ import (
 
 // AchievementRepository implements repository.AchievementRepository for PostgreSQL
 type AchievementRepository struct {
-	db *pgxpool.Pool
+	db   *pgxpool.Pool
+	read *pgxpool.Pool // read replica, or db when none is configured
+	opts options
 }
 
 // NewAchievementRepository creates a new PostgreSQL achievement repository
-func NewAchievementRepository(db *pgxpool.Pool) *AchievementRepository {
-	return &AchievementRepository{db: db}
+func NewAchievementRepository(db *pgxpool.Pool, opts ...Option) *AchievementRepository {
+	o := newOptions(opts)
+	return &AchievementRepository{db: db, read: o.reader(db), opts: o}
 }
 
 // GetAchievements retrieves all achievements with optional filtering
@@ -46,17 +50,38 @@ func (r *AchievementRepository) GetAchievements(ctx context.Context, filters rep
 		argIndex++
 	}
 
+	switch {
+	case filters.YearFrom != nil && filters.YearTo != nil:
+		conditions = append(conditions, fmt.Sprintf("year_achieved BETWEEN $%d AND $%d", argIndex, argIndex+1))
+		args = append(args, *filters.YearFrom, *filters.YearTo)
+		argIndex += 2
+	case filters.YearFrom != nil:
+		conditions = append(conditions, fmt.Sprintf("year_achieved >= $%d", argIndex))
+		args = append(args, *filters.YearFrom)
+		argIndex++
+	case filters.YearTo != nil:
+		conditions = append(conditions, fmt.Sprintf("year_achieved <= $%d", argIndex))
+		args = append(args, *filters.YearTo)
+		argIndex++
+	}
+
 	if filters.Featured != nil {
 		conditions = append(conditions, fmt.Sprintf("is_featured = $%d", argIndex))
 		args = append(args, *filters.Featured)
 		argIndex++
 	}
 
+	if filters.UpdatedSince != nil {
+		conditions = append(conditions, fmt.Sprintf("updated_at > $%d", argIndex))
+		args = append(args, *filters.UpdatedSince)
+		argIndex++
+	}
+
 	if len(conditions) > 0 {
 		query += " WHERE " + strings.Join(conditions, " AND ")
 	}
 
-	query += " ORDER BY year_achieved DESC, order_index"
+	query += r.opts.orderBySort("achievements", filters.Sort, "year_achieved DESC, order_index")
 
 	// Apply pagination
 	if filters.Limit > 0 {
@@ -70,13 +95,13 @@ func (r *AchievementRepository) GetAchievements(ctx context.Context, filters rep
 		args = append(args, filters.Offset)
 	}
 
-	rows, err := r.db.Query(ctx, query, args...)
+	rows, err := r.read.Query(ctx, query, args...)
 	if err != nil {
 		return nil, repository.NewRepositoryError("get", "achievements", err)
 	}
 	defer rows.Close()
 
-	var achievements []*models.Achievement
+	achievements := []*models.Achievement{}
 	for rows.Next() {
 		var achievement models.Achievement
 		err := rows.Scan(
@@ -104,17 +129,20 @@ func (r *AchievementRepository) GetAchievements(ctx context.Context, filters rep
 	return achievements, nil
 }
 
-// GetFeaturedAchievements retrieves only featured achievements
-func (r *AchievementRepository) GetFeaturedAchievements(ctx context.Context) ([]*models.Achievement, error) {
+// GetFeaturedAchievements retrieves only featured achievements, applying the remaining
+// filters such as limit and offset
+func (r *AchievementRepository) GetFeaturedAchievements(ctx context.Context, filters repository.AchievementFilters) ([]*models.Achievement, error) {
 	featured := true
-	filters := repository.AchievementFilters{
-		Featured: &featured,
-	}
+	filters.Featured = &featured
 	return r.GetAchievements(ctx, filters)
 }
 
 // CreateAchievement creates a new achievement entry
 func (r *AchievementRepository) CreateAchievement(ctx context.Context, achievement *models.Achievement) error {
+	if err := achievement.Validate(); err != nil {
+		return repository.NewRepositoryError("create", "achievement", err)
+	}
+
 	query := `
 		INSERT INTO achievements (title, description, category, impact_metric, 
 		                         year_achieved, order_index, is_featured)
@@ -140,6 +168,10 @@ func (r *AchievementRepository) CreateAchievement(ctx context.Context, achieveme
 
 // UpdateAchievement updates an existing achievement
 func (r *AchievementRepository) UpdateAchievement(ctx context.Context, achievement *models.Achievement) error {
+	if err := achievement.Validate(); err != nil {
+		return repository.NewRepositoryError("update", "achievement", err)
+	}
+
 	query := `
 		UPDATE achievements 
 		SET title = $2, description = $3, category = $4, impact_metric = $5, 
@@ -161,7 +193,7 @@ func (r *AchievementRepository) UpdateAchievement(ctx context.Context, achieveme
 
 	if err != nil {
 		if err == pgx.ErrNoRows {
-			return repository.NewRepositoryError("update", "achievement", fmt.Errorf("achievement with id %d not found", achievement.ID))
+			return repository.NewRepositoryError("update", "achievement", fmt.Errorf("achievement with id %d %w", achievement.ID, repository.ErrNotFound))
 		}
 		return repository.NewRepositoryError("update", "achievement", err)
 	}
@@ -180,8 +212,13 @@ func (r *AchievementRepository) DeleteAchievement(ctx context.Context, id int) e
 
 	rowsAffected := result.RowsAffected()
 	if rowsAffected == 0 {
-		return repository.NewRepositoryError("delete", "achievement", fmt.Errorf("achievement with id %d not found", id))
+		return repository.NewRepositoryError("delete", "achievement", fmt.Errorf("achievement with id %d %w", id, repository.ErrNotFound))
 	}
 
 	return nil
-}
\ No newline at end of file
+}
+
+// GetMaxUpdatedAt returns when any achievement was last updated, or nil when there are none
+func (r *AchievementRepository) GetMaxUpdatedAt(ctx context.Context) (*time.Time, error) {
+	return maxUpdatedAt(ctx, r.read, "achievements", "achievements")
+}
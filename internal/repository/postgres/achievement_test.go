@@ -2,6 +2,7 @@ package postgres
 
 import (
 	"context"
+	"fmt"
 	"testing"
 	"time"
 
@@ -40,6 +41,38 @@ func TestAchievementRepository(t *testing.T) {
 		assert.NotZero(t, achievement.UpdatedAt)
 	})
 
+	t.Run("CreateAchievement_InvalidCategory", func(t *testing.T) {
+		testDB.CleanupTables(t)
+
+		achievement := &models.Achievement{
+			Title:    "Unknown Category",
+			Category: stringPtr("customer"),
+		}
+
+		err := repo.CreateAchievement(ctx, achievement)
+		assert.ErrorIs(t, err, models.ErrInvalidAchievementCategory)
+		assert.Zero(t, achievement.ID)
+	})
+
+	t.Run("UpdateAchievement_InvalidCategory", func(t *testing.T) {
+		testDB.CleanupTables(t)
+
+		achievement := &models.Achievement{
+			Title:    "Known Category",
+			Category: stringPtr(models.AchievementCategoryTeamwork),
+		}
+		require.NoError(t, repo.CreateAchievement(ctx, achievement))
+
+		achievement.Category = stringPtr("customer")
+		err := repo.UpdateAchievement(ctx, achievement)
+		assert.ErrorIs(t, err, models.ErrInvalidAchievementCategory)
+
+		retrieved, err := repo.GetAchievements(ctx, repository.AchievementFilters{})
+		require.NoError(t, err)
+		require.Len(t, retrieved, 1)
+		assert.Equal(t, models.AchievementCategoryTeamwork, *retrieved[0].Category)
+	})
+
 	t.Run("GetAchievements_All", func(t *testing.T) {
 		testDB.CleanupTables(t)
 
@@ -160,6 +193,73 @@ func TestAchievementRepository(t *testing.T) {
 		}
 	})
 
+	t.Run("GetAchievements_FilterByYearRange", func(t *testing.T) {
+		testDB.CleanupTables(t)
+
+		achievements := []*models.Achievement{
+			{
+				Title:        "Performance 2020",
+				Category:     stringPtr(models.AchievementCategoryPerformance),
+				YearAchieved: intPtr(2020),
+			},
+			{
+				Title:        "Performance 2021",
+				Category:     stringPtr(models.AchievementCategoryPerformance),
+				YearAchieved: intPtr(2021),
+			},
+			{
+				Title:        "Security 2022",
+				Category:     stringPtr(models.AchievementCategorySecurity),
+				YearAchieved: intPtr(2022),
+			},
+			{
+				Title:        "Performance 2023",
+				Category:     stringPtr(models.AchievementCategoryPerformance),
+				YearAchieved: intPtr(2023),
+			},
+			{
+				Title:        "Performance 2024",
+				Category:     stringPtr(models.AchievementCategoryPerformance),
+				YearAchieved: intPtr(2024),
+			},
+		}
+
+		for _, achievement := range achievements {
+			err := repo.CreateAchievement(ctx, achievement)
+			require.NoError(t, err)
+		}
+
+		// Range boundaries are inclusive
+		filters := repository.AchievementFilters{
+			YearFrom: intPtr(2021),
+			YearTo:   intPtr(2023),
+		}
+		retrieved, err := repo.GetAchievements(ctx, filters)
+		require.NoError(t, err)
+		require.Len(t, retrieved, 3)
+		assert.Equal(t, "Performance 2023", retrieved[0].Title)
+		assert.Equal(t, "Security 2022", retrieved[1].Title)
+		assert.Equal(t, "Performance 2021", retrieved[2].Title)
+
+		// Open-ended ranges
+		retrieved, err = repo.GetAchievements(ctx, repository.AchievementFilters{YearFrom: intPtr(2023)})
+		require.NoError(t, err)
+		assert.Len(t, retrieved, 2)
+
+		retrieved, err = repo.GetAchievements(ctx, repository.AchievementFilters{YearTo: intPtr(2020)})
+		require.NoError(t, err)
+		require.Len(t, retrieved, 1)
+		assert.Equal(t, "Performance 2020", retrieved[0].Title)
+
+		// Range combined with category
+		filters.Category = models.AchievementCategoryPerformance
+		retrieved, err = repo.GetAchievements(ctx, filters)
+		require.NoError(t, err)
+		require.Len(t, retrieved, 2)
+		assert.Equal(t, "Performance 2023", retrieved[0].Title)
+		assert.Equal(t, "Performance 2021", retrieved[1].Title)
+	})
+
 	t.Run("GetAchievements_FilterByFeatured", func(t *testing.T) {
 		testDB.CleanupTables(t)
 
@@ -292,7 +392,7 @@ func TestAchievementRepository(t *testing.T) {
 			require.NoError(t, err)
 		}
 
-		featured, err := repo.GetFeaturedAchievements(ctx)
+		featured, err := repo.GetFeaturedAchievements(ctx, repository.AchievementFilters{})
 		require.NoError(t, err)
 		assert.Len(t, featured, 2)
 		for _, achievement := range featured {
@@ -300,6 +400,25 @@ func TestAchievementRepository(t *testing.T) {
 		}
 	})
 
+	t.Run("GetFeaturedAchievements_Paginated", func(t *testing.T) {
+		testDB.CleanupTables(t)
+
+		// Five featured entries and two regular ones
+		for i := 0; i < 7; i++ {
+			require.NoError(t, repo.CreateAchievement(ctx, &models.Achievement{Title: fmt.Sprintf("Achievement %d", i), IsFeatured: i < 5}))
+		}
+
+		page, err := repo.GetFeaturedAchievements(ctx, repository.AchievementFilters{Limit: 2})
+		require.NoError(t, err)
+		assert.Len(t, page, 2)
+
+		lastPage, err := repo.GetFeaturedAchievements(ctx, repository.AchievementFilters{Limit: 2, Offset: 4})
+		require.NoError(t, err)
+		require.Len(t, lastPage, 1)
+		assert.True(t, lastPage[0].IsFeatured)
+		assert.NotEqual(t, page[0].ID, lastPage[0].ID)
+	})
+
 	t.Run("UpdateAchievement", func(t *testing.T) {
 		testDB.CleanupTables(t)
 
@@ -353,6 +472,7 @@ func TestAchievementRepository(t *testing.T) {
 		err := repo.UpdateAchievement(ctx, achievement)
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "achievement with id 999 not found")
+		assert.ErrorIs(t, err, repository.ErrNotFound)
 	})
 
 	t.Run("DeleteAchievement", func(t *testing.T) {
@@ -387,6 +507,7 @@ func TestAchievementRepository(t *testing.T) {
 		err := repo.DeleteAchievement(ctx, 999)
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "achievement with id 999 not found")
+		assert.ErrorIs(t, err, repository.ErrNotFound)
 	})
 
 	t.Run("AchievementCategories_Constants", func(t *testing.T) {
@@ -0,0 +1,40 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/npmulder/resume-api/internal/models"
+	"github.com/npmulder/resume-api/internal/repository"
+)
+
+// ContactRepository implements repository.ContactRepository for PostgreSQL
+type ContactRepository struct {
+	db *pgxpool.Pool
+}
+
+// NewContactRepository creates a new PostgreSQL contact message repository
+func NewContactRepository(db *pgxpool.Pool) *ContactRepository {
+	return &ContactRepository{db: db}
+}
+
+// CreateContactMessage stores a submission
+func (r *ContactRepository) CreateContactMessage(ctx context.Context, message *models.ContactMessage) error {
+	query := `
+		INSERT INTO contact_messages (name, email, message)
+		VALUES ($1, $2, $3)
+		RETURNING id, created_at`
+
+	err := r.db.QueryRow(ctx, query,
+		message.Name,
+		message.Email,
+		message.Message,
+	).Scan(&message.ID, &message.CreatedAt)
+
+	if err != nil {
+		return repository.NewRepositoryError("create", "contact message", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,42 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/npmulder/resume-api/internal/models"
+)
+
+func TestContactRepository(t *testing.T) {
+	testDB := setupTestDB(t)
+	defer testDB.Close()
+
+	repo := NewContactRepository(testDB.Pool())
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	t.Run("CreateContactMessage", func(t *testing.T) {
+		testDB.CleanupTables(t)
+
+		message := &models.ContactMessage{
+			Name:    "Jane Doe",
+			Email:   "jane@example.com",
+			Message: "Are you available for a consulting project?",
+		}
+		require.NoError(t, repo.CreateContactMessage(ctx, message))
+		assert.NotZero(t, message.ID)
+		assert.NotZero(t, message.CreatedAt)
+
+		var name, email, body string
+		err := testDB.Pool().QueryRow(ctx, "SELECT name, email, message FROM contact_messages WHERE id = $1", message.ID).
+			Scan(&name, &email, &body)
+		require.NoError(t, err)
+		assert.Equal(t, "Jane Doe", name)
+		assert.Equal(t, "jane@example.com", email)
+		assert.Equal(t, "Are you available for a consulting project?", body)
+	})
+}
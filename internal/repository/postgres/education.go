@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -14,12 +15,15 @@ import (
 
 // EducationRepository implements repository.EducationRepository for PostgreSQL
 type EducationRepository struct {
-	db *pgxpool.Pool
+	db   *pgxpool.Pool
+	read *pgxpool.Pool // read replica, or db when none is configured
+	opts options
 }
 
 // NewEducationRepository creates a new PostgreSQL education repository
-func NewEducationRepository(db *pgxpool.Pool) *EducationRepository {
-	return &EducationRepository{db: db}
+func NewEducationRepository(db *pgxpool.Pool, opts ...Option) *EducationRepository {
+	o := newOptions(opts)
+	return &EducationRepository{db: db, read: o.reader(db), opts: o}
 }
 
 // GetEducation retrieves all education entries with optional filtering
@@ -43,7 +47,7 @@ func (r *EducationRepository) GetEducation(ctx context.Context, filters reposito
 
 	if filters.Institution != "" {
 		conditions = append(conditions, fmt.Sprintf("institution ILIKE $%d", argIndex))
-		args = append(args, "%"+filters.Institution+"%")
+		args = append(args, containsPattern(filters.Institution))
 		argIndex++
 	}
 
@@ -59,11 +63,17 @@ func (r *EducationRepository) GetEducation(ctx context.Context, filters reposito
 		argIndex++
 	}
 
+	if filters.UpdatedSince != nil {
+		conditions = append(conditions, fmt.Sprintf("updated_at > $%d", argIndex))
+		args = append(args, *filters.UpdatedSince)
+		argIndex++
+	}
+
 	if len(conditions) > 0 {
 		query += " WHERE " + strings.Join(conditions, " AND ")
 	}
 
-	query += " ORDER BY type, year_completed DESC, order_index"
+	query += r.opts.orderBySort("education", filters.Sort, "type, year_completed DESC, order_index")
 
 	// Apply pagination
 	if filters.Limit > 0 {
@@ -77,13 +87,13 @@ func (r *EducationRepository) GetEducation(ctx context.Context, filters reposito
 		args = append(args, filters.Offset)
 	}
 
-	rows, err := r.db.Query(ctx, query, args...)
+	rows, err := r.read.Query(ctx, query, args...)
 	if err != nil {
 		return nil, repository.NewRepositoryError("get", "education", err)
 	}
 	defer rows.Close()
 
-	var educations []*models.Education
+	educations := []*models.Education{}
 	for rows.Next() {
 		var edu models.Education
 		err := rows.Scan(
@@ -125,15 +135,87 @@ func (r *EducationRepository) GetEducationByType(ctx context.Context, eduType st
 	return r.GetEducation(ctx, filters)
 }
 
-// GetFeaturedEducation retrieves only featured education entries
-func (r *EducationRepository) GetFeaturedEducation(ctx context.Context) ([]*models.Education, error) {
+// GetFeaturedEducation retrieves only featured education entries, applying the remaining
+// filters such as limit and offset
+func (r *EducationRepository) GetFeaturedEducation(ctx context.Context, filters repository.EducationFilters) ([]*models.Education, error) {
 	featured := true
-	filters := repository.EducationFilters{
-		Featured: &featured,
-	}
+	filters.Featured = &featured
 	return r.GetEducation(ctx, filters)
 }
 
+// GetInstitutions lists the distinct institutions with their number of
+// entries, most entries first
+func (r *EducationRepository) GetInstitutions(ctx context.Context) ([]*models.InstitutionCount, error) {
+	query := `
+		SELECT institution, COUNT(*)
+		FROM education
+		GROUP BY institution
+		ORDER BY COUNT(*) DESC, institution`
+
+	rows, err := r.read.Query(ctx, query)
+	if err != nil {
+		return nil, repository.NewRepositoryError("get", "institutions", err)
+	}
+	defer rows.Close()
+
+	institutions := []*models.InstitutionCount{}
+	for rows.Next() {
+		var institution models.InstitutionCount
+		if err := rows.Scan(&institution.Institution, &institution.Count); err != nil {
+			return nil, repository.NewRepositoryError("scan", "institutions", err)
+		}
+		institutions = append(institutions, &institution)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, repository.NewRepositoryError("iterate", "institutions", err)
+	}
+
+	return institutions, nil
+}
+
+// GetEducationByCredentialID retrieves the certification with the given
+// credential ID, the oldest one when several share it
+func (r *EducationRepository) GetEducationByCredentialID(ctx context.Context, credentialID string) (*models.Education, error) {
+	query := `
+		SELECT id, institution, degree_or_certification, field_of_study, year_completed, 
+		       year_started, description, type, status, credential_id, credential_url, 
+		       expiry_date, order_index, is_featured, created_at, updated_at
+		FROM education
+		WHERE type = $1 AND credential_id = $2
+		ORDER BY id
+		LIMIT 1`
+
+	var edu models.Education
+	err := r.read.QueryRow(ctx, query, models.EducationTypeCertification, credentialID).Scan(
+		&edu.ID,
+		&edu.Institution,
+		&edu.DegreeOrCertification,
+		&edu.FieldOfStudy,
+		&edu.YearCompleted,
+		&edu.YearStarted,
+		&edu.Description,
+		&edu.Type,
+		&edu.Status,
+		&edu.CredentialID,
+		&edu.CredentialURL,
+		&edu.ExpiryDate,
+		&edu.OrderIndex,
+		&edu.IsFeatured,
+		&edu.CreatedAt,
+		&edu.UpdatedAt,
+	)
+
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, repository.NewRepositoryError("get", "education", fmt.Errorf("certification with credential id %q %w", credentialID, repository.ErrNotFound))
+		}
+		return nil, repository.NewRepositoryError("get", "education", err)
+	}
+
+	return &edu, nil
+}
+
 // CreateEducation creates a new education entry
 func (r *EducationRepository) CreateEducation(ctx context.Context, education *models.Education) error {
 	query := `
@@ -196,7 +278,7 @@ func (r *EducationRepository) UpdateEducation(ctx context.Context, education *mo
 
 	if err != nil {
 		if err == pgx.ErrNoRows {
-			return repository.NewRepositoryError("update", "education", fmt.Errorf("education with id %d not found", education.ID))
+			return repository.NewRepositoryError("update", "education", fmt.Errorf("education with id %d %w", education.ID, repository.ErrNotFound))
 		}
 		return repository.NewRepositoryError("update", "education", err)
 	}
@@ -215,8 +297,13 @@ func (r *EducationRepository) DeleteEducation(ctx context.Context, id int) error
 
 	rowsAffected := result.RowsAffected()
 	if rowsAffected == 0 {
-		return repository.NewRepositoryError("delete", "education", fmt.Errorf("education with id %d not found", id))
+		return repository.NewRepositoryError("delete", "education", fmt.Errorf("education with id %d %w", id, repository.ErrNotFound))
 	}
 
 	return nil
-}
\ No newline at end of file
+}
+
+// GetMaxUpdatedAt returns when any education entry was last updated, or nil when there are none
+func (r *EducationRepository) GetMaxUpdatedAt(ctx context.Context) (*time.Time, error) {
+	return maxUpdatedAt(ctx, r.read, "education", "education")
+}
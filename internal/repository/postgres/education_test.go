@@ -2,6 +2,7 @@ package postgres
 
 import (
 	"context"
+	"fmt"
 	"testing"
 	"time"
 
@@ -206,6 +207,39 @@ func TestEducationRepository(t *testing.T) {
 		}
 	})
 
+	t.Run("GetEducation_FilterByInstitutionEscapesWildcards", func(t *testing.T) {
+		testDB.CleanupTables(t)
+
+		educations := []*models.Education{
+			{
+				Institution:           "Top 1% Academy",
+				DegreeOrCertification: "Leadership Program",
+				Type:                  models.EducationTypeCertification,
+				Status:                models.EducationStatusCompleted,
+			},
+			{
+				Institution:           "Top 10 Academy",
+				DegreeOrCertification: "Management Program",
+				Type:                  models.EducationTypeCertification,
+				Status:                models.EducationStatusCompleted,
+			},
+		}
+
+		for _, education := range educations {
+			err := repo.CreateEducation(ctx, education)
+			require.NoError(t, err)
+		}
+
+		// A literal % only matches a literal % in the data
+		filters := repository.EducationFilters{
+			Institution: "1%",
+		}
+		retrieved, err := repo.GetEducation(ctx, filters)
+		require.NoError(t, err)
+		require.Len(t, retrieved, 1)
+		assert.Equal(t, "Top 1% Academy", retrieved[0].Institution)
+	})
+
 	t.Run("GetEducation_FilterByStatus", func(t *testing.T) {
 		testDB.CleanupTables(t)
 
@@ -357,6 +391,82 @@ func TestEducationRepository(t *testing.T) {
 		assert.Equal(t, models.EducationTypeCertification, certList[0].Type)
 	})
 
+	t.Run("GetInstitutions", func(t *testing.T) {
+		testDB.CleanupTables(t)
+
+		educations := []*models.Education{
+			{Institution: "AWS", DegreeOrCertification: "Solutions Architect", Type: models.EducationTypeCertification, Status: models.EducationStatusCompleted},
+			{Institution: "AWS", DegreeOrCertification: "Developer", Type: models.EducationTypeCertification, Status: models.EducationStatusCompleted},
+			{Institution: "AWS", DegreeOrCertification: "SysOps Administrator", Type: models.EducationTypeCertification, Status: models.EducationStatusPlanned},
+			{Institution: "University A", DegreeOrCertification: "Bachelor's Degree", Type: models.EducationTypeEducation, Status: models.EducationStatusCompleted},
+			{Institution: "University A", DegreeOrCertification: "Master's Degree", Type: models.EducationTypeEducation, Status: models.EducationStatusInProgress},
+			{Institution: "University B", DegreeOrCertification: "Exchange Semester", Type: models.EducationTypeEducation, Status: models.EducationStatusCompleted},
+		}
+		for _, education := range educations {
+			require.NoError(t, repo.CreateEducation(ctx, education))
+		}
+
+		institutions, err := repo.GetInstitutions(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, []*models.InstitutionCount{
+			{Institution: "AWS", Count: 3},
+			{Institution: "University A", Count: 2},
+			{Institution: "University B", Count: 1},
+		}, institutions)
+	})
+
+	t.Run("GetInstitutions_Empty", func(t *testing.T) {
+		testDB.CleanupTables(t)
+
+		institutions, err := repo.GetInstitutions(ctx)
+		require.NoError(t, err)
+		assert.NotNil(t, institutions)
+		assert.Empty(t, institutions)
+	})
+
+	t.Run("GetEducationByCredentialID", func(t *testing.T) {
+		testDB.CleanupTables(t)
+
+		certification := &models.Education{
+			Institution:           "AWS",
+			DegreeOrCertification: "AWS Certified Solutions Architect",
+			Type:                  models.EducationTypeCertification,
+			Status:                models.EducationStatusCompleted,
+			CredentialID:          stringPtr("AWS-CSA-123456"),
+			CredentialURL:         stringPtr("https://aws.amazon.com/verification/123456"),
+		}
+		require.NoError(t, repo.CreateEducation(ctx, certification))
+		require.NoError(t, repo.CreateEducation(ctx, &models.Education{
+			Institution:           "CNCF",
+			DegreeOrCertification: "Certified Kubernetes Administrator",
+			Type:                  models.EducationTypeCertification,
+			Status:                models.EducationStatusCompleted,
+			CredentialID:          stringPtr("CKA-654321"),
+		}))
+
+		found, err := repo.GetEducationByCredentialID(ctx, "AWS-CSA-123456")
+		require.NoError(t, err)
+		assert.Equal(t, certification.ID, found.ID)
+		assert.Equal(t, "AWS Certified Solutions Architect", found.DegreeOrCertification)
+		assert.Equal(t, "https://aws.amazon.com/verification/123456", *found.CredentialURL)
+	})
+
+	t.Run("GetEducationByCredentialID_NotFound", func(t *testing.T) {
+		testDB.CleanupTables(t)
+
+		require.NoError(t, repo.CreateEducation(ctx, &models.Education{
+			Institution:           "AWS",
+			DegreeOrCertification: "AWS Certified Solutions Architect",
+			Type:                  models.EducationTypeCertification,
+			Status:                models.EducationStatusCompleted,
+			CredentialID:          stringPtr("AWS-CSA-123456"),
+		}))
+
+		found, err := repo.GetEducationByCredentialID(ctx, "AWS-CSA-000000")
+		assert.Nil(t, found)
+		assert.ErrorIs(t, err, repository.ErrNotFound)
+	})
+
 	t.Run("GetFeaturedEducation", func(t *testing.T) {
 		testDB.CleanupTables(t)
 
@@ -371,7 +481,7 @@ func TestEducationRepository(t *testing.T) {
 			require.NoError(t, err)
 		}
 
-		featured, err := repo.GetFeaturedEducation(ctx)
+		featured, err := repo.GetFeaturedEducation(ctx, repository.EducationFilters{})
 		require.NoError(t, err)
 		assert.Len(t, featured, 2)
 		for _, education := range featured {
@@ -379,6 +489,25 @@ func TestEducationRepository(t *testing.T) {
 		}
 	})
 
+	t.Run("GetFeaturedEducation_Paginated", func(t *testing.T) {
+		testDB.CleanupTables(t)
+
+		// Five featured entries and two regular ones
+		for i := 0; i < 7; i++ {
+			require.NoError(t, repo.CreateEducation(ctx, &models.Education{Institution: fmt.Sprintf("Uni %d", i), DegreeOrCertification: "Degree", Type: models.EducationTypeEducation, Status: models.EducationStatusCompleted, IsFeatured: i < 5}))
+		}
+
+		page, err := repo.GetFeaturedEducation(ctx, repository.EducationFilters{Limit: 2})
+		require.NoError(t, err)
+		assert.Len(t, page, 2)
+
+		lastPage, err := repo.GetFeaturedEducation(ctx, repository.EducationFilters{Limit: 2, Offset: 4})
+		require.NoError(t, err)
+		require.Len(t, lastPage, 1)
+		assert.True(t, lastPage[0].IsFeatured)
+		assert.NotEqual(t, page[0].ID, lastPage[0].ID)
+	})
+
 	t.Run("UpdateEducation", func(t *testing.T) {
 		testDB.CleanupTables(t)
 
@@ -433,6 +562,7 @@ func TestEducationRepository(t *testing.T) {
 		err := repo.UpdateEducation(ctx, education)
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "education with id 999 not found")
+		assert.ErrorIs(t, err, repository.ErrNotFound)
 	})
 
 	t.Run("DeleteEducation", func(t *testing.T) {
@@ -470,6 +600,7 @@ func TestEducationRepository(t *testing.T) {
 		err := repo.DeleteEducation(ctx, 999)
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "education with id 999 not found")
+		assert.ErrorIs(t, err, repository.ErrNotFound)
 	})
 
 	t.Run("EducationConstants_Validation", func(t *testing.T) {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -14,12 +15,15 @@ import (
 
 // ExperienceRepository implements repository.ExperienceRepository for PostgreSQL
 type ExperienceRepository struct {
-	db *pgxpool.Pool
+	db   *pgxpool.Pool
+	read *pgxpool.Pool // read replica, or db when none is configured
+	opts options
 }
 
 // NewExperienceRepository creates a new PostgreSQL experience repository
-func NewExperienceRepository(db *pgxpool.Pool) *ExperienceRepository {
-	return &ExperienceRepository{db: db}
+func NewExperienceRepository(db *pgxpool.Pool, opts ...Option) *ExperienceRepository {
+	o := newOptions(opts)
+	return &ExperienceRepository{db: db, read: o.reader(db), opts: o}
 }
 
 // GetExperiences retrieves all work experiences with optional filtering
@@ -36,13 +40,13 @@ func (r *ExperienceRepository) GetExperiences(ctx context.Context, filters repos
 	// Apply filters
 	if filters.Company != "" {
 		conditions = append(conditions, fmt.Sprintf("company ILIKE $%d", argIndex))
-		args = append(args, "%"+filters.Company+"%")
+		args = append(args, containsPattern(filters.Company))
 		argIndex++
 	}
 
 	if filters.Position != "" {
 		conditions = append(conditions, fmt.Sprintf("position ILIKE $%d", argIndex))
-		args = append(args, "%"+filters.Position+"%")
+		args = append(args, containsPattern(filters.Position))
 		argIndex++
 	}
 
@@ -58,6 +62,12 @@ func (r *ExperienceRepository) GetExperiences(ctx context.Context, filters repos
 		argIndex++
 	}
 
+	if filters.HighlightContains != "" {
+		conditions = append(conditions, fmt.Sprintf("EXISTS (SELECT 1 FROM unnest(highlights) h WHERE h ILIKE $%d)", argIndex))
+		args = append(args, containsPattern(filters.HighlightContains))
+		argIndex++
+	}
+
 	if filters.IsCurrent != nil {
 		if *filters.IsCurrent {
 			conditions = append(conditions, "end_date IS NULL")
@@ -66,11 +76,17 @@ func (r *ExperienceRepository) GetExperiences(ctx context.Context, filters repos
 		}
 	}
 
+	if filters.UpdatedSince != nil {
+		conditions = append(conditions, fmt.Sprintf("updated_at > $%d", argIndex))
+		args = append(args, *filters.UpdatedSince)
+		argIndex++
+	}
+
 	if len(conditions) > 0 {
 		query += " WHERE " + strings.Join(conditions, " AND ")
 	}
 
-	query += " ORDER BY start_date DESC"
+	query += r.opts.orderBy("experiences", "start_date DESC")
 
 	// Apply pagination
 	if filters.Limit > 0 {
@@ -84,13 +100,13 @@ func (r *ExperienceRepository) GetExperiences(ctx context.Context, filters repos
 		args = append(args, filters.Offset)
 	}
 
-	rows, err := r.db.Query(ctx, query, args...)
+	rows, err := r.read.Query(ctx, query, args...)
 	if err != nil {
 		return nil, repository.NewRepositoryError("get", "experiences", err)
 	}
 	defer rows.Close()
 
-	var experiences []*models.Experience
+	experiences := []*models.Experience{}
 	for rows.Next() {
 		var exp models.Experience
 		err := rows.Scan(
@@ -127,7 +143,7 @@ func (r *ExperienceRepository) GetExperienceByID(ctx context.Context, id int) (*
 		WHERE id = $1`
 
 	var exp models.Experience
-	err := r.db.QueryRow(ctx, query, id).Scan(
+	err := r.read.QueryRow(ctx, query, id).Scan(
 		&exp.ID,
 		&exp.Company,
 		&exp.Position,
@@ -142,7 +158,7 @@ func (r *ExperienceRepository) GetExperienceByID(ctx context.Context, id int) (*
 
 	if err != nil {
 		if err == pgx.ErrNoRows {
-			return nil, repository.NewRepositoryError("get", "experience", fmt.Errorf("experience with id %d not found", id))
+			return nil, repository.NewRepositoryError("get", "experience", fmt.Errorf("experience with id %d %w", id, repository.ErrNotFound))
 		}
 		return nil, repository.NewRepositoryError("get", "experience", err)
 	}
@@ -150,8 +166,13 @@ func (r *ExperienceRepository) GetExperienceByID(ctx context.Context, id int) (*
 	return &exp, nil
 }
 
-// CreateExperience creates a new experience entry
+// CreateExperience creates a new experience entry. It returns
+// models.ErrTooManyItems when the highlights exceed the configured limit.
 func (r *ExperienceRepository) CreateExperience(ctx context.Context, experience *models.Experience) error {
+	if err := experience.ValidateLimits(r.opts.listLimits); err != nil {
+		return repository.NewRepositoryError("create", "experience", err)
+	}
+
 	query := `
 		INSERT INTO experiences (company, position, start_date, end_date, description, 
 		                        highlights, order_index)
@@ -175,8 +196,13 @@ func (r *ExperienceRepository) CreateExperience(ctx context.Context, experience
 	return nil
 }
 
-// UpdateExperience updates an existing experience
+// UpdateExperience updates an existing experience, checking the same limits
+// as CreateExperience
 func (r *ExperienceRepository) UpdateExperience(ctx context.Context, experience *models.Experience) error {
+	if err := experience.ValidateLimits(r.opts.listLimits); err != nil {
+		return repository.NewRepositoryError("update", "experience", err)
+	}
+
 	query := `
 		UPDATE experiences 
 		SET company = $2, position = $3, start_date = $4, end_date = $5, 
@@ -198,7 +224,7 @@ func (r *ExperienceRepository) UpdateExperience(ctx context.Context, experience
 
 	if err != nil {
 		if err == pgx.ErrNoRows {
-			return repository.NewRepositoryError("update", "experience", fmt.Errorf("experience with id %d not found", experience.ID))
+			return repository.NewRepositoryError("update", "experience", fmt.Errorf("experience with id %d %w", experience.ID, repository.ErrNotFound))
 		}
 		return repository.NewRepositoryError("update", "experience", err)
 	}
@@ -217,8 +243,13 @@ func (r *ExperienceRepository) DeleteExperience(ctx context.Context, id int) err
 
 	rowsAffected := result.RowsAffected()
 	if rowsAffected == 0 {
-		return repository.NewRepositoryError("delete", "experience", fmt.Errorf("experience with id %d not found", id))
+		return repository.NewRepositoryError("delete", "experience", fmt.Errorf("experience with id %d %w", id, repository.ErrNotFound))
 	}
 
 	return nil
-}
\ No newline at end of file
+}
+
+// GetMaxUpdatedAt returns when any experience was last updated, or nil when there are none
+func (r *ExperienceRepository) GetMaxUpdatedAt(ctx context.Context) (*time.Time, error) {
+	return maxUpdatedAt(ctx, r.read, "experiences", "experiences")
+}
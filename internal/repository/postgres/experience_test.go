@@ -47,6 +47,28 @@ func TestExperienceRepository(t *testing.T) {
 		assert.NotZero(t, experience.UpdatedAt)
 	})
 
+	t.Run("CreateExperience_TooManyHighlights", func(t *testing.T) {
+		testDB.CleanupTables(t)
+
+		limited := NewExperienceRepository(testDB.Pool(), WithListLimits(models.ListLimits{Highlights: 2}))
+		experience := &models.Experience{
+			Company:    "TechCorp Inc",
+			Position:   "Engineer",
+			StartDate:  time.Date(2022, 1, 15, 0, 0, 0, 0, time.UTC),
+			Highlights: []string{"Shipped v1", "Cut latency", "Mentored"},
+		}
+
+		err := limited.CreateExperience(ctx, experience)
+		assert.ErrorIs(t, err, models.ErrTooManyItems)
+		assert.Zero(t, experience.ID)
+
+		// Within the limit the experience is stored, and updates are checked too
+		experience.Highlights = experience.Highlights[:2]
+		require.NoError(t, limited.CreateExperience(ctx, experience))
+		experience.Highlights = append(experience.Highlights, "Mentored")
+		assert.ErrorIs(t, limited.UpdateExperience(ctx, experience), models.ErrTooManyItems)
+	})
+
 	t.Run("GetExperienceByID", func(t *testing.T) {
 		testDB.CleanupTables(t)
 
@@ -91,6 +113,7 @@ func TestExperienceRepository(t *testing.T) {
 		assert.Error(t, err)
 		assert.Nil(t, experience)
 		assert.Contains(t, err.Error(), "experience with id 999 not found")
+		assert.ErrorIs(t, err, repository.ErrNotFound)
 	})
 
 	t.Run("GetExperiences_All", func(t *testing.T) {
@@ -175,6 +198,45 @@ func TestExperienceRepository(t *testing.T) {
 		assert.Equal(t, "Software Engineer", retrieved[1].Position)
 	})
 
+	t.Run("GetExperiences_FilterEscapesWildcards", func(t *testing.T) {
+		testDB.CleanupTables(t)
+
+		experiences := []*models.Experience{
+			{
+				Company:   "100% Remote Ltd",
+				Position:  "Backend_Engineer",
+				StartDate: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+			{
+				Company:   "100 Percent Remote",
+				Position:  "Backend Engineer",
+				StartDate: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+		}
+
+		for _, exp := range experiences {
+			err := repo.CreateExperience(ctx, exp)
+			require.NoError(t, err)
+		}
+
+		// A literal % only matches a literal % in the data
+		retrieved, err := repo.GetExperiences(ctx, repository.ExperienceFilters{Company: "100%"})
+		require.NoError(t, err)
+		require.Len(t, retrieved, 1)
+		assert.Equal(t, "100% Remote Ltd", retrieved[0].Company)
+
+		// A literal _ doesn't match an arbitrary character
+		retrieved, err = repo.GetExperiences(ctx, repository.ExperienceFilters{Position: "Backend_"})
+		require.NoError(t, err)
+		require.Len(t, retrieved, 1)
+		assert.Equal(t, "Backend_Engineer", retrieved[0].Position)
+
+		// A lone % no longer matches everything
+		retrieved, err = repo.GetExperiences(ctx, repository.ExperienceFilters{Company: "%"})
+		require.NoError(t, err)
+		assert.Len(t, retrieved, 1)
+	})
+
 	t.Run("GetExperiences_FilterByPosition", func(t *testing.T) {
 		testDB.CleanupTables(t)
 
@@ -257,6 +319,56 @@ func TestExperienceRepository(t *testing.T) {
 		assert.NotNil(t, retrieved[0].EndDate)
 	})
 
+	t.Run("GetExperiences_FilterByHighlight", func(t *testing.T) {
+		testDB.CleanupTables(t)
+
+		experiences := []*models.Experience{
+			{
+				Company:    "Kubernetes Co",
+				Position:   "Engineer",
+				StartDate:  time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+				Highlights: []string{"Migrated services to Kubernetes", "Mentored junior engineers"},
+			},
+			{
+				Company:    "Payments Co",
+				Position:   "Engineer",
+				StartDate:  time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+				Highlights: []string{"Built payment processing with 99.99% uptime"},
+			},
+			{
+				Company:   "No Highlights Co",
+				Position:  "Engineer",
+				StartDate: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+		}
+
+		for _, exp := range experiences {
+			err := repo.CreateExperience(ctx, exp)
+			require.NoError(t, err)
+		}
+
+		// Matching is case-insensitive against any element of the array
+		retrieved, err := repo.GetExperiences(ctx, repository.ExperienceFilters{HighlightContains: "kubernetes"})
+		require.NoError(t, err)
+		require.Len(t, retrieved, 1)
+		assert.Equal(t, "Kubernetes Co", retrieved[0].Company)
+
+		retrieved, err = repo.GetExperiences(ctx, repository.ExperienceFilters{HighlightContains: "Mentored"})
+		require.NoError(t, err)
+		require.Len(t, retrieved, 1)
+		assert.Equal(t, "Kubernetes Co", retrieved[0].Company)
+
+		// Wildcards are matched literally
+		retrieved, err = repo.GetExperiences(ctx, repository.ExperienceFilters{HighlightContains: "99%"})
+		require.NoError(t, err)
+		require.Len(t, retrieved, 1)
+		assert.Equal(t, "Payments Co", retrieved[0].Company)
+
+		retrieved, err = repo.GetExperiences(ctx, repository.ExperienceFilters{HighlightContains: "blockchain"})
+		require.NoError(t, err)
+		assert.Empty(t, retrieved)
+	})
+
 	t.Run("GetExperiences_FilterByDateRange", func(t *testing.T) {
 		testDB.CleanupTables(t)
 
@@ -376,6 +488,7 @@ func TestExperienceRepository(t *testing.T) {
 		err := repo.UpdateExperience(ctx, experience)
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "experience with id 999 not found")
+		assert.ErrorIs(t, err, repository.ErrNotFound)
 	})
 
 	t.Run("DeleteExperience", func(t *testing.T) {
@@ -403,6 +516,7 @@ func TestExperienceRepository(t *testing.T) {
 		_, err = repo.GetExperienceByID(ctx, experience.ID)
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "not found")
+		assert.ErrorIs(t, err, repository.ErrNotFound)
 	})
 
 	t.Run("DeleteExperience_NotFound", func(t *testing.T) {
@@ -411,5 +525,6 @@ func TestExperienceRepository(t *testing.T) {
 		err := repo.DeleteExperience(ctx, 999)
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "experience with id 999 not found")
+		assert.ErrorIs(t, err, repository.ErrNotFound)
 	})
 }
\ No newline at end of file
@@ -0,0 +1,13 @@
+package postgres
+
+import "strings"
+
+// likeEscaper escapes the LIKE metacharacters using PostgreSQL's default
+// escape character, the backslash
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// containsPattern returns a LIKE/ILIKE pattern matching values that contain
+// s literally, so wildcards in user input don't change the match semantics
+func containsPattern(s string) string {
+	return "%" + likeEscaper.Replace(s) + "%"
+}
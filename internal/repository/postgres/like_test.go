@@ -0,0 +1,14 @@
+package postgres
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContainsPattern(t *testing.T) {
+	assert.Equal(t, "%Acme%", containsPattern("Acme"))
+	assert.Equal(t, `%100\% Remote%`, containsPattern("100% Remote"))
+	assert.Equal(t, `%snake\_case%`, containsPattern("snake_case"))
+	assert.Equal(t, `%C:\\Temp%`, containsPattern(`C:\Temp`))
+}
@@ -4,6 +4,7 @@ package postgres
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -14,12 +15,13 @@ import (
 
 // ProfileRepository implements repository.ProfileRepository for PostgreSQL
 type ProfileRepository struct {
-	db *pgxpool.Pool
+	db   *pgxpool.Pool
+	read *pgxpool.Pool // read replica, or db when none is configured
 }
 
 // NewProfileRepository creates a new PostgreSQL profile repository
-func NewProfileRepository(db *pgxpool.Pool) *ProfileRepository {
-	return &ProfileRepository{db: db}
+func NewProfileRepository(db *pgxpool.Pool, opts ...Option) *ProfileRepository {
+	return &ProfileRepository{db: db, read: newOptions(opts).reader(db)}
 }
 
 // GetProfile retrieves the user's profile information
@@ -32,7 +34,7 @@ func (r *ProfileRepository) GetProfile(ctx context.Context) (*models.Profile, er
 		LIMIT 1`
 
 	var profile models.Profile
-	err := r.db.QueryRow(ctx, query).Scan(
+	err := r.read.QueryRow(ctx, query).Scan(
 		&profile.ID,
 		&profile.Name,
 		&profile.Title,
@@ -82,16 +84,48 @@ func (r *ProfileRepository) CreateProfile(ctx context.Context, profile *models.P
 	return nil
 }
 
-// UpdateProfile updates the user's profile information
+// UpdateProfile updates the user's profile information. The current version
+// is copied into profile_history in the same transaction. The update only
+// applies while updated_at still equals profile.UpdatedAt, or to any version
+// when it is zero; a concurrent update committed first makes it fail with
+// ErrPreconditionFailed.
 func (r *ProfileRepository) UpdateProfile(ctx context.Context, profile *models.Profile) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return repository.NewRepositoryError("update", "profile", err)
+	}
+	defer tx.Rollback(ctx)
+
+	var version *time.Time
+	if !profile.UpdatedAt.IsZero() {
+		expected := profile.UpdatedAt
+		version = &expected
+	}
+
+	snapshot := `
+		INSERT INTO profile_history (profile_id, name, title, email, phone, location,
+		                            linkedin, github, summary, updated_at)
+		SELECT id, name, title, email, phone, location, linkedin, github, summary, updated_at
+		FROM profiles
+		WHERE id = $1 AND ($2::timestamp IS NULL OR updated_at = $2)
+		FOR UPDATE`
+
+	tag, err := tx.Exec(ctx, snapshot, profile.ID, version)
+	if err != nil {
+		return repository.NewRepositoryError("update", "profile", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return missingProfileError(ctx, tx, profile.ID)
+	}
+
 	query := `
 		UPDATE profiles 
 		SET name = $2, title = $3, email = $4, phone = $5, location = $6, 
 		    linkedin = $7, github = $8, summary = $9, updated_at = CURRENT_TIMESTAMP
-		WHERE id = $1
+		WHERE id = $1 AND ($10::timestamp IS NULL OR updated_at = $10)
 		RETURNING updated_at`
 
-	err := r.db.QueryRow(ctx, query,
+	err = tx.QueryRow(ctx, query,
 		profile.ID,
 		profile.Name,
 		profile.Title,
@@ -101,14 +135,82 @@ func (r *ProfileRepository) UpdateProfile(ctx context.Context, profile *models.P
 		profile.LinkedIn,
 		profile.GitHub,
 		profile.Summary,
+		version,
 	).Scan(&profile.UpdatedAt)
 
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return repository.ErrNotFound
+			// The row matched when snapshotted, so a concurrent update won
+			return repository.ErrPreconditionFailed
 		}
 		return repository.NewRepositoryError("update", "profile", err)
 	}
 
+	if err := tx.Commit(ctx); err != nil {
+		return repository.NewRepositoryError("update", "profile", err)
+	}
+
 	return nil
 }
+
+// missingProfileError tells apart a profile that doesn't exist from one whose
+// version no longer matches, after a conditional write matched no rows
+func missingProfileError(ctx context.Context, tx pgx.Tx, id int) error {
+	var exists bool
+	if err := tx.QueryRow(ctx, `SELECT EXISTS (SELECT 1 FROM profiles WHERE id = $1)`, id).Scan(&exists); err != nil {
+		return repository.NewRepositoryError("update", "profile", err)
+	}
+	if exists {
+		return repository.ErrPreconditionFailed
+	}
+	return repository.ErrNotFound
+}
+
+// GetProfileHistory retrieves past profile versions, newest first
+func (r *ProfileRepository) GetProfileHistory(ctx context.Context) ([]*models.ProfileVersion, error) {
+	query := `
+		SELECT id, profile_id, name, title, email, phone, location, linkedin, 
+		       github, summary, updated_at, replaced_at
+		FROM profile_history
+		ORDER BY replaced_at DESC, id DESC`
+
+	rows, err := r.read.Query(ctx, query)
+	if err != nil {
+		return nil, repository.NewRepositoryError("get", "profile history", err)
+	}
+	defer rows.Close()
+
+	versions := []*models.ProfileVersion{}
+	for rows.Next() {
+		var version models.ProfileVersion
+		err := rows.Scan(
+			&version.ID,
+			&version.ProfileID,
+			&version.Name,
+			&version.Title,
+			&version.Email,
+			&version.Phone,
+			&version.Location,
+			&version.LinkedIn,
+			&version.GitHub,
+			&version.Summary,
+			&version.UpdatedAt,
+			&version.ReplacedAt,
+		)
+		if err != nil {
+			return nil, repository.NewRepositoryError("scan", "profile history", err)
+		}
+		versions = append(versions, &version)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, repository.NewRepositoryError("iterate", "profile history", err)
+	}
+
+	return versions, nil
+}
+
+// GetMaxUpdatedAt returns when the profile was last updated, or nil when there is no profile
+func (r *ProfileRepository) GetMaxUpdatedAt(ctx context.Context) (*time.Time, error) {
+	return maxUpdatedAt(ctx, r.read, "profiles", "profile")
+}
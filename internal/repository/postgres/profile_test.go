@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/npmulder/resume-api/internal/models"
+	"github.com/npmulder/resume-api/internal/repository"
 )
 
 func TestProfileRepository(t *testing.T) {
@@ -85,6 +86,7 @@ func TestProfileRepository(t *testing.T) {
 		assert.Error(t, err)
 		assert.Nil(t, profile)
 		assert.Contains(t, err.Error(), "not found")
+		assert.ErrorIs(t, err, repository.ErrNotFound)
 	})
 
 	t.Run("UpdateProfile", func(t *testing.T) {
@@ -127,6 +129,51 @@ func TestProfileRepository(t *testing.T) {
 		assert.True(t, updated.UpdatedAt.After(originalUpdatedAt))
 	})
 
+	t.Run("UpdateProfile_StaleVersion", func(t *testing.T) {
+		testDB.CleanupTables(t)
+
+		profile := &models.Profile{Name: "Bob Wilson", Title: "Backend Developer", Email: "bob.wilson@example.com"}
+		require.NoError(t, repo.CreateProfile(ctx, profile))
+
+		// Two writers read the same version
+		first, second := *profile, *profile
+		first.Title = "Senior Backend Developer"
+		second.Title = "Staff Backend Developer"
+
+		require.NoError(t, repo.UpdateProfile(ctx, &first))
+		err := repo.UpdateProfile(ctx, &second)
+		assert.ErrorIs(t, err, repository.ErrPreconditionFailed)
+
+		// The first update is kept and only it is recorded in the history
+		stored, err := repo.GetProfile(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, "Senior Backend Developer", stored.Title)
+		history, err := repo.GetProfileHistory(ctx)
+		require.NoError(t, err)
+		assert.Len(t, history, 1)
+	})
+
+	t.Run("UpdateProfile_AnyVersion", func(t *testing.T) {
+		testDB.CleanupTables(t)
+
+		profile := &models.Profile{Name: "Bob Wilson", Title: "Backend Developer", Email: "bob.wilson@example.com"}
+		require.NoError(t, repo.CreateProfile(ctx, profile))
+
+		// A zero version applies regardless of what is stored
+		update := *profile
+		update.Title = "Staff Backend Developer"
+		update.UpdatedAt = time.Time{}
+		require.NoError(t, repo.UpdateProfile(ctx, &update))
+		assert.False(t, update.UpdatedAt.IsZero())
+
+		stored, err := repo.GetProfile(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, "Staff Backend Developer", stored.Title)
+		history, err := repo.GetProfileHistory(ctx)
+		require.NoError(t, err)
+		assert.Len(t, history, 1)
+	})
+
 	t.Run("UpdateProfile_NotFound", func(t *testing.T) {
 		testDB.CleanupTables(t)
 
@@ -141,6 +188,7 @@ func TestProfileRepository(t *testing.T) {
 		err := repo.UpdateProfile(ctx, profile)
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "not found")
+		assert.ErrorIs(t, err, repository.ErrNotFound)
 	})
 
 	t.Run("CreateProfile_DuplicateEmail", func(t *testing.T) {
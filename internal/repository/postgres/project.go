@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -14,18 +15,21 @@ import (
 
 // ProjectRepository implements repository.ProjectRepository for PostgreSQL
 type ProjectRepository struct {
-	db *pgxpool.Pool
+	db   *pgxpool.Pool
+	read *pgxpool.Pool // read replica, or db when none is configured
+	opts options
 }
 
 // NewProjectRepository creates a new PostgreSQL project repository
-func NewProjectRepository(db *pgxpool.Pool) *ProjectRepository {
-	return &ProjectRepository{db: db}
+func NewProjectRepository(db *pgxpool.Pool, opts ...Option) *ProjectRepository {
+	o := newOptions(opts)
+	return &ProjectRepository{db: db, read: o.reader(db), opts: o}
 }
 
 // GetProjects retrieves all projects with optional filtering
 func (r *ProjectRepository) GetProjects(ctx context.Context, filters repository.ProjectFilters) ([]*models.Project, error) {
 	query := `
-		SELECT id, name, description, short_description, technologies, github_url, 
+		SELECT id, name, slug, description, short_description, technologies, github_url, 
 		       demo_url, start_date, end_date, status, is_featured, order_index, 
 		       key_features, created_at, updated_at
 		FROM projects`
@@ -54,11 +58,61 @@ func (r *ProjectRepository) GetProjects(ctx context.Context, filters repository.
 		argIndex++
 	}
 
+	if filters.Ongoing != nil {
+		// Mirrors models.Project.IsOngoing
+		ongoing := fmt.Sprintf("(status = '%s' AND end_date IS NULL)", models.ProjectStatusActive)
+		if *filters.Ongoing {
+			conditions = append(conditions, ongoing)
+		} else {
+			conditions = append(conditions, "NOT "+ongoing)
+		}
+	}
+
+	if filters.HasDemo != nil {
+		if *filters.HasDemo {
+			conditions = append(conditions, "demo_url IS NOT NULL")
+		} else {
+			conditions = append(conditions, "demo_url IS NULL")
+		}
+	}
+
+	if filters.HasRepo != nil {
+		if *filters.HasRepo {
+			conditions = append(conditions, "github_url IS NOT NULL")
+		} else {
+			conditions = append(conditions, "github_url IS NULL")
+		}
+	}
+
+	if filters.StartedAfter != nil {
+		conditions = append(conditions, fmt.Sprintf("start_date >= $%d", argIndex))
+		args = append(args, *filters.StartedAfter)
+		argIndex++
+	}
+
+	if filters.StartedBefore != nil {
+		conditions = append(conditions, fmt.Sprintf("start_date <= $%d", argIndex))
+		args = append(args, *filters.StartedBefore)
+		argIndex++
+	}
+
+	if filters.ActiveDuring != nil {
+		conditions = append(conditions, fmt.Sprintf("start_date <= $%d AND (end_date IS NULL OR end_date >= $%d)", argIndex, argIndex))
+		args = append(args, *filters.ActiveDuring)
+		argIndex++
+	}
+
+	if filters.UpdatedSince != nil {
+		conditions = append(conditions, fmt.Sprintf("updated_at > $%d", argIndex))
+		args = append(args, *filters.UpdatedSince)
+		argIndex++
+	}
+
 	if len(conditions) > 0 {
 		query += " WHERE " + strings.Join(conditions, " AND ")
 	}
 
-	query += " ORDER BY start_date DESC, order_index"
+	query += r.opts.orderBySort("projects", filters.Sort, "start_date DESC, order_index")
 
 	// Apply pagination
 	if filters.Limit > 0 {
@@ -72,18 +126,19 @@ func (r *ProjectRepository) GetProjects(ctx context.Context, filters repository.
 		args = append(args, filters.Offset)
 	}
 
-	rows, err := r.db.Query(ctx, query, args...)
+	rows, err := r.read.Query(ctx, query, args...)
 	if err != nil {
 		return nil, repository.NewRepositoryError("get", "projects", err)
 	}
 	defer rows.Close()
 
-	var projects []*models.Project
+	projects := []*models.Project{}
 	for rows.Next() {
 		var project models.Project
 		err := rows.Scan(
 			&project.ID,
 			&project.Name,
+			&project.Slug,
 			&project.Description,
 			&project.ShortDescription,
 			&project.Technologies,
@@ -101,6 +156,7 @@ func (r *ProjectRepository) GetProjects(ctx context.Context, filters repository.
 		if err != nil {
 			return nil, repository.NewRepositoryError("scan", "project", err)
 		}
+		normalizeProjectArrays(&project)
 		projects = append(projects, &project)
 	}
 
@@ -114,16 +170,17 @@ func (r *ProjectRepository) GetProjects(ctx context.Context, filters repository.
 // GetProjectByID retrieves a specific project by ID
 func (r *ProjectRepository) GetProjectByID(ctx context.Context, id int) (*models.Project, error) {
 	query := `
-		SELECT id, name, description, short_description, technologies, github_url, 
+		SELECT id, name, slug, description, short_description, technologies, github_url, 
 		       demo_url, start_date, end_date, status, is_featured, order_index, 
 		       key_features, created_at, updated_at
 		FROM projects 
 		WHERE id = $1`
 
 	var project models.Project
-	err := r.db.QueryRow(ctx, query, id).Scan(
+	err := r.read.QueryRow(ctx, query, id).Scan(
 		&project.ID,
 		&project.Name,
+		&project.Slug,
 		&project.Description,
 		&project.ShortDescription,
 		&project.Technologies,
@@ -141,34 +198,138 @@ func (r *ProjectRepository) GetProjectByID(ctx context.Context, id int) (*models
 
 	if err != nil {
 		if err == pgx.ErrNoRows {
-			return nil, repository.NewRepositoryError("get", "project", fmt.Errorf("project with id %d not found", id))
+			return nil, repository.NewRepositoryError("get", "project", fmt.Errorf("project with id %d %w", id, repository.ErrNotFound))
 		}
 		return nil, repository.NewRepositoryError("get", "project", err)
 	}
+	normalizeProjectArrays(&project)
 
 	return &project, nil
 }
 
-// GetFeaturedProjects retrieves only featured projects
-func (r *ProjectRepository) GetFeaturedProjects(ctx context.Context) ([]*models.Project, error) {
+// GetFeaturedProjects retrieves only featured projects, applying the remaining
+// filters such as limit and offset
+func (r *ProjectRepository) GetFeaturedProjects(ctx context.Context, filters repository.ProjectFilters) ([]*models.Project, error) {
 	featured := true
-	filters := repository.ProjectFilters{
-		Featured: &featured,
-	}
+	filters.Featured = &featured
 	return r.GetProjects(ctx, filters)
 }
 
-// CreateProject creates a new project entry
+// GetSimilarProjects retrieves up to limit other projects sharing at least one
+// technology with the project with the given id, ranked by the number of
+// technologies they share
+func (r *ProjectRepository) GetSimilarProjects(ctx context.Context, id int, limit int) ([]*models.SimilarProject, error) {
+	query := `
+		SELECT p.id, p.name, p.slug, p.description, p.short_description, p.technologies, p.github_url, 
+		       p.demo_url, p.start_date, p.end_date, p.status, p.is_featured, p.order_index, 
+		       p.key_features, p.created_at, p.updated_at, shared.count
+		FROM projects target
+		JOIN projects p ON p.id <> target.id
+		CROSS JOIN LATERAL (
+			SELECT COUNT(DISTINCT tech) AS count
+			FROM jsonb_array_elements_text(p.technologies) AS tech
+			WHERE target.technologies ? tech
+		) shared
+		WHERE target.id = $1 AND shared.count > 0
+		ORDER BY shared.count DESC, p.start_date DESC NULLS LAST, p.id
+		LIMIT $2`
+
+	rows, err := r.read.Query(ctx, query, id, limit)
+	if err != nil {
+		return nil, repository.NewRepositoryError("get", "similar projects", err)
+	}
+	defer rows.Close()
+
+	projects := []*models.SimilarProject{}
+	for rows.Next() {
+		var project models.SimilarProject
+		err := rows.Scan(
+			&project.ID,
+			&project.Name,
+			&project.Slug,
+			&project.Description,
+			&project.ShortDescription,
+			&project.Technologies,
+			&project.GitHubURL,
+			&project.DemoURL,
+			&project.StartDate,
+			&project.EndDate,
+			&project.Status,
+			&project.IsFeatured,
+			&project.OrderIndex,
+			&project.KeyFeatures,
+			&project.CreatedAt,
+			&project.UpdatedAt,
+			&project.SharedTechnologies,
+		)
+		if err != nil {
+			return nil, repository.NewRepositoryError("scan", "similar project", err)
+		}
+		normalizeProjectArrays(&project.Project)
+		projects = append(projects, &project)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, repository.NewRepositoryError("iterate", "similar projects", err)
+	}
+
+	// No matches is only an error when the project itself doesn't exist
+	if len(projects) == 0 {
+		var exists bool
+		if err := r.read.QueryRow(ctx, "SELECT EXISTS(SELECT 1 FROM projects WHERE id = $1)", id).Scan(&exists); err != nil {
+			return nil, repository.NewRepositoryError("get", "similar projects", err)
+		}
+		if !exists {
+			return nil, repository.NewRepositoryError("get", "similar projects", fmt.Errorf("project with id %d %w", id, repository.ErrNotFound))
+		}
+	}
+
+	return projects, nil
+}
+
+// uniqueSlugSQL returns the expression for the slug stored on the project
+// with id idExpr. Like the backfill in migration 007, a slug derived from the
+// name gets the id appended when another project already has it, and a name
+// with no slug-able characters becomes project-<id>. A slug set by the caller
+// is stored as given, so a clash with it is reported as a conflict.
+func uniqueSlugSQL(slug, derived, idExpr string) string {
+	return fmt.Sprintf(`CASE
+		WHEN %[1]s::text = '' THEN 'project-' || %[3]s
+		WHEN %[2]s::boolean AND EXISTS (SELECT 1 FROM projects taken WHERE taken.slug = %[1]s AND taken.id <> %[3]s)
+			THEN %[1]s::text || '-' || %[3]s
+		ELSE %[1]s END`, slug, derived, idExpr)
+}
+
+// slugConflict wraps repository.ErrConflict for a slug that is already taken
+func slugConflict(slug string) error {
+	return fmt.Errorf("slug %q is already used by another project: %w", slug, repository.ErrConflict)
+}
+
+// CreateProject creates a new project entry. It returns
+// models.ErrTooManyItems when the technologies or key features exceed the
+// configured limits, and repository.ErrConflict when the project's explicit
+// slug is already taken.
 func (r *ProjectRepository) CreateProject(ctx context.Context, project *models.Project) error {
+	if err := project.ValidateLimits(r.opts.listLimits); err != nil {
+		return repository.NewRepositoryError("create", "project", err)
+	}
+	derived := project.Slug == ""
+	project.EnsureSlug()
+
+	// The id is drawn up front so a derived slug can be made unique with it
 	query := `
-		INSERT INTO projects (name, description, short_description, technologies, 
+		WITH new_row AS (SELECT nextval(pg_get_serial_sequence('projects', 'id')) AS id)
+		INSERT INTO projects (id, name, slug, description, short_description, technologies, 
 		                     github_url, demo_url, start_date, end_date, status, 
 		                     is_featured, order_index, key_features)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
-		RETURNING id, created_at, updated_at`
+		SELECT new_row.id, $1, ` + uniqueSlugSQL("$2", "$14", "new_row.id") + `,
+		       $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13
+		FROM new_row
+		RETURNING id, slug, created_at, updated_at`
 
 	err := r.db.QueryRow(ctx, query,
 		project.Name,
+		project.Slug,
 		project.Description,
 		project.ShortDescription,
 		project.Technologies,
@@ -180,29 +341,42 @@ func (r *ProjectRepository) CreateProject(ctx context.Context, project *models.P
 		project.IsFeatured,
 		project.OrderIndex,
 		project.KeyFeatures,
-	).Scan(&project.ID, &project.CreatedAt, &project.UpdatedAt)
+		derived,
+	).Scan(&project.ID, &project.Slug, &project.CreatedAt, &project.UpdatedAt)
 
 	if err != nil {
+		if isUniqueViolation(err) {
+			return repository.NewRepositoryError("create", "project", slugConflict(project.Slug))
+		}
 		return repository.NewRepositoryError("create", "project", err)
 	}
 
 	return nil
 }
 
-// UpdateProject updates an existing project
+// UpdateProject updates an existing project, checking the same limits and
+// deriving the slug the same way as CreateProject
 func (r *ProjectRepository) UpdateProject(ctx context.Context, project *models.Project) error {
+	if err := project.ValidateLimits(r.opts.listLimits); err != nil {
+		return repository.NewRepositoryError("update", "project", err)
+	}
+	derived := project.Slug == ""
+	project.EnsureSlug()
+
 	query := `
 		UPDATE projects 
-		SET name = $2, description = $3, short_description = $4, technologies = $5, 
-		    github_url = $6, demo_url = $7, start_date = $8, end_date = $9, 
-		    status = $10, is_featured = $11, order_index = $12, key_features = $13,
+		SET name = $2, slug = ` + uniqueSlugSQL("$3", "$15", "projects.id") + `,
+		    description = $4, short_description = $5, technologies = $6, 
+		    github_url = $7, demo_url = $8, start_date = $9, end_date = $10, 
+		    status = $11, is_featured = $12, order_index = $13, key_features = $14,
 		    updated_at = CURRENT_TIMESTAMP
 		WHERE id = $1
-		RETURNING updated_at`
+		RETURNING slug, updated_at`
 
 	err := r.db.QueryRow(ctx, query,
 		project.ID,
 		project.Name,
+		project.Slug,
 		project.Description,
 		project.ShortDescription,
 		project.Technologies,
@@ -214,11 +388,15 @@ func (r *ProjectRepository) UpdateProject(ctx context.Context, project *models.P
 		project.IsFeatured,
 		project.OrderIndex,
 		project.KeyFeatures,
-	).Scan(&project.UpdatedAt)
+		derived,
+	).Scan(&project.Slug, &project.UpdatedAt)
 
 	if err != nil {
 		if err == pgx.ErrNoRows {
-			return repository.NewRepositoryError("update", "project", fmt.Errorf("project with id %d not found", project.ID))
+			return repository.NewRepositoryError("update", "project", fmt.Errorf("project with id %d %w", project.ID, repository.ErrNotFound))
+		}
+		if isUniqueViolation(err) {
+			return repository.NewRepositoryError("update", "project", slugConflict(project.Slug))
 		}
 		return repository.NewRepositoryError("update", "project", err)
 	}
@@ -226,6 +404,60 @@ func (r *ProjectRepository) UpdateProject(ctx context.Context, project *models.P
 	return nil
 }
 
+// UpsertProjectQuery inserts a project or updates the one with the same slug
+// ($2) in place. It is shared with the seed script, so seeding and
+// UpsertProject match existing projects the same way.
+const UpsertProjectQuery = `
+		INSERT INTO projects (name, slug, description, short_description, technologies, 
+		                     github_url, demo_url, start_date, end_date, status, 
+		                     is_featured, order_index, key_features)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+		ON CONFLICT (slug) DO UPDATE
+		SET name = EXCLUDED.name, description = EXCLUDED.description, 
+		    short_description = EXCLUDED.short_description, technologies = EXCLUDED.technologies, 
+		    github_url = EXCLUDED.github_url, demo_url = EXCLUDED.demo_url, 
+		    start_date = EXCLUDED.start_date, end_date = EXCLUDED.end_date, 
+		    status = EXCLUDED.status, is_featured = EXCLUDED.is_featured, 
+		    order_index = EXCLUDED.order_index, key_features = EXCLUDED.key_features,
+		    updated_at = CURRENT_TIMESTAMP
+		RETURNING id, created_at, updated_at, (xmax = 0) AS inserted`
+
+// UpsertProject inserts a project or, if a project with the same slug exists,
+// updates it in place. It reports whether a new row was inserted. A project
+// whose name yields no slug has nothing to match on, so it is always created.
+func (r *ProjectRepository) UpsertProject(ctx context.Context, project *models.Project) (bool, error) {
+	project.EnsureSlug()
+	if project.Slug == "" {
+		if err := r.CreateProject(ctx, project); err != nil {
+			return false, err
+		}
+		return true, nil
+	}
+
+	var inserted bool
+	err := r.db.QueryRow(ctx, UpsertProjectQuery,
+		project.Name,
+		project.Slug,
+		project.Description,
+		project.ShortDescription,
+		project.Technologies,
+		project.GitHubURL,
+		project.DemoURL,
+		project.StartDate,
+		project.EndDate,
+		project.Status,
+		project.IsFeatured,
+		project.OrderIndex,
+		project.KeyFeatures,
+	).Scan(&project.ID, &project.CreatedAt, &project.UpdatedAt, &inserted)
+
+	if err != nil {
+		return false, repository.NewRepositoryError("upsert", "project", err)
+	}
+
+	return inserted, nil
+}
+
 // DeleteProject deletes a project by ID
 func (r *ProjectRepository) DeleteProject(ctx context.Context, id int) error {
 	query := `DELETE FROM projects WHERE id = $1`
@@ -237,8 +469,36 @@ func (r *ProjectRepository) DeleteProject(ctx context.Context, id int) error {
 
 	rowsAffected := result.RowsAffected()
 	if rowsAffected == 0 {
-		return repository.NewRepositoryError("delete", "project", fmt.Errorf("project with id %d not found", id))
+		return repository.NewRepositoryError("delete", "project", fmt.Errorf("project with id %d %w", id, repository.ErrNotFound))
 	}
 
 	return nil
-}
\ No newline at end of file
+}
+
+// DeleteAllProjects deletes every project in a single statement, so either all
+// rows are removed or none are
+func (r *ProjectRepository) DeleteAllProjects(ctx context.Context) (int64, error) {
+	query := `DELETE FROM projects`
+
+	result, err := r.db.Exec(ctx, query)
+	if err != nil {
+		return 0, repository.NewRepositoryError("delete", "projects", err)
+	}
+
+	return result.RowsAffected(), nil
+}
+// normalizeProjectArrays replaces NULL technologies and key_features, which
+// scan as nil slices, with empty slices so they serialize as [] rather than null
+func normalizeProjectArrays(project *models.Project) {
+	if project.Technologies == nil {
+		project.Technologies = []string{}
+	}
+	if project.KeyFeatures == nil {
+		project.KeyFeatures = []string{}
+	}
+}
+
+// GetMaxUpdatedAt returns when any project was last updated, or nil when there are none
+func (r *ProjectRepository) GetMaxUpdatedAt(ctx context.Context) (*time.Time, error) {
+	return maxUpdatedAt(ctx, r.read, "projects", "projects")
+}
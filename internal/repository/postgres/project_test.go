@@ -2,6 +2,8 @@ package postgres
 
 import (
 	"context"
+	"fmt"
+	"encoding/json"
 	"testing"
 	"time"
 
@@ -74,6 +76,36 @@ func TestProjectRepository(t *testing.T) {
 		assert.True(t, project.IsOngoing())
 	})
 
+	t.Run("CreateProject_TooManyItems", func(t *testing.T) {
+		testDB.CleanupTables(t)
+
+		limited := NewProjectRepository(testDB.Pool(), WithListLimits(models.ListLimits{Technologies: 2, KeyFeatures: 1}))
+
+		err := limited.CreateProject(ctx, &models.Project{
+			Name:         "Too Many Technologies",
+			Technologies: []string{"Go", "PostgreSQL", "Redis"},
+			Status:       models.ProjectStatusActive,
+		})
+		assert.ErrorIs(t, err, models.ErrTooManyItems)
+
+		project := &models.Project{
+			Name:         "Within Limits",
+			Technologies: []string{"Go", "PostgreSQL"},
+			KeyFeatures:  []string{"Caching"},
+			Status:       models.ProjectStatusActive,
+		}
+		require.NoError(t, limited.CreateProject(ctx, project))
+
+		project.KeyFeatures = append(project.KeyFeatures, "Metrics")
+		assert.ErrorIs(t, limited.UpdateProject(ctx, project), models.ErrTooManyItems)
+
+		// Nothing over the limit was stored
+		projects, err := limited.GetProjects(ctx, repository.ProjectFilters{})
+		require.NoError(t, err)
+		require.Len(t, projects, 1)
+		assert.Equal(t, []string{"Caching"}, projects[0].KeyFeatures)
+	})
+
 	t.Run("GetProjectByID", func(t *testing.T) {
 		testDB.CleanupTables(t)
 
@@ -108,6 +140,7 @@ func TestProjectRepository(t *testing.T) {
 		assert.Error(t, err)
 		assert.Nil(t, project)
 		assert.Contains(t, err.Error(), "project with id 999 not found")
+		assert.ErrorIs(t, err, repository.ErrNotFound)
 	})
 
 	t.Run("GetProjects_All", func(t *testing.T) {
@@ -185,6 +218,121 @@ func TestProjectRepository(t *testing.T) {
 		assert.Equal(t, "Completed Project", retrieved[0].Name)
 	})
 
+	t.Run("GetProjects_FilterByOngoing", func(t *testing.T) {
+		testDB.CleanupTables(t)
+
+		endDate := time.Date(2024, 6, 30, 0, 0, 0, 0, time.UTC)
+		projects := []*models.Project{
+			{Name: "Active Without End", Status: models.ProjectStatusActive},
+			{Name: "Active With End", Status: models.ProjectStatusActive, EndDate: &endDate},
+			{Name: "Completed Project", Status: models.ProjectStatusCompleted},
+		}
+
+		for _, project := range projects {
+			err := repo.CreateProject(ctx, project)
+			require.NoError(t, err)
+		}
+
+		// Only active projects without an end date are ongoing
+		filters := repository.ProjectFilters{
+			Ongoing: boolPtr(true),
+		}
+		retrieved, err := repo.GetProjects(ctx, filters)
+		require.NoError(t, err)
+		require.Len(t, retrieved, 1)
+		assert.Equal(t, "Active Without End", retrieved[0].Name)
+		assert.True(t, retrieved[0].IsOngoing())
+
+		// The inverse returns everything else
+		filters.Ongoing = boolPtr(false)
+		retrieved, err = repo.GetProjects(ctx, filters)
+		require.NoError(t, err)
+		assert.Len(t, retrieved, 2)
+		for _, project := range retrieved {
+			assert.False(t, project.IsOngoing())
+		}
+	})
+
+	t.Run("GetProjects_FilterByLinks", func(t *testing.T) {
+		testDB.CleanupTables(t)
+
+		projects := []*models.Project{
+			{Name: "Demo And Repo", Status: models.ProjectStatusActive, DemoURL: stringPtr("https://demo.example.com"), GitHubURL: stringPtr("https://github.com/example/both")},
+			{Name: "Demo Only", Status: models.ProjectStatusActive, DemoURL: stringPtr("https://demo-only.example.com")},
+			{Name: "Repo Only", Status: models.ProjectStatusCompleted, GitHubURL: stringPtr("https://github.com/example/repo")},
+			{Name: "No Links", Status: models.ProjectStatusCompleted},
+		}
+
+		for _, project := range projects {
+			err := repo.CreateProject(ctx, project)
+			require.NoError(t, err)
+		}
+
+		names := func(filters repository.ProjectFilters) []string {
+			retrieved, err := repo.GetProjects(ctx, filters)
+			require.NoError(t, err)
+			var names []string
+			for _, project := range retrieved {
+				names = append(names, project.Name)
+			}
+			return names
+		}
+
+		assert.ElementsMatch(t, []string{"Demo And Repo", "Demo Only"}, names(repository.ProjectFilters{HasDemo: boolPtr(true)}))
+		assert.ElementsMatch(t, []string{"Repo Only", "No Links"}, names(repository.ProjectFilters{HasDemo: boolPtr(false)}))
+		assert.ElementsMatch(t, []string{"Demo And Repo", "Repo Only"}, names(repository.ProjectFilters{HasRepo: boolPtr(true)}))
+		assert.ElementsMatch(t, []string{"Demo Only", "No Links"}, names(repository.ProjectFilters{HasRepo: boolPtr(false)}))
+
+		// Both filters combine
+		assert.Equal(t, []string{"Demo And Repo"}, names(repository.ProjectFilters{HasDemo: boolPtr(true), HasRepo: boolPtr(true)}))
+	})
+
+	t.Run("GetProjects_FilterByStartDate", func(t *testing.T) {
+		testDB.CleanupTables(t)
+
+		date := func(y int, m time.Month, d int) *time.Time {
+			v := time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+			return &v
+		}
+		projects := []*models.Project{
+			{Name: "Early", Status: models.ProjectStatusCompleted, StartDate: date(2020, 1, 15), EndDate: date(2020, 12, 31)},
+			{Name: "Boundary", Status: models.ProjectStatusCompleted, StartDate: date(2022, 3, 1), EndDate: date(2023, 6, 30)},
+			{Name: "Recent", Status: models.ProjectStatusActive, StartDate: date(2024, 2, 10)},
+			{Name: "Undated", Status: models.ProjectStatusPlanned},
+		}
+		for _, project := range projects {
+			require.NoError(t, repo.CreateProject(ctx, project))
+		}
+
+		names := func(filters repository.ProjectFilters) []string {
+			retrieved, err := repo.GetProjects(ctx, filters)
+			require.NoError(t, err)
+			var result []string
+			for _, project := range retrieved {
+				result = append(result, project.Name)
+			}
+			return result
+		}
+
+		// Both bounds are inclusive
+		assert.Equal(t, []string{"Recent", "Boundary"}, names(repository.ProjectFilters{StartedAfter: stringPtr("2022-03-01")}))
+		assert.Equal(t, []string{"Boundary", "Early"}, names(repository.ProjectFilters{StartedBefore: stringPtr("2022-03-01")}))
+		assert.Equal(t, []string{"Boundary"}, names(repository.ProjectFilters{
+			StartedAfter:  stringPtr("2021-01-01"),
+			StartedBefore: stringPtr("2023-12-31"),
+		}))
+
+		// Date filters combine with the other filters
+		assert.Equal(t, []string{"Recent"}, names(repository.ProjectFilters{
+			StartedAfter: stringPtr("2020-01-01"),
+			Status:       models.ProjectStatusActive,
+		}))
+
+		// Active during covers ongoing projects and those ending on the date
+		assert.Equal(t, []string{"Boundary"}, names(repository.ProjectFilters{ActiveDuring: stringPtr("2023-06-30")}))
+		assert.Equal(t, []string{"Recent"}, names(repository.ProjectFilters{ActiveDuring: stringPtr("2025-01-01")}))
+	})
+
 	t.Run("GetProjects_FilterByTechnology", func(t *testing.T) {
 		testDB.CleanupTables(t)
 
@@ -339,6 +487,79 @@ func TestProjectRepository(t *testing.T) {
 		assert.Equal(t, "Project D", page2[1].Name) // 2021
 	})
 
+	t.Run("GetProjects_TiedSortKeysPagination", func(t *testing.T) {
+		testDB.CleanupTables(t)
+
+		// Every project shares the same start date and order index, so only
+		// the id tiebreaker decides their order
+		var ids []int
+		for i := 0; i < 4; i++ {
+			project := &models.Project{
+				Name:       "Tied " + string(rune('A'+i)),
+				StartDate:  timePtr(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+				Status:     models.ProjectStatusCompleted,
+				OrderIndex: 1,
+			}
+			require.NoError(t, repo.CreateProject(ctx, project))
+			ids = append(ids, project.ID)
+		}
+
+		var paged []int
+		for offset := 0; offset < 4; offset += 2 {
+			page, err := repo.GetProjects(ctx, repository.ProjectFilters{Limit: 2, Offset: offset})
+			require.NoError(t, err)
+			require.Len(t, page, 2)
+			for _, project := range page {
+				paged = append(paged, project.ID)
+			}
+		}
+
+		// Pages do not overlap and follow insertion order
+		assert.Equal(t, ids, paged)
+	})
+
+	t.Run("GetSimilarProjects", func(t *testing.T) {
+		testDB.CleanupTables(t)
+
+		target := &models.Project{Name: "Resume API", Technologies: []string{"Go", "PostgreSQL", "Redis", "Docker"}, Status: models.ProjectStatusActive}
+		threeShared := &models.Project{Name: "Job Board", Technologies: []string{"Go", "PostgreSQL", "Redis", "React"}, Status: models.ProjectStatusCompleted}
+		oneShared := &models.Project{Name: "Data Pipeline", Technologies: []string{"Python", "Docker"}, Status: models.ProjectStatusCompleted}
+		twoShared := &models.Project{Name: "CLI Tool", Technologies: []string{"Go", "Docker", "Cobra"}, Status: models.ProjectStatusCompleted}
+		noneShared := &models.Project{Name: "Mobile App", Technologies: []string{"Swift"}, Status: models.ProjectStatusCompleted}
+		for _, project := range []*models.Project{target, threeShared, oneShared, twoShared, noneShared} {
+			require.NoError(t, repo.CreateProject(ctx, project))
+		}
+
+		similar, err := repo.GetSimilarProjects(ctx, target.ID, 10)
+		require.NoError(t, err)
+		require.Len(t, similar, 3)
+		assert.Equal(t, threeShared.ID, similar[0].ID)
+		assert.Equal(t, 3, similar[0].SharedTechnologies)
+		assert.Equal(t, twoShared.ID, similar[1].ID)
+		assert.Equal(t, 2, similar[1].SharedTechnologies)
+		assert.Equal(t, oneShared.ID, similar[2].ID)
+		assert.Equal(t, 1, similar[2].SharedTechnologies)
+
+		limited, err := repo.GetSimilarProjects(ctx, target.ID, 1)
+		require.NoError(t, err)
+		require.Len(t, limited, 1)
+		assert.Equal(t, threeShared.ID, limited[0].ID)
+
+		// A project sharing nothing has no similar projects
+		none, err := repo.GetSimilarProjects(ctx, noneShared.ID, 10)
+		require.NoError(t, err)
+		assert.NotNil(t, none)
+		assert.Empty(t, none)
+	})
+
+	t.Run("GetSimilarProjects_NotFound", func(t *testing.T) {
+		testDB.CleanupTables(t)
+
+		similar, err := repo.GetSimilarProjects(ctx, 999, 10)
+		assert.Nil(t, similar)
+		assert.ErrorIs(t, err, repository.ErrNotFound)
+	})
+
 	t.Run("GetFeaturedProjects", func(t *testing.T) {
 		testDB.CleanupTables(t)
 
@@ -354,7 +575,7 @@ func TestProjectRepository(t *testing.T) {
 			require.NoError(t, err)
 		}
 
-		featured, err := repo.GetFeaturedProjects(ctx)
+		featured, err := repo.GetFeaturedProjects(ctx, repository.ProjectFilters{})
 		require.NoError(t, err)
 		assert.Len(t, featured, 2)
 		for _, project := range featured {
@@ -362,6 +583,25 @@ func TestProjectRepository(t *testing.T) {
 		}
 	})
 
+	t.Run("GetFeaturedProjects_Paginated", func(t *testing.T) {
+		testDB.CleanupTables(t)
+
+		// Five featured entries and two regular ones
+		for i := 0; i < 7; i++ {
+			require.NoError(t, repo.CreateProject(ctx, &models.Project{Name: fmt.Sprintf("Project %d", i), Status: models.ProjectStatusActive, IsFeatured: i < 5}))
+		}
+
+		page, err := repo.GetFeaturedProjects(ctx, repository.ProjectFilters{Limit: 2})
+		require.NoError(t, err)
+		assert.Len(t, page, 2)
+
+		lastPage, err := repo.GetFeaturedProjects(ctx, repository.ProjectFilters{Limit: 2, Offset: 4})
+		require.NoError(t, err)
+		require.Len(t, lastPage, 1)
+		assert.True(t, lastPage[0].IsFeatured)
+		assert.NotEqual(t, page[0].ID, lastPage[0].ID)
+	})
+
 	t.Run("UpdateProject", func(t *testing.T) {
 		testDB.CleanupTables(t)
 
@@ -416,6 +656,85 @@ func TestProjectRepository(t *testing.T) {
 		err := repo.UpdateProject(ctx, project)
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "project with id 999 not found")
+		assert.ErrorIs(t, err, repository.ErrNotFound)
+	})
+
+	t.Run("CreateProject_DuplicateNameGetsUniqueSlug", func(t *testing.T) {
+		testDB.CleanupTables(t)
+
+		first := &models.Project{Name: "Resume API", Status: models.ProjectStatusActive}
+		require.NoError(t, repo.CreateProject(ctx, first))
+		assert.Equal(t, "resume-api", first.Slug)
+
+		// A second project with the same derived slug gets its id appended
+		second := &models.Project{Name: "Resume  API!", Status: models.ProjectStatusActive}
+		require.NoError(t, repo.CreateProject(ctx, second))
+		assert.Equal(t, fmt.Sprintf("resume-api-%d", second.ID), second.Slug)
+
+		// Names without slug-able characters don't collide either
+		kanji := &models.Project{Name: "履歴書", Status: models.ProjectStatusActive}
+		require.NoError(t, repo.CreateProject(ctx, kanji))
+		assert.Equal(t, fmt.Sprintf("project-%d", kanji.ID), kanji.Slug)
+
+		kana := &models.Project{Name: "レジュメ", Status: models.ProjectStatusActive}
+		require.NoError(t, repo.CreateProject(ctx, kana))
+		assert.Equal(t, fmt.Sprintf("project-%d", kana.ID), kana.Slug)
+
+		// Updating keeps the project's own slug rather than suffixing it
+		first.Description = stringPtr("Updated")
+		first.Slug = ""
+		require.NoError(t, repo.UpdateProject(ctx, first))
+		assert.Equal(t, "resume-api", first.Slug)
+	})
+
+	t.Run("CreateProject_ExplicitSlugConflict", func(t *testing.T) {
+		testDB.CleanupTables(t)
+
+		require.NoError(t, repo.CreateProject(ctx, &models.Project{Name: "Resume API", Status: models.ProjectStatusActive}))
+
+		err := repo.CreateProject(ctx, &models.Project{Name: "Other", Slug: "resume-api", Status: models.ProjectStatusActive})
+		assert.ErrorIs(t, err, repository.ErrConflict)
+	})
+
+	t.Run("UpsertProject", func(t *testing.T) {
+		testDB.CleanupTables(t)
+
+		project := &models.Project{
+			Name:         "Resume API",
+			Description:  stringPtr("Original description"),
+			Technologies: []string{"Go"},
+			Status:       models.ProjectStatusActive,
+		}
+
+		inserted, err := repo.UpsertProject(ctx, project)
+		require.NoError(t, err)
+		assert.True(t, inserted)
+		assert.Equal(t, "resume-api", project.Slug)
+		originalID := project.ID
+
+		// Upsert the same project again with changed fields
+		again := &models.Project{
+			Name:         "Resume API",
+			Description:  stringPtr("Updated description"),
+			Technologies: []string{"Go", "PostgreSQL"},
+			Status:       models.ProjectStatusCompleted,
+			IsFeatured:   true,
+		}
+
+		inserted, err = repo.UpsertProject(ctx, again)
+		require.NoError(t, err)
+		assert.False(t, inserted)
+		assert.Equal(t, originalID, again.ID)
+
+		// Verify a single, updated row exists
+		projects, err := repo.GetProjects(ctx, repository.ProjectFilters{})
+		require.NoError(t, err)
+		require.Len(t, projects, 1)
+		assert.Equal(t, originalID, projects[0].ID)
+		assert.Equal(t, "Updated description", *projects[0].Description)
+		assert.Equal(t, []string{"Go", "PostgreSQL"}, projects[0].Technologies)
+		assert.Equal(t, models.ProjectStatusCompleted, projects[0].Status)
+		assert.True(t, projects[0].IsFeatured)
 	})
 
 	t.Run("DeleteProject", func(t *testing.T) {
@@ -442,6 +761,7 @@ func TestProjectRepository(t *testing.T) {
 		_, err = repo.GetProjectByID(ctx, project.ID)
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "not found")
+		assert.ErrorIs(t, err, repository.ErrNotFound)
 	})
 
 	t.Run("DeleteProject_NotFound", func(t *testing.T) {
@@ -450,6 +770,7 @@ func TestProjectRepository(t *testing.T) {
 		err := repo.DeleteProject(ctx, 999)
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "project with id 999 not found")
+		assert.ErrorIs(t, err, repository.ErrNotFound)
 	})
 
 	t.Run("ProjectStatuses_Validation", func(t *testing.T) {
@@ -517,4 +838,34 @@ func TestProjectRepository(t *testing.T) {
 		assert.Len(t, retrieved.Technologies, 8)
 		assert.Len(t, retrieved.KeyFeatures, 5)
 	})
+
+	t.Run("Project_NullArraysScanAsEmpty", func(t *testing.T) {
+		testDB.CleanupTables(t)
+
+		// Insert directly so both array columns are NULL
+		var id int
+		err := testDB.Pool().QueryRow(ctx, `
+			INSERT INTO projects (name, slug, status, technologies, key_features)
+			VALUES ('Legacy Project', 'legacy-project', 'completed', NULL, NULL)
+			RETURNING id`).Scan(&id)
+		require.NoError(t, err)
+
+		retrieved, err := repo.GetProjectByID(ctx, id)
+		require.NoError(t, err)
+		assert.NotNil(t, retrieved.Technologies)
+		assert.Empty(t, retrieved.Technologies)
+		assert.NotNil(t, retrieved.KeyFeatures)
+		assert.Empty(t, retrieved.KeyFeatures)
+
+		projects, err := repo.GetProjects(ctx, repository.ProjectFilters{})
+		require.NoError(t, err)
+		require.Len(t, projects, 1)
+		assert.NotNil(t, projects[0].Technologies)
+		assert.NotNil(t, projects[0].KeyFeatures)
+
+		data, err := json.Marshal(projects[0])
+		require.NoError(t, err)
+		assert.Contains(t, string(data), `"technologies":[]`)
+		assert.Contains(t, string(data), `"key_features":[]`)
+	})
 }
\ No newline at end of file
@@ -2,8 +2,14 @@
 package postgres
 
 import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 
+	"github.com/npmulder/resume-api/internal/models"
 	"github.com/npmulder/resume-api/internal/repository"
 )
 
@@ -15,17 +21,133 @@ type Repositories struct {
 	Achievement repository.AchievementRepository
 	Education   repository.EducationRepository
 	Project     repository.ProjectRepository
+	Search      repository.SearchRepository
+}
+
+// uniqueViolation is the PostgreSQL error code for unique constraint violations
+const uniqueViolation = "23505"
+
+// isUniqueViolation reports whether err is a unique constraint violation
+func isUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == uniqueViolation
+}
+
+// Option configures a PostgreSQL repository
+type Option func(*options)
+
+// options holds the settings shared by the repositories
+type options struct {
+	defaultSort   string
+	readPool      *pgxpool.Pool
+	categoryOrder []string
+	listLimits    models.ListLimits
+}
+
+// WithDefaultSort orders list queries by spec ("column [asc|desc]") instead of
+// the built-in order. Specs are validated against repository.SortColumns at
+// startup; an invalid one falls back to the built-in order.
+func WithDefaultSort(spec string) Option {
+	return func(o *options) {
+		o.defaultSort = spec
+	}
+}
+
+// WithReadPool sends read queries to pool, typically a read replica, while
+// writes keep using the primary pool. A nil pool is ignored.
+func WithReadPool(pool *pgxpool.Pool) Option {
+	return func(o *options) {
+		o.readPool = pool
+	}
+}
+
+// WithSkillCategoryOrder lists skill categories in the given order, followed
+// by unlisted categories alphabetically. A configured default sort takes
+// precedence.
+func WithSkillCategoryOrder(categories []string) Option {
+	return func(o *options) {
+		o.categoryOrder = categories
+	}
+}
+
+// WithListLimits rejects creating or updating entries whose list fields hold
+// more items than limits allow
+func WithListLimits(limits models.ListLimits) Option {
+	return func(o *options) {
+		o.listLimits = limits
+	}
 }
 
-// NewRepositories creates a new set of PostgreSQL repositories
-func NewRepositories(db *pgxpool.Pool) *Repositories {
+func newOptions(opts []Option) options {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+// reader returns the pool read queries use: the configured read pool, or db
+func (o options) reader(db *pgxpool.Pool) *pgxpool.Pool {
+	if o.readPool != nil {
+		return o.readPool
+	}
+	return db
+}
+
+// maxUpdatedAt returns the latest updated_at in table, or nil when the table
+// is empty
+func maxUpdatedAt(ctx context.Context, pool *pgxpool.Pool, table, entity string) (*time.Time, error) {
+	var updatedAt *time.Time
+	if err := pool.QueryRow(ctx, "SELECT MAX(updated_at) FROM "+table).Scan(&updatedAt); err != nil {
+		return nil, repository.NewRepositoryError("get", entity+" last update", err)
+	}
+	return updatedAt, nil
+}
+
+// orderBy returns the ORDER BY clause for entity, using the configured default
+// sort when set and builtin otherwise. id always breaks remaining ties.
+func (o options) orderBy(entity, builtin string) string {
+	return o.orderBySort(entity, "", builtin)
+}
+
+// orderBySort is orderBy with sort, a per-query sort spec from the filters,
+// taking precedence over both the configured default and builtin
+func (o options) orderBySort(entity, sort, builtin string) string {
+	if expr, err := repository.ParseSort(entity, sort); sort != "" && err == nil {
+		return " ORDER BY " + expr + ", id"
+	}
+	if expr, ok := o.sortOverride(entity); ok {
+		return " ORDER BY " + expr + ", id"
+	}
+	return " ORDER BY " + builtin + ", id"
+}
+
+// sortOverride returns the ORDER BY expression of a valid configured default
+// sort for entity, and false when the built-in order applies
+func (o options) sortOverride(entity string) (string, bool) {
+	if o.defaultSort == "" {
+		return "", false
+	}
+	expr, err := repository.ParseSort(entity, o.defaultSort)
+	return expr, err == nil
+}
+
+// NewRepositories creates a new set of PostgreSQL repositories.
+// maxSearchResults caps the number of rows a search returns and defaultSort
+// maps entities to the sort spec used for their list queries. opts apply to
+// every repository.
+func NewRepositories(db *pgxpool.Pool, maxSearchResults int, defaultSort map[string]string, opts ...Option) *Repositories {
+	withSort := func(entity string) []Option {
+		return append([]Option{WithDefaultSort(defaultSort[entity])}, opts...)
+	}
 	return &Repositories{
-		Profile:     NewProfileRepository(db),
-		Experience:  NewExperienceRepository(db),
-		Skill:       NewSkillRepository(db),
-		Achievement: NewAchievementRepository(db),
-		Education:   NewEducationRepository(db),
-		Project:     NewProjectRepository(db),
+		Profile:     NewProfileRepository(db, opts...),
+		Experience:  NewExperienceRepository(db, withSort("experiences")...),
+		Skill:       NewSkillRepository(db, withSort("skills")...),
+		Achievement: NewAchievementRepository(db, withSort("achievements")...),
+		Education:   NewEducationRepository(db, withSort("education")...),
+		Project:     NewProjectRepository(db, withSort("projects")...),
+		Search:      NewSearchRepository(db, maxSearchResults, opts...),
 	}
 }
 
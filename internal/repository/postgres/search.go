@@ -0,0 +1,95 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/npmulder/resume-api/internal/models"
+	"github.com/npmulder/resume-api/internal/repository"
+)
+
+// DefaultMaxSearchResults is the search result cap used when none is configured
+const DefaultMaxSearchResults = 100
+
+// searchMatches selects the entries of every section containing the $1 pattern
+const searchMatches = `
+	SELECT 'experience' AS type, id, position || ' at ' || company AS title, updated_at
+	FROM experiences
+	WHERE company ILIKE $1 OR position ILIKE $1 OR description ILIKE $1
+	UNION ALL
+	SELECT 'skill', id, name, updated_at
+	FROM skills
+	WHERE name ILIKE $1 OR category ILIKE $1
+	UNION ALL
+	SELECT 'achievement', id, title, updated_at
+	FROM achievements
+	WHERE title ILIKE $1 OR description ILIKE $1 OR impact_metric ILIKE $1
+	UNION ALL
+	SELECT 'education', id, degree_or_certification, updated_at
+	FROM education
+	WHERE institution ILIKE $1 OR degree_or_certification ILIKE $1 OR field_of_study ILIKE $1
+	UNION ALL
+	SELECT 'project', id, name, updated_at
+	FROM projects
+	WHERE name ILIKE $1 OR short_description ILIKE $1 OR description ILIKE $1`
+
+// SearchRepository implements repository.SearchRepository for PostgreSQL
+type SearchRepository struct {
+	db         *pgxpool.Pool // read replica, or the primary when none is configured
+	maxResults int
+}
+
+// NewSearchRepository creates a new PostgreSQL search repository.
+// Searches return at most maxResults rows; a non-positive value uses DefaultMaxSearchResults.
+func NewSearchRepository(db *pgxpool.Pool, maxResults int, opts ...Option) *SearchRepository {
+	if maxResults <= 0 {
+		maxResults = DefaultMaxSearchResults
+	}
+	return &SearchRepository{db: newOptions(opts).reader(db), maxResults: maxResults}
+}
+
+// Search finds entries in every section containing term, most recently updated first.
+// When the cap is hit the total number of matches is counted separately.
+func (r *SearchRepository) Search(ctx context.Context, term string) (*models.SearchResults, error) {
+	pattern := containsPattern(term)
+
+	query := `
+		SELECT type, id, title
+		FROM (` + searchMatches + `) matches
+		ORDER BY updated_at DESC, type, id
+		LIMIT $2`
+
+	rows, err := r.db.Query(ctx, query, pattern, r.maxResults)
+	if err != nil {
+		return nil, repository.NewRepositoryError("search", "resume", err)
+	}
+	defer rows.Close()
+
+	results := &models.SearchResults{Results: []*models.SearchResult{}}
+	for rows.Next() {
+		var result models.SearchResult
+		if err := rows.Scan(&result.Type, &result.ID, &result.Title); err != nil {
+			return nil, repository.NewRepositoryError("scan", "search result", err)
+		}
+		results.Results = append(results.Results, &result)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, repository.NewRepositoryError("iterate", "search results", err)
+	}
+
+	results.Total = len(results.Results)
+	if results.Total < r.maxResults {
+		return results, nil
+	}
+
+	// The cap was hit, so count every match
+	countQuery := `SELECT COUNT(*) FROM (` + searchMatches + `) matches`
+	if err := r.db.QueryRow(ctx, countQuery, pattern).Scan(&results.Total); err != nil {
+		return nil, repository.NewRepositoryError("count", "search results", err)
+	}
+	results.Truncated = results.Total > len(results.Results)
+
+	return results, nil
+}
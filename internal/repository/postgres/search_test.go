@@ -0,0 +1,96 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/npmulder/resume-api/internal/models"
+)
+
+func TestSearchRepository(t *testing.T) {
+	testDB := setupTestDB(t)
+	defer testDB.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	skillRepo := NewSkillRepository(testDB.Pool())
+	projectRepo := NewProjectRepository(testDB.Pool())
+
+	t.Run("Search_AcrossSections", func(t *testing.T) {
+		testDB.CleanupTables(t)
+
+		require.NoError(t, skillRepo.CreateSkill(ctx, &models.Skill{Category: "Languages", Name: "Go"}))
+		require.NoError(t, skillRepo.CreateSkill(ctx, &models.Skill{Category: "Languages", Name: "Python"}))
+		require.NoError(t, projectRepo.CreateProject(ctx, &models.Project{
+			Name:             "Resume API",
+			ShortDescription: stringPtr("A Go service for my resume"),
+			Status:           models.ProjectStatusActive,
+		}))
+
+		repo := NewSearchRepository(testDB.Pool(), 10)
+		results, err := repo.Search(ctx, "go")
+		require.NoError(t, err)
+
+		assert.Equal(t, 2, results.Total)
+		assert.False(t, results.Truncated)
+		require.Len(t, results.Results, 2)
+
+		types := map[string]string{}
+		for _, result := range results.Results {
+			types[result.Type] = result.Title
+		}
+		assert.Equal(t, map[string]string{"skill": "Go", "project": "Resume API"}, types)
+	})
+
+	t.Run("Search_CapsResults", func(t *testing.T) {
+		testDB.CleanupTables(t)
+
+		for i := 0; i < 8; i++ {
+			skill := &models.Skill{Category: "Frameworks", Name: fmt.Sprintf("Framework %d", i)}
+			require.NoError(t, skillRepo.CreateSkill(ctx, skill))
+		}
+
+		repo := NewSearchRepository(testDB.Pool(), 5)
+		results, err := repo.Search(ctx, "framework")
+		require.NoError(t, err)
+
+		assert.Len(t, results.Results, 5)
+		assert.Equal(t, 8, results.Total)
+		assert.True(t, results.Truncated)
+	})
+
+	t.Run("Search_ExactlyAtCap", func(t *testing.T) {
+		testDB.CleanupTables(t)
+
+		for i := 0; i < 5; i++ {
+			skill := &models.Skill{Category: "Frameworks", Name: fmt.Sprintf("Framework %d", i)}
+			require.NoError(t, skillRepo.CreateSkill(ctx, skill))
+		}
+
+		repo := NewSearchRepository(testDB.Pool(), 5)
+		results, err := repo.Search(ctx, "framework")
+		require.NoError(t, err)
+
+		assert.Len(t, results.Results, 5)
+		assert.Equal(t, 5, results.Total)
+		assert.False(t, results.Truncated)
+	})
+
+	t.Run("Search_NoMatches", func(t *testing.T) {
+		testDB.CleanupTables(t)
+
+		repo := NewSearchRepository(testDB.Pool(), 5)
+		results, err := repo.Search(ctx, "nothing")
+		require.NoError(t, err)
+
+		assert.Empty(t, results.Results)
+		assert.NotNil(t, results.Results)
+		assert.Equal(t, 0, results.Total)
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -14,12 +15,15 @@ import (
 
 // SkillRepository implements repository.SkillRepository for PostgreSQL
 type SkillRepository struct {
-	db *pgxpool.Pool
+	db   *pgxpool.Pool
+	read *pgxpool.Pool // read replica, or db when none is configured
+	opts options
 }
 
 // NewSkillRepository creates a new PostgreSQL skill repository
-func NewSkillRepository(db *pgxpool.Pool) *SkillRepository {
-	return &SkillRepository{db: db}
+func NewSkillRepository(db *pgxpool.Pool, opts ...Option) *SkillRepository {
+	o := newOptions(opts)
+	return &SkillRepository{db: db, read: o.reader(db), opts: o}
 }
 
 // GetSkills retrieves all skills with optional filtering
@@ -34,7 +38,11 @@ func (r *SkillRepository) GetSkills(ctx context.Context, filters repository.Skil
 	argIndex := 1
 
 	// Apply filters
-	if filters.Category != "" {
+	if len(filters.Categories) > 0 {
+		conditions = append(conditions, fmt.Sprintf("category = ANY($%d)", argIndex))
+		args = append(args, filters.Categories)
+		argIndex++
+	} else if filters.Category != "" {
 		conditions = append(conditions, fmt.Sprintf("category = $%d", argIndex))
 		args = append(args, filters.Category)
 		argIndex++
@@ -52,11 +60,24 @@ func (r *SkillRepository) GetSkills(ctx context.Context, filters repository.Skil
 		argIndex++
 	}
 
+	if filters.UpdatedSince != nil {
+		conditions = append(conditions, fmt.Sprintf("updated_at > $%d", argIndex))
+		args = append(args, *filters.UpdatedSince)
+		argIndex++
+	}
+
 	if len(conditions) > 0 {
 		query += " WHERE " + strings.Join(conditions, " AND ")
 	}
 
-	query += " ORDER BY category, order_index, name"
+	var categoryOrder string
+	var categoryArgs []interface{}
+	if filters.Sort == "" {
+		categoryOrder, categoryArgs = r.categoryOrder(argIndex)
+	}
+	query += r.opts.orderBySort("skills", filters.Sort, categoryOrder+"category, order_index, name")
+	args = append(args, categoryArgs...)
+	argIndex += len(categoryArgs)
 
 	// Apply pagination
 	if filters.Limit > 0 {
@@ -70,13 +91,13 @@ func (r *SkillRepository) GetSkills(ctx context.Context, filters repository.Skil
 		args = append(args, filters.Offset)
 	}
 
-	rows, err := r.db.Query(ctx, query, args...)
+	rows, err := r.read.Query(ctx, query, args...)
 	if err != nil {
 		return nil, repository.NewRepositoryError("get", "skills", err)
 	}
 	defer rows.Close()
 
-	var skills []*models.Skill
+	skills := []*models.Skill{}
 	for rows.Next() {
 		var skill models.Skill
 		err := rows.Scan(
@@ -103,6 +124,26 @@ func (r *SkillRepository) GetSkills(ctx context.Context, filters repository.Skil
 	return skills, nil
 }
 
+// categoryOrder returns a CASE expression ranking the configured categories
+// before all others, numbering its parameters from argIndex, along with its
+// arguments. Without a configured order, or when a default sort replaces the
+// built-in order, it returns an empty expression.
+func (r *SkillRepository) categoryOrder(argIndex int) (string, []interface{}) {
+	if _, overridden := r.opts.sortOverride("skills"); len(r.opts.categoryOrder) == 0 || overridden {
+		return "", nil
+	}
+
+	var expr strings.Builder
+	args := make([]interface{}, 0, len(r.opts.categoryOrder))
+	expr.WriteString("CASE category")
+	for i, category := range r.opts.categoryOrder {
+		fmt.Fprintf(&expr, " WHEN $%d THEN %d", argIndex+i, i)
+		args = append(args, category)
+	}
+	fmt.Fprintf(&expr, " ELSE %d END, ", len(r.opts.categoryOrder))
+	return expr.String(), args
+}
+
 // GetSkillsByCategory retrieves skills grouped by category
 func (r *SkillRepository) GetSkillsByCategory(ctx context.Context, category string) ([]*models.Skill, error) {
 	filters := repository.SkillFilters{
@@ -111,16 +152,80 @@ func (r *SkillRepository) GetSkillsByCategory(ctx context.Context, category stri
 	return r.GetSkills(ctx, filters)
 }
 
-// GetFeaturedSkills retrieves only featured skills
-func (r *SkillRepository) GetFeaturedSkills(ctx context.Context) ([]*models.Skill, error) {
-	featured := true
-	filters := repository.SkillFilters{
-		Featured: &featured,
+// GetSkillByName retrieves the skill with the given name, ignoring case, the
+// oldest one when several categories list it
+func (r *SkillRepository) GetSkillByName(ctx context.Context, name string) (*models.Skill, error) {
+	query := `
+		SELECT id, category, name, level, years_experience, order_index, is_featured, 
+		       created_at, updated_at
+		FROM skills
+		WHERE LOWER(name) = LOWER($1)
+		ORDER BY id
+		LIMIT 1`
+
+	var skill models.Skill
+	err := r.read.QueryRow(ctx, query, name).Scan(
+		&skill.ID,
+		&skill.Category,
+		&skill.Name,
+		&skill.Level,
+		&skill.YearsExperience,
+		&skill.OrderIndex,
+		&skill.IsFeatured,
+		&skill.CreatedAt,
+		&skill.UpdatedAt,
+	)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, repository.NewRepositoryError("get", "skill", fmt.Errorf("skill named %q %w", name, repository.ErrNotFound))
+		}
+		return nil, repository.NewRepositoryError("get", "skill", err)
 	}
+
+	return &skill, nil
+}
+
+// GetFeaturedSkills retrieves only featured skills, applying the remaining
+// filters such as limit and offset
+func (r *SkillRepository) GetFeaturedSkills(ctx context.Context, filters repository.SkillFilters) ([]*models.Skill, error) {
+	featured := true
+	filters.Featured = &featured
 	return r.GetSkills(ctx, filters)
 }
 
-// CreateSkill creates a new skill entry
+// GetSkillLevelHistogram counts skills per proficiency level, counting skills
+// without a level as unspecified
+func (r *SkillRepository) GetSkillLevelHistogram(ctx context.Context) (*models.SkillLevelHistogram, error) {
+	query := `
+		SELECT level, COUNT(*)
+		FROM skills
+		GROUP BY level`
+
+	rows, err := r.read.Query(ctx, query)
+	if err != nil {
+		return nil, repository.NewRepositoryError("get", "skill levels", err)
+	}
+	defer rows.Close()
+
+	var histogram models.SkillLevelHistogram
+	for rows.Next() {
+		var level *string
+		var count int
+		if err := rows.Scan(&level, &count); err != nil {
+			return nil, repository.NewRepositoryError("scan", "skill levels", err)
+		}
+		histogram.Add(level, count)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, repository.NewRepositoryError("iterate", "skill levels", err)
+	}
+
+	return &histogram, nil
+}
+
+// CreateSkill creates a new skill entry. It returns repository.ErrConflict
+// when the category already has a skill with the same name, ignoring case.
 func (r *SkillRepository) CreateSkill(ctx context.Context, skill *models.Skill) error {
 	query := `
 		INSERT INTO skills (category, name, level, years_experience, order_index, is_featured)
@@ -137,13 +242,18 @@ func (r *SkillRepository) CreateSkill(ctx context.Context, skill *models.Skill)
 	).Scan(&skill.ID, &skill.CreatedAt, &skill.UpdatedAt)
 
 	if err != nil {
+		if isUniqueViolation(err) {
+			return repository.NewRepositoryError("create", "skill",
+				fmt.Errorf("category %q already has a skill named %q: %w", skill.Category, skill.Name, repository.ErrConflict))
+		}
 		return repository.NewRepositoryError("create", "skill", err)
 	}
 
 	return nil
 }
 
-// UpdateSkill updates an existing skill
+// UpdateSkill updates an existing skill. Like CreateSkill, it returns
+// repository.ErrConflict when the name is already taken in the category.
 func (r *SkillRepository) UpdateSkill(ctx context.Context, skill *models.Skill) error {
 	query := `
 		UPDATE skills 
@@ -164,7 +274,11 @@ func (r *SkillRepository) UpdateSkill(ctx context.Context, skill *models.Skill)
 
 	if err != nil {
 		if err == pgx.ErrNoRows {
-			return repository.NewRepositoryError("update", "skill", fmt.Errorf("skill with id %d not found", skill.ID))
+			return repository.NewRepositoryError("update", "skill", fmt.Errorf("skill with id %d %w", skill.ID, repository.ErrNotFound))
+		}
+		if isUniqueViolation(err) {
+			return repository.NewRepositoryError("update", "skill",
+				fmt.Errorf("category %q already has a skill named %q: %w", skill.Category, skill.Name, repository.ErrConflict))
 		}
 		return repository.NewRepositoryError("update", "skill", err)
 	}
@@ -183,8 +297,31 @@ func (r *SkillRepository) DeleteSkill(ctx context.Context, id int) error {
 
 	rowsAffected := result.RowsAffected()
 	if rowsAffected == 0 {
-		return repository.NewRepositoryError("delete", "skill", fmt.Errorf("skill with id %d not found", id))
+		return repository.NewRepositoryError("delete", "skill", fmt.Errorf("skill with id %d %w", id, repository.ErrNotFound))
 	}
 
 	return nil
-}
\ No newline at end of file
+}
+
+// RenameSkillCategory moves every skill in category from to category to in
+// a single statement. It returns repository.ErrConflict when to already holds
+// a skill with the same name as one being moved.
+func (r *SkillRepository) RenameSkillCategory(ctx context.Context, from, to string) (int64, error) {
+	query := `UPDATE skills SET category = $2 WHERE category = $1`
+
+	result, err := r.db.Exec(ctx, query, from, to)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return 0, repository.NewRepositoryError("update", "skill category",
+				fmt.Errorf("category %q already has a skill with the same name: %w", to, repository.ErrConflict))
+		}
+		return 0, repository.NewRepositoryError("update", "skill category", err)
+	}
+
+	return result.RowsAffected(), nil
+}
+
+// GetMaxUpdatedAt returns when any skill was last updated, or nil when there are none
+func (r *SkillRepository) GetMaxUpdatedAt(ctx context.Context) (*time.Time, error) {
+	return maxUpdatedAt(ctx, r.read, "skills", "skills")
+}
@@ -2,6 +2,7 @@ package postgres
 
 import (
 	"context"
+	"fmt"
 	"testing"
 	"time"
 
@@ -39,6 +40,18 @@ func TestSkillRepository(t *testing.T) {
 		assert.NotZero(t, skill.UpdatedAt)
 	})
 
+	t.Run("CreateSkill_CaseInsensitiveDuplicate", func(t *testing.T) {
+		testDB.CleanupTables(t)
+
+		require.NoError(t, repo.CreateSkill(ctx, &models.Skill{Category: "Programming Languages", Name: "Go"}))
+
+		err := repo.CreateSkill(ctx, &models.Skill{Category: "Programming Languages", Name: "go"})
+		assert.ErrorIs(t, err, repository.ErrConflict)
+
+		// The same name is still allowed in another category
+		require.NoError(t, repo.CreateSkill(ctx, &models.Skill{Category: "Tools", Name: "go"}))
+	})
+
 	t.Run("GetSkills_All", func(t *testing.T) {
 		testDB.CleanupTables(t)
 
@@ -130,6 +143,31 @@ func TestSkillRepository(t *testing.T) {
 		}
 	})
 
+	t.Run("GetSkills_FilterByCategories", func(t *testing.T) {
+		testDB.CleanupTables(t)
+
+		for _, skill := range []*models.Skill{
+			{Category: "Programming Languages", Name: "Go"},
+			{Category: "Databases", Name: "PostgreSQL"},
+			{Category: "Frameworks", Name: "Gin"},
+			{Category: "Cloud Platforms", Name: "AWS"},
+		} {
+			require.NoError(t, repo.CreateSkill(ctx, skill))
+		}
+
+		retrieved, err := repo.GetSkills(ctx, repository.SkillFilters{
+			Categories: []string{"Programming Languages", "Databases"},
+		})
+		require.NoError(t, err)
+
+		var names []string
+		for _, skill := range retrieved {
+			assert.Contains(t, []string{"Programming Languages", "Databases"}, skill.Category)
+			names = append(names, skill.Name)
+		}
+		assert.ElementsMatch(t, []string{"Go", "PostgreSQL"}, names)
+	})
+
 	t.Run("GetSkills_FilterByLevel", func(t *testing.T) {
 		testDB.CleanupTables(t)
 
@@ -248,6 +286,35 @@ func TestSkillRepository(t *testing.T) {
 		assert.Equal(t, "Language D", page2[1].Name)
 	})
 
+	t.Run("GetSkills_ConfiguredDefaultSort", func(t *testing.T) {
+		testDB.CleanupTables(t)
+
+		skills := []*models.Skill{
+			{Category: "Languages", Name: "Go", YearsExperience: intPtr(5), OrderIndex: 1},
+			{Category: "Languages", Name: "Rust", YearsExperience: intPtr(2), OrderIndex: 2},
+			{Category: "Tools", Name: "Docker", YearsExperience: intPtr(7), OrderIndex: 1},
+		}
+		for _, skill := range skills {
+			require.NoError(t, repo.CreateSkill(ctx, skill))
+		}
+
+		sorted := NewSkillRepository(testDB.Pool(), WithDefaultSort("years_experience desc"))
+		retrieved, err := sorted.GetSkills(ctx, repository.SkillFilters{})
+		require.NoError(t, err)
+		require.Len(t, retrieved, 3)
+		assert.Equal(t, "Docker", retrieved[0].Name)
+		assert.Equal(t, "Go", retrieved[1].Name)
+		assert.Equal(t, "Rust", retrieved[2].Name)
+
+		// Without a configured sort the built-in category order applies
+		retrieved, err = repo.GetSkills(ctx, repository.SkillFilters{})
+		require.NoError(t, err)
+		require.Len(t, retrieved, 3)
+		assert.Equal(t, "Go", retrieved[0].Name)
+		assert.Equal(t, "Rust", retrieved[1].Name)
+		assert.Equal(t, "Docker", retrieved[2].Name)
+	})
+
 	t.Run("GetSkillsByCategory", func(t *testing.T) {
 		testDB.CleanupTables(t)
 
@@ -280,6 +347,90 @@ func TestSkillRepository(t *testing.T) {
 		}
 	})
 
+	t.Run("GetSkills_NoMatches", func(t *testing.T) {
+		testDB.CleanupTables(t)
+
+		require.NoError(t, repo.CreateSkill(ctx, &models.Skill{Category: "Programming", Name: "Go"}))
+
+		skills, err := repo.GetSkills(ctx, repository.SkillFilters{Category: "Nonexistent"})
+		require.NoError(t, err)
+		assert.NotNil(t, skills)
+		assert.Empty(t, skills)
+	})
+
+	t.Run("GetSkillByName", func(t *testing.T) {
+		testDB.CleanupTables(t)
+
+		skill := &models.Skill{Category: "Programming Languages", Name: "Go", Level: stringPtr(models.SkillLevelExpert)}
+		require.NoError(t, repo.CreateSkill(ctx, skill))
+		require.NoError(t, repo.CreateSkill(ctx, &models.Skill{Category: "Tools", Name: "go"}))
+
+		// The lookup ignores case and prefers the oldest skill
+		found, err := repo.GetSkillByName(ctx, "GO")
+		require.NoError(t, err)
+		assert.Equal(t, skill.ID, found.ID)
+		assert.Equal(t, "Go", found.Name)
+		assert.Equal(t, models.SkillLevelExpert, *found.Level)
+	})
+
+	t.Run("GetSkillByName_NotFound", func(t *testing.T) {
+		testDB.CleanupTables(t)
+
+		_, err := repo.GetSkillByName(ctx, "COBOL")
+		assert.ErrorIs(t, err, repository.ErrNotFound)
+	})
+
+	t.Run("GetSkillByName_LinksProjects", func(t *testing.T) {
+		testDB.CleanupTables(t)
+
+		projectRepo := NewProjectRepository(testDB.Pool())
+		require.NoError(t, repo.CreateSkill(ctx, &models.Skill{Category: "Programming Languages", Name: "Go"}))
+		for _, project := range []*models.Project{
+			{Name: "Resume API", Technologies: []string{"Go", "PostgreSQL"}, Status: models.ProjectStatusActive},
+			{Name: "Job Board", Technologies: []string{"React", "Go"}, Status: models.ProjectStatusCompleted},
+			{Name: "Content Analyzer", Technologies: []string{"Python"}, Status: models.ProjectStatusPlanned},
+		} {
+			require.NoError(t, projectRepo.CreateProject(ctx, project))
+		}
+
+		// The skill's stored name selects the projects that use it
+		skill, err := repo.GetSkillByName(ctx, "go")
+		require.NoError(t, err)
+		projects, err := projectRepo.GetProjects(ctx, repository.ProjectFilters{Technology: skill.Name})
+		require.NoError(t, err)
+
+		var names []string
+		for _, project := range projects {
+			names = append(names, project.Name)
+		}
+		assert.ElementsMatch(t, []string{"Resume API", "Job Board"}, names)
+	})
+
+	t.Run("GetSkillLevelHistogram", func(t *testing.T) {
+		testDB.CleanupTables(t)
+
+		skills := []*models.Skill{
+			{Category: "Programming", Name: "Go", Level: stringPtr(models.SkillLevelExpert)},
+			{Category: "Programming", Name: "Python", Level: stringPtr(models.SkillLevelAdvanced)},
+			{Category: "Programming", Name: "Rust", Level: stringPtr(models.SkillLevelBeginner)},
+			{Category: "Database", Name: "PostgreSQL", Level: stringPtr(models.SkillLevelExpert)},
+			{Category: "Cloud", Name: "AWS"},
+			{Category: "Cloud", Name: "GCP"},
+		}
+		for _, skill := range skills {
+			require.NoError(t, repo.CreateSkill(ctx, skill))
+		}
+
+		histogram, err := repo.GetSkillLevelHistogram(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, &models.SkillLevelHistogram{
+			Beginner:    1,
+			Advanced:    1,
+			Expert:      2,
+			Unspecified: 2,
+		}, histogram)
+	})
+
 	t.Run("GetFeaturedSkills", func(t *testing.T) {
 		testDB.CleanupTables(t)
 
@@ -295,7 +446,7 @@ func TestSkillRepository(t *testing.T) {
 			require.NoError(t, err)
 		}
 
-		featured, err := repo.GetFeaturedSkills(ctx)
+		featured, err := repo.GetFeaturedSkills(ctx, repository.SkillFilters{})
 		require.NoError(t, err)
 		assert.Len(t, featured, 2)
 		for _, skill := range featured {
@@ -303,6 +454,25 @@ func TestSkillRepository(t *testing.T) {
 		}
 	})
 
+	t.Run("GetFeaturedSkills_Paginated", func(t *testing.T) {
+		testDB.CleanupTables(t)
+
+		// Five featured entries and two regular ones
+		for i := 0; i < 7; i++ {
+			require.NoError(t, repo.CreateSkill(ctx, &models.Skill{Category: "Programming", Name: fmt.Sprintf("Skill %d", i), IsFeatured: i < 5}))
+		}
+
+		page, err := repo.GetFeaturedSkills(ctx, repository.SkillFilters{Limit: 2})
+		require.NoError(t, err)
+		assert.Len(t, page, 2)
+
+		lastPage, err := repo.GetFeaturedSkills(ctx, repository.SkillFilters{Limit: 2, Offset: 4})
+		require.NoError(t, err)
+		require.Len(t, lastPage, 1)
+		assert.True(t, lastPage[0].IsFeatured)
+		assert.NotEqual(t, page[0].ID, lastPage[0].ID)
+	})
+
 	t.Run("UpdateSkill", func(t *testing.T) {
 		testDB.CleanupTables(t)
 
@@ -354,6 +524,7 @@ func TestSkillRepository(t *testing.T) {
 		err := repo.UpdateSkill(ctx, skill)
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "skill with id 999 not found")
+		assert.ErrorIs(t, err, repository.ErrNotFound)
 	})
 
 	t.Run("DeleteSkill", func(t *testing.T) {
@@ -389,6 +560,88 @@ func TestSkillRepository(t *testing.T) {
 		err := repo.DeleteSkill(ctx, 999)
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "skill with id 999 not found")
+		assert.ErrorIs(t, err, repository.ErrNotFound)
+	})
+
+	t.Run("RenameSkillCategory", func(t *testing.T) {
+		testDB.CleanupTables(t)
+
+		for _, skill := range []*models.Skill{
+			{Category: "Programming Languages", Name: "Go"},
+			{Category: "Programming Languages", Name: "Python"},
+			{Category: "Tools", Name: "Docker"},
+		} {
+			require.NoError(t, repo.CreateSkill(ctx, skill))
+		}
+
+		renamed, err := repo.RenameSkillCategory(ctx, "Programming Languages", "Languages")
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), renamed)
+
+		languages, err := repo.GetSkillsByCategory(ctx, "Languages")
+		require.NoError(t, err)
+		assert.Len(t, languages, 2)
+
+		old, err := repo.GetSkillsByCategory(ctx, "Programming Languages")
+		require.NoError(t, err)
+		assert.Empty(t, old)
+
+		// Other categories are untouched
+		tools, err := repo.GetSkillsByCategory(ctx, "Tools")
+		require.NoError(t, err)
+		assert.Len(t, tools, 1)
+
+		// Renaming a category that doesn't exist changes nothing
+		renamed, err = repo.RenameSkillCategory(ctx, "Frameworks", "Libraries")
+		require.NoError(t, err)
+		assert.Zero(t, renamed)
+	})
+
+	t.Run("RenameSkillCategory_Conflict", func(t *testing.T) {
+		testDB.CleanupTables(t)
+
+		require.NoError(t, repo.CreateSkill(ctx, &models.Skill{Category: "Programming Languages", Name: "Go"}))
+		require.NoError(t, repo.CreateSkill(ctx, &models.Skill{Category: "Languages", Name: "Go"}))
+
+		_, err := repo.RenameSkillCategory(ctx, "Programming Languages", "Languages")
+		assert.ErrorIs(t, err, repository.ErrConflict)
+
+		// The failed rename leaves both categories as they were
+		old, err := repo.GetSkillsByCategory(ctx, "Programming Languages")
+		require.NoError(t, err)
+		assert.Len(t, old, 1)
+	})
+
+	t.Run("GetSkills_CategoryOrder", func(t *testing.T) {
+		testDB.CleanupTables(t)
+
+		for _, skill := range []*models.Skill{
+			{Category: "Cloud Platforms", Name: "AWS"},
+			{Category: "Databases", Name: "PostgreSQL"},
+			{Category: "Frameworks", Name: "Gin"},
+			{Category: "Languages", Name: "Python", OrderIndex: 2},
+			{Category: "Languages", Name: "Go", OrderIndex: 1},
+			{Category: "Build Tools", Name: "Make"},
+		} {
+			require.NoError(t, repo.CreateSkill(ctx, skill))
+		}
+
+		curated := NewSkillRepository(testDB.Pool(), WithSkillCategoryOrder([]string{"Languages", "Frameworks", "Testing"}))
+		skills, err := curated.GetSkills(ctx, repository.SkillFilters{Limit: 10})
+		require.NoError(t, err)
+
+		var names []string
+		for _, skill := range skills {
+			names = append(names, skill.Name)
+		}
+		// Listed categories first, in the configured order, then the rest alphabetically
+		assert.Equal(t, []string{"Go", "Python", "Gin", "Make", "AWS", "PostgreSQL"}, names)
+
+		// Filters still bind to the right parameters
+		frameworks, err := curated.GetSkills(ctx, repository.SkillFilters{Category: "Frameworks", Limit: 5})
+		require.NoError(t, err)
+		require.Len(t, frameworks, 1)
+		assert.Equal(t, "Gin", frameworks[0].Name)
 	})
 
 	t.Run("SkillLevels_Validation", func(t *testing.T) {
@@ -72,6 +72,9 @@ func (tdb *TestDB) CleanupTables(t *testing.T) {
 
 	// Clean tables in correct order due to potential foreign keys
 	tables := []string{
+		"contact_messages",
+		"webhook_failures",
+		"profile_history",
 		"projects",
 		"education", 
 		"achievements",
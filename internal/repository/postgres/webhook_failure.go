@@ -0,0 +1,124 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/npmulder/resume-api/internal/models"
+	"github.com/npmulder/resume-api/internal/repository"
+)
+
+// WebhookFailureRepository implements repository.WebhookFailureRepository for PostgreSQL.
+// Failures are read from the primary so a replay always sees a just-recorded row.
+type WebhookFailureRepository struct {
+	db *pgxpool.Pool
+}
+
+// NewWebhookFailureRepository creates a new PostgreSQL webhook failure repository
+func NewWebhookFailureRepository(db *pgxpool.Pool) *WebhookFailureRepository {
+	return &WebhookFailureRepository{db: db}
+}
+
+// RecordFailure stores a failed delivery
+func (r *WebhookFailureRepository) RecordFailure(ctx context.Context, failure *models.WebhookFailure) error {
+	query := `
+		INSERT INTO webhook_failures (url, payload, error, attempts)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id, failed_at`
+
+	err := r.db.QueryRow(ctx, query,
+		failure.URL,
+		string(failure.Payload),
+		failure.Error,
+		failure.Attempts,
+	).Scan(&failure.ID, &failure.FailedAt)
+
+	if err != nil {
+		return repository.NewRepositoryError("create", "webhook failure", err)
+	}
+
+	return nil
+}
+
+// GetFailures lists the failed deliveries, most recent first
+func (r *WebhookFailureRepository) GetFailures(ctx context.Context) ([]*models.WebhookFailure, error) {
+	query := `
+		SELECT id, url, payload, error, attempts, failed_at
+		FROM webhook_failures
+		ORDER BY failed_at DESC, id DESC`
+
+	rows, err := r.db.Query(ctx, query)
+	if err != nil {
+		return nil, repository.NewRepositoryError("get", "webhook failures", err)
+	}
+	defer rows.Close()
+
+	failures := []*models.WebhookFailure{}
+	for rows.Next() {
+		var failure models.WebhookFailure
+		err := rows.Scan(
+			&failure.ID,
+			&failure.URL,
+			&failure.Payload,
+			&failure.Error,
+			&failure.Attempts,
+			&failure.FailedAt,
+		)
+		if err != nil {
+			return nil, repository.NewRepositoryError("scan", "webhook failure", err)
+		}
+		failures = append(failures, &failure)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, repository.NewRepositoryError("iterate", "webhook failures", err)
+	}
+
+	return failures, nil
+}
+
+// GetFailureByID retrieves a failed delivery by ID
+func (r *WebhookFailureRepository) GetFailureByID(ctx context.Context, id int) (*models.WebhookFailure, error) {
+	query := `
+		SELECT id, url, payload, error, attempts, failed_at
+		FROM webhook_failures
+		WHERE id = $1`
+
+	var failure models.WebhookFailure
+	err := r.db.QueryRow(ctx, query, id).Scan(
+		&failure.ID,
+		&failure.URL,
+		&failure.Payload,
+		&failure.Error,
+		&failure.Attempts,
+		&failure.FailedAt,
+	)
+
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, repository.NewRepositoryError("get", "webhook failure", fmt.Errorf("webhook failure with id %d %w", id, repository.ErrNotFound))
+		}
+		return nil, repository.NewRepositoryError("get", "webhook failure", err)
+	}
+
+	return &failure, nil
+}
+
+// DeleteFailure removes a failed delivery
+func (r *WebhookFailureRepository) DeleteFailure(ctx context.Context, id int) error {
+	query := `DELETE FROM webhook_failures WHERE id = $1`
+
+	result, err := r.db.Exec(ctx, query, id)
+	if err != nil {
+		return repository.NewRepositoryError("delete", "webhook failure", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return repository.NewRepositoryError("delete", "webhook failure", fmt.Errorf("webhook failure with id %d %w", id, repository.ErrNotFound))
+	}
+
+	return nil
+}
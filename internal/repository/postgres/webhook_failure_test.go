@@ -0,0 +1,83 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/npmulder/resume-api/internal/models"
+	"github.com/npmulder/resume-api/internal/repository"
+)
+
+func TestWebhookFailureRepository(t *testing.T) {
+	testDB := setupTestDB(t)
+	defer testDB.Close()
+
+	repo := NewWebhookFailureRepository(testDB.Pool())
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	newFailure := func() *models.WebhookFailure {
+		return &models.WebhookFailure{
+			URL:      "https://hooks.example.com/resume",
+			Payload:  json.RawMessage(`{"entity":"profile","action":"updated","id":1}`),
+			Error:    "unexpected status 500",
+			Attempts: 3,
+		}
+	}
+
+	t.Run("RecordFailure", func(t *testing.T) {
+		testDB.CleanupTables(t)
+
+		failure := newFailure()
+		require.NoError(t, repo.RecordFailure(ctx, failure))
+		assert.NotZero(t, failure.ID)
+		assert.NotZero(t, failure.FailedAt)
+
+		retrieved, err := repo.GetFailureByID(ctx, failure.ID)
+		require.NoError(t, err)
+		assert.Equal(t, failure.URL, retrieved.URL)
+		assert.JSONEq(t, string(failure.Payload), string(retrieved.Payload))
+		assert.Equal(t, failure.Error, retrieved.Error)
+		assert.Equal(t, 3, retrieved.Attempts)
+	})
+
+	t.Run("GetFailures_MostRecentFirst", func(t *testing.T) {
+		testDB.CleanupTables(t)
+
+		first, second := newFailure(), newFailure()
+		require.NoError(t, repo.RecordFailure(ctx, first))
+		require.NoError(t, repo.RecordFailure(ctx, second))
+
+		failures, err := repo.GetFailures(ctx)
+		require.NoError(t, err)
+		require.Len(t, failures, 2)
+		assert.Equal(t, second.ID, failures[0].ID)
+		assert.Equal(t, first.ID, failures[1].ID)
+	})
+
+	t.Run("GetFailureByID_NotFound", func(t *testing.T) {
+		testDB.CleanupTables(t)
+
+		_, err := repo.GetFailureByID(ctx, 99999)
+		assert.ErrorIs(t, err, repository.ErrNotFound)
+	})
+
+	t.Run("DeleteFailure", func(t *testing.T) {
+		testDB.CleanupTables(t)
+
+		failure := newFailure()
+		require.NoError(t, repo.RecordFailure(ctx, failure))
+		require.NoError(t, repo.DeleteFailure(ctx, failure.ID))
+
+		_, err := repo.GetFailureByID(ctx, failure.ID)
+		assert.ErrorIs(t, err, repository.ErrNotFound)
+
+		err = repo.DeleteFailure(ctx, failure.ID)
+		assert.ErrorIs(t, err, repository.ErrNotFound)
+	})
+}
@@ -0,0 +1,45 @@
+package repository
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// SortColumns lists, per entity, the columns list queries may be ordered by
+var SortColumns = map[string][]string{
+	"experiences":  {"company", "position", "start_date", "end_date", "order_index", "created_at", "updated_at"},
+	"skills":       {"category", "name", "level", "years_experience", "order_index", "created_at", "updated_at"},
+	"achievements": {"title", "category", "year_achieved", "order_index", "created_at", "updated_at"},
+	"education":    {"institution", "type", "status", "year_started", "year_completed", "order_index", "created_at", "updated_at"},
+	"projects":     {"name", "status", "start_date", "end_date", "order_index", "created_at", "updated_at"},
+}
+
+// ParseSort validates a "column dir" sort spec for entity and returns it as an
+// ORDER BY expression. The direction is optional and defaults to ascending.
+func ParseSort(entity, spec string) (string, error) {
+	columns, ok := SortColumns[entity]
+	if !ok {
+		return "", fmt.Errorf("unknown sort entity %q", entity)
+	}
+
+	fields := strings.Fields(spec)
+	if len(fields) == 0 || len(fields) > 2 {
+		return "", fmt.Errorf("invalid sort %q for %s (want \"column [asc|desc]\")", spec, entity)
+	}
+
+	column := strings.ToLower(fields[0])
+	if !slices.Contains(columns, column) {
+		return "", fmt.Errorf("invalid sort column %q for %s (must be one of: %s)", column, entity, strings.Join(columns, ", "))
+	}
+
+	direction := "ASC"
+	if len(fields) == 2 {
+		direction = strings.ToUpper(fields[1])
+		if direction != "ASC" && direction != "DESC" {
+			return "", fmt.Errorf("invalid sort direction %q for %s (must be asc or desc)", fields[1], entity)
+		}
+	}
+
+	return column + " " + direction, nil
+}
@@ -0,0 +1,86 @@
+package seed
+
+// Data is a resume seed or import payload
+type Data struct {
+	Profile      Profile       `json:"profile"`
+	Experiences  []Experience  `json:"experiences"`
+	Skills       []Skill       `json:"skills"`
+	Achievements []Achievement `json:"achievements"`
+	Education    []Education   `json:"education"`
+	Projects     []Project     `json:"projects"`
+}
+
+// Profile is the resume owner in a Data payload
+type Profile struct {
+	Name     string `json:"name"`
+	Title    string `json:"title"`
+	Email    string `json:"email"`
+	Phone    string `json:"phone"`
+	Location string `json:"location"`
+	LinkedIn string `json:"linkedin"`
+	GitHub   string `json:"github"`
+	Summary  string `json:"summary"`
+}
+
+// Experience is a position held in a Data payload
+type Experience struct {
+	Company     string   `json:"company"`
+	Position    string   `json:"position"`
+	StartDate   string   `json:"start_date"`
+	EndDate     *string  `json:"end_date"`
+	Description string   `json:"description"`
+	Highlights  []string `json:"highlights"`
+	Order       int      `json:"order"`
+}
+
+// Skill is a skill in a Data payload
+type Skill struct {
+	Category string `json:"category"`
+	Name     string `json:"name"`
+	Level    string `json:"level"`
+	Order    int    `json:"order"`
+	Featured bool   `json:"featured"`
+}
+
+// Achievement is an achievement in a Data payload
+type Achievement struct {
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	Category    string `json:"category"`
+	Impact      string `json:"impact"`
+	Year        int    `json:"year"`
+	Order       int    `json:"order"`
+	Featured    bool   `json:"featured"`
+}
+
+// Education is a degree or certification in a Data payload
+type Education struct {
+	Institution   string `json:"institution"`
+	Degree        string `json:"degree"`
+	Field         string `json:"field"`
+	YearCompleted *int   `json:"year_completed"`
+	YearStarted   *int   `json:"year_started"`
+	Description   string `json:"description"`
+	Type          string `json:"type"`
+	Status        string `json:"status"`
+	CredentialID  string `json:"credential_id"`
+	CredentialURL string `json:"credential_url"`
+	Order         int    `json:"order"`
+	Featured      bool   `json:"featured"`
+}
+
+// Project is a project in a Data payload
+type Project struct {
+	Name             string   `json:"name"`
+	Description      string   `json:"description"`
+	ShortDescription string   `json:"short_description"`
+	Technologies     []string `json:"technologies"`
+	GitHubURL        string   `json:"github_url"`
+	DemoURL          *string  `json:"demo_url"`
+	StartDate        string   `json:"start_date"`
+	EndDate          *string  `json:"end_date"`
+	Status           string   `json:"status"`
+	IsFeatured       bool     `json:"is_featured"`
+	Order            int      `json:"order"`
+	KeyFeatures      []string `json:"key_features"`
+}
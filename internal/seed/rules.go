@@ -0,0 +1,105 @@
+package seed
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/npmulder/resume-api/internal/models"
+)
+
+// CheckRules checks the rules the JSON schema can't express: real calendar
+// dates, date and year ranges that don't run backwards, known achievement
+// categories, and project names that produce non-empty, unique slugs. It returns one
+// message per problem, or nil when the data is valid.
+func CheckRules(data *Data) []string {
+	var problems []string
+
+	for i, exp := range data.Experiences {
+		label := fmt.Sprintf("experiences[%d] (%s)", i, exp.Company)
+		problems = append(problems, checkDateRange(label, exp.StartDate, exp.EndDate)...)
+	}
+
+	for i, edu := range data.Education {
+		if edu.YearStarted != nil && edu.YearCompleted != nil && *edu.YearCompleted < *edu.YearStarted {
+			problems = append(problems, fmt.Sprintf("education[%d] (%s): year_completed %d is before year_started %d",
+				i, edu.Institution, *edu.YearCompleted, *edu.YearStarted))
+		}
+	}
+
+	for i, achievement := range data.Achievements {
+		if err := models.ValidateAchievementCategory(achievement.Category); err != nil {
+			problems = append(problems, fmt.Sprintf("achievements[%d] (%s): %v", i, achievement.Title, err))
+		}
+	}
+
+	slugs := make(map[string]string)
+	for i, project := range data.Projects {
+		label := fmt.Sprintf("projects[%d] (%s)", i, project.Name)
+		if project.StartDate != "" {
+			problems = append(problems, checkDateRange(label, project.StartDate, project.EndDate)...)
+		}
+
+		slug := models.Slugify(project.Name)
+		if slug == "" {
+			problems = append(problems, fmt.Sprintf("%s: name has no letters or digits to build a slug from", label))
+			continue
+		}
+		if other, ok := slugs[slug]; ok {
+			problems = append(problems, fmt.Sprintf("%s: slug %q is already used by %s", label, slug, other))
+		}
+		slugs[slug] = project.Name
+	}
+
+	return problems
+}
+
+// Validate runs the schema and the business rules against a raw payload,
+// the same checks an import runs before writing anything. It returns a
+// *ValidationError listing every problem found by both.
+func Validate(data []byte) error {
+	var problems []string
+
+	err := ValidateSeedData(data)
+	var validationErr *ValidationError
+	switch {
+	case errors.As(err, &validationErr):
+		problems = append(problems, validationErr.Problems...)
+	case err != nil:
+		return err
+	}
+
+	// The rules need a typed payload; when the structure is too broken to
+	// decode, the schema problems already explain why
+	var decoded Data
+	if err := json.Unmarshal(data, &decoded); err == nil {
+		problems = append(problems, CheckRules(&decoded)...)
+	}
+
+	if len(problems) > 0 {
+		return &ValidationError{Problems: problems}
+	}
+	return nil
+}
+
+// checkDateRange checks that start (and end, when set) are valid dates and
+// that end is not before start
+func checkDateRange(label, start string, end *string) []string {
+	startDate, err := time.Parse("2006-01-02", start)
+	if err != nil {
+		return []string{fmt.Sprintf("%s: invalid start_date %q", label, start)}
+	}
+	if end == nil {
+		return nil
+	}
+
+	endDate, err := time.Parse("2006-01-02", *end)
+	if err != nil {
+		return []string{fmt.Sprintf("%s: invalid end_date %q", label, *end)}
+	}
+	if endDate.Before(startDate) {
+		return []string{fmt.Sprintf("%s: end_date %s is before start_date %s", label, *end, start)}
+	}
+	return nil
+}
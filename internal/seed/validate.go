@@ -0,0 +1,95 @@
+// Package seed provides validation for resume seed and import payloads
+package seed
+
+import (
+	"bytes"
+	_ "embed"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/santhosh-tekuri/jsonschema/v6"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+)
+
+//go:embed schema.json
+var schemaJSON []byte
+
+// schemaURL identifies the embedded schema within the compiler
+const schemaURL = "seed-data.schema.json"
+
+// schema is the compiled seed data schema
+var schema = mustCompileSchema()
+
+// printer renders schema error messages
+var printer = message.NewPrinter(language.English)
+
+// ValidationError lists every structural problem found in a seed payload
+type ValidationError struct {
+	Problems []string
+}
+
+// Error implements the error interface
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("seed data is invalid:\n  %s", strings.Join(e.Problems, "\n  "))
+}
+
+// ValidateSeedData validates a raw seed payload against the embedded JSON schema.
+// It returns a *ValidationError listing all problems when the payload is
+// structurally invalid, so callers can reject it before doing any database work.
+func ValidateSeedData(data []byte) error {
+	instance, err := jsonschema.UnmarshalJSON(bytes.NewReader(data))
+	if err != nil {
+		return &ValidationError{Problems: []string{fmt.Sprintf("invalid JSON: %v", err)}}
+	}
+
+	err = schema.Validate(instance)
+	if err == nil {
+		return nil
+	}
+
+	var schemaErr *jsonschema.ValidationError
+	if !errors.As(err, &schemaErr) {
+		return fmt.Errorf("failed to validate seed data: %w", err)
+	}
+
+	return &ValidationError{Problems: collectProblems(schemaErr)}
+}
+
+// collectProblems flattens a schema validation error into one message per
+// failing leaf, so wrapper errors from $ref and anyOf don't hide the cause
+func collectProblems(err *jsonschema.ValidationError) []string {
+	var problems []string
+	var walk func(e *jsonschema.ValidationError)
+	walk = func(e *jsonschema.ValidationError) {
+		if len(e.Causes) == 0 {
+			location := "/" + strings.Join(e.InstanceLocation, "/")
+			problems = append(problems, fmt.Sprintf("%s: %s", location, e.ErrorKind.LocalizedString(printer)))
+			return
+		}
+		for _, cause := range e.Causes {
+			walk(cause)
+		}
+	}
+	walk(err)
+
+	sort.Strings(problems)
+	return problems
+}
+
+// mustCompileSchema compiles the embedded schema and panics if it is invalid
+func mustCompileSchema() *jsonschema.Schema {
+	doc, err := jsonschema.UnmarshalJSON(bytes.NewReader(schemaJSON))
+	if err != nil {
+		panic(fmt.Sprintf("failed to parse seed data schema: %v", err))
+	}
+
+	compiler := jsonschema.NewCompiler()
+	if err := compiler.AddResource(schemaURL, doc); err != nil {
+		panic(fmt.Sprintf("failed to load seed data schema: %v", err))
+	}
+
+	return compiler.MustCompile(schemaURL)
+}
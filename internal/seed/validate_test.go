@@ -0,0 +1,77 @@
+package seed
+
+import (
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateSeedData(t *testing.T) {
+	t.Run("example seed data is valid", func(t *testing.T) {
+		data, err := os.ReadFile("../../scripts/seed-data.example.json")
+		require.NoError(t, err)
+
+		assert.NoError(t, ValidateSeedData(data))
+	})
+
+	t.Run("minimal payload is valid", func(t *testing.T) {
+		data := []byte(`{"profile": {"name": "Jane Doe", "title": "Engineer", "email": "jane@example.com"}}`)
+
+		assert.NoError(t, ValidateSeedData(data))
+	})
+
+	t.Run("lists all structural problems", func(t *testing.T) {
+		data := []byte(`{
+			"profile": {"name": "Jane Doe", "email": "not-an-email"},
+			"experiences": [{"company": "Acme", "position": "Engineer", "start_date": "January 2020"}],
+			"skills": [{"category": "Languages", "name": "Go", "level": "guru"}],
+			"projects": [{"name": "API", "technologies": "Go"}]
+		}`)
+
+		err := ValidateSeedData(data)
+		require.Error(t, err)
+
+		var validationErr *ValidationError
+		require.True(t, errors.As(err, &validationErr))
+		assert.Len(t, validationErr.Problems, 5)
+		assert.Contains(t, err.Error(), "/profile")
+		assert.Contains(t, err.Error(), "/experiences/0/start_date")
+		assert.Contains(t, err.Error(), "/skills/0/level")
+		assert.Contains(t, err.Error(), "/projects/0/technologies")
+	})
+
+	t.Run("malformed JSON", func(t *testing.T) {
+		err := ValidateSeedData([]byte(`{"profile": `))
+
+		var validationErr *ValidationError
+		require.True(t, errors.As(err, &validationErr))
+		assert.Contains(t, validationErr.Problems[0], "invalid JSON")
+	})
+}
+
+func TestValidate(t *testing.T) {
+	t.Run("example seed data is valid", func(t *testing.T) {
+		data, err := os.ReadFile("../../scripts/seed-data.example.json")
+		require.NoError(t, err)
+
+		assert.NoError(t, Validate(data))
+	})
+
+	t.Run("combines schema and rule problems", func(t *testing.T) {
+		data := []byte(`{
+			"profile": {"name": "Jane Doe", "title": "Engineer", "email": "not-an-email"},
+			"education": [{"institution": "University", "degree": "BSc", "type": "education", "year_started": 2016, "year_completed": 2012}]
+		}`)
+
+		err := Validate(data)
+
+		var validationErr *ValidationError
+		require.True(t, errors.As(err, &validationErr))
+		assert.Len(t, validationErr.Problems, 2)
+		assert.Contains(t, err.Error(), "/profile/email")
+		assert.Contains(t, err.Error(), "education[0] (University): year_completed 2012 is before year_started 2016")
+	})
+}
@@ -3,37 +3,162 @@ package services
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 
+	"golang.org/x/sync/singleflight"
+
 	"github.com/npmulder/resume-api/internal/cache"
+	cachemetrics "github.com/npmulder/resume-api/internal/metrics/cache"
 	"github.com/npmulder/resume-api/internal/models"
 	"github.com/npmulder/resume-api/internal/repository"
 )
 
+// Cache keys for the profile and its cached not-found state
+const (
+	profileCacheKey         = "profile"
+	profileNotFoundCacheKey = "profile:notfound"
+)
+
+// cacheKeyPrefixes maps each cached entity to the prefix shared by its cache keys
+var cacheKeyPrefixes = map[string]string{
+	"profile":      profileCacheKey,
+	"experiences":  "experiences:",
+	"skills":       "skills:",
+	"achievements": "achievements:",
+	"education":    "education:",
+	"projects":     "projects:",
+	"recent":       "recent:",
+	"stats":        "stats",
+	"meta":         "meta",
+	"completeness": "completeness",
+	"resume":       "resume",
+}
+
+// CacheKeyPrefix returns the cache key prefix for an entity and whether the entity is known
+func CacheKeyPrefix(entity string) (string, bool) {
+	prefix, ok := cacheKeyPrefixes[entity]
+	return prefix, ok
+}
+
+// CacheEntities returns the names of the cached entities in sorted order
+func CacheEntities() []string {
+	entities := make([]string, 0, len(cacheKeyPrefixes))
+	for entity := range cacheKeyPrefixes {
+		entities = append(entities, entity)
+	}
+	sort.Strings(entities)
+	return entities
+}
+
 // CachedResumeService is a decorator for ResumeService that adds caching
 type CachedResumeService struct {
-	service ResumeService
-	cache   cache.Cache
-	ttl     time.Duration
+	service     ResumeService
+	cache       cache.Cache
+	ttl         time.Duration
+	negativeTTL time.Duration
+	group       singleflight.Group
+	// trackLookups is false for the no-op cache, whose lookups always miss
+	trackLookups bool
 }
 
-// NewCachedResumeService creates a new cached resume service
-func NewCachedResumeService(service ResumeService, cache cache.Cache, ttl time.Duration) ResumeService {
+// NewCachedResumeService creates a new cached resume service.
+// negativeTTL controls how long a missing profile is cached; zero disables it.
+func NewCachedResumeService(service ResumeService, c cache.Cache, ttl, negativeTTL time.Duration) ResumeService {
+	_, noop := c.(*cache.NoOpCache)
 	return &CachedResumeService{
-		service: service,
-		cache:   cache,
-		ttl:     ttl,
+		service:      service,
+		cache:        c,
+		ttl:          ttl,
+		negativeTTL:  negativeTTL,
+		trackLookups: !noop,
+	}
+}
+
+// trackLookup records whether a cache lookup hit, skipping the no-op cache so
+// a disabled cache doesn't report a 0% hit ratio
+func (s *CachedResumeService) trackLookup(ctx context.Context, err error) {
+	if s.trackLookups {
+		cachemetrics.TrackLookup(ctx, err == nil)
+	}
+}
+
+// intValue formats an optional int for use in a cache key
+func intValue(p *int) string {
+	if p == nil {
+		return ""
+	}
+	return strconv.Itoa(*p)
+}
+
+// stringValue formats an optional string for use in a cache key
+func stringValue(p *string) string {
+	if p == nil {
+		return ""
+	}
+	return *p
+}
+
+// boolValue formats an optional bool for use in a cache key
+func boolValue(p *bool) string {
+	if p == nil {
+		return ""
+	}
+	return strconv.FormatBool(*p)
+}
+
+// sharedLoadTimeout bounds a fetch shared by coalesced cache misses, which
+// outlives the request of the caller that started it
+const sharedLoadTimeout = 30 * time.Second
+
+// loadShared runs fetch for a cache miss, coalescing concurrent misses on the
+// same cache key into a single call. The result is stored in the cache with
+// the given TTL before it is shared with the waiting callers. fetch runs with
+// a context detached from the first caller's cancellation, so one caller
+// giving up doesn't fail the others; each caller stops waiting when its own
+// ctx is done.
+func loadShared[T any](ctx context.Context, s *CachedResumeService, cacheKey string, ttl time.Duration, fetch func(context.Context) (T, error)) (T, error) {
+	ch := s.group.DoChan(cacheKey, func() (interface{}, error) {
+		fetchCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), sharedLoadTimeout)
+		defer cancel()
+
+		result, err := fetch(fetchCtx)
+		if err != nil {
+			return nil, err
+		}
+
+		// Store in cache for future requests
+		if err := s.cache.Set(fetchCtx, cacheKey, result, ttl); err != nil {
+			// Log the error but don't fail the request
+			fmt.Printf("Failed to cache %s: %v\n", cacheKey, err)
+		}
+
+		return result, nil
+	})
+
+	var zero T
+	select {
+	case <-ctx.Done():
+		return zero, ctx.Err()
+	case res := <-ch:
+		if res.Err != nil {
+			return zero, res.Err
+		}
+		return res.Val.(T), nil
 	}
 }
 
 // GetProfile retrieves the user's profile, with caching
 func (s *CachedResumeService) GetProfile(ctx context.Context) (*models.Profile, error) {
-	cacheKey := "profile"
 	var profile models.Profile
 
 	// Try to get from cache first
-	err := s.cache.Get(ctx, cacheKey, &profile)
+	err := s.cache.Get(ctx, profileCacheKey, &profile)
+	s.trackLookup(ctx, err)
 	if err == nil {
 		return &profile, nil
 	}
@@ -44,31 +169,91 @@ func (s *CachedResumeService) GetProfile(ctx context.Context) (*models.Profile,
 		fmt.Printf("Cache error for profile: %v\n", err)
 	}
 
-	// Get from service
-	result, err := s.service.GetProfile(ctx)
-	if err != nil {
-		return nil, err
+	// A recently missing profile is served from the cache as not found
+	if s.negativeTTL > 0 {
+		var notFound bool
+		if err := s.cache.Get(ctx, profileNotFoundCacheKey, &notFound); err == nil && notFound {
+			return nil, repository.ErrNotFound
+		}
+	}
+
+	// Get from service, sharing the call with concurrent misses
+	return loadShared(ctx, s, profileCacheKey, s.ttl, func(ctx context.Context) (*models.Profile, error) {
+		result, err := s.service.GetProfile(ctx)
+		if errors.Is(err, repository.ErrNotFound) && s.negativeTTL > 0 {
+			if err := s.cache.Set(ctx, profileNotFoundCacheKey, true, s.negativeTTL); err != nil {
+				fmt.Printf("Failed to cache missing profile: %v\n", err)
+			}
+		}
+		return result, err
+	})
+}
+
+// CreateProfile creates the user's profile and clears any cached not-found state
+func (s *CachedResumeService) CreateProfile(ctx context.Context, profile *models.Profile) error {
+	if err := s.service.CreateProfile(ctx, profile); err != nil {
+		return err
+	}
+
+	if err := s.cache.Delete(ctx, profileNotFoundCacheKey); err != nil {
+		fmt.Printf("Failed to invalidate missing profile cache: %v\n", err)
+	}
+
+	return nil
+}
+
+// UpdateProfile updates the user's profile and invalidates the cached entries
+// that include it. An update rejected because the profile changed drops the
+// cached profile too, as it may be the stale version the update was based on.
+func (s *CachedResumeService) UpdateProfile(ctx context.Context, profile *models.Profile) error {
+	if err := s.service.UpdateProfile(ctx, profile); err != nil {
+		if errors.Is(err, repository.ErrPreconditionFailed) {
+			if err := s.cache.Delete(ctx, profileCacheKey); err != nil {
+				fmt.Printf("Failed to invalidate profile cache: %v\n", err)
+			}
+		}
+		return err
 	}
 
-	// Store in cache for future requests
-	if err := s.cache.Set(ctx, cacheKey, result, s.ttl); err != nil {
-		// Log the error but don't fail the request
-		fmt.Printf("Failed to cache profile: %v\n", err)
+	for _, entity := range []string{"profile", "resume", "recent", "meta", "completeness"} {
+		if _, err := s.cache.DeletePrefix(ctx, cacheKeyPrefixes[entity]); err != nil {
+			fmt.Printf("Failed to invalidate %s cache: %v\n", entity, err)
+		}
 	}
 
-	return result, nil
+	return nil
+}
+
+// GetProfileHistory retrieves past profile versions. The history is an audit
+// trail read by administrators, so it is always read from the service.
+func (s *CachedResumeService) GetProfileHistory(ctx context.Context) ([]*models.ProfileVersion, error) {
+	return s.service.GetProfileHistory(ctx)
+}
+
+// GetProfileDiff compares two past states of the profile. Like the history
+// it is built from, it is always read from the service.
+func (s *CachedResumeService) GetProfileDiff(ctx context.Context, from, to time.Time) (*models.ProfileDiff, error) {
+	return s.service.GetProfileDiff(ctx, from, to)
+}
+
+// GetChangesSince retrieves the entries updated after since. Every sync asks
+// for a different timestamp, so the result is always read from the service.
+func (s *CachedResumeService) GetChangesSince(ctx context.Context, since time.Time) (*models.ResumeChanges, error) {
+	return s.service.GetChangesSince(ctx, since)
 }
 
 // GetExperiences retrieves work experiences with optional filtering, with caching
 func (s *CachedResumeService) GetExperiences(ctx context.Context, filters repository.ExperienceFilters) ([]*models.Experience, error) {
 	// Create a cache key based on the filters
-	cacheKey := fmt.Sprintf("experiences:%v:%v:%v:%v:%v",
-		filters.Company, filters.Position, filters.IsCurrent, filters.Limit, filters.Offset)
+	cacheKey := fmt.Sprintf("experiences:%v:%v:%v:%v:%v:%v:%v:%v",
+		filters.Company, filters.Position, stringValue(filters.DateFrom), stringValue(filters.DateTo),
+		boolValue(filters.IsCurrent), filters.HighlightContains, filters.Limit, filters.Offset)
 
 	var experiences []*models.Experience
 
 	// Try to get from cache first
 	err := s.cache.Get(ctx, cacheKey, &experiences)
+	s.trackLookup(ctx, err)
 	if err == nil {
 		return experiences, nil
 	}
@@ -78,30 +263,159 @@ func (s *CachedResumeService) GetExperiences(ctx context.Context, filters reposi
 		fmt.Printf("Cache error for experiences: %v\n", err)
 	}
 
-	// Get from service
-	experiences, err = s.service.GetExperiences(ctx, filters)
-	if err != nil {
-		return nil, err
+	// Get from service, sharing the call with concurrent misses
+	return loadShared(ctx, s, cacheKey, s.ttl, func(ctx context.Context) ([]*models.Experience, error) {
+		return s.service.GetExperiences(ctx, filters)
+	})
+}
+
+// GetTenureSummary retrieves the work experience tenure summary, with caching
+func (s *CachedResumeService) GetTenureSummary(ctx context.Context) (*models.TenureSummary, error) {
+	cacheKey := "experiences:tenure"
+	var summary models.TenureSummary
+
+	// Try to get from cache first
+	err := s.cache.Get(ctx, cacheKey, &summary)
+	s.trackLookup(ctx, err)
+	if err == nil {
+		return &summary, nil
+	}
+
+	// If not in cache or error, get from service
+	if err != cache.ErrCacheMiss {
+		fmt.Printf("Cache error for tenure summary: %v\n", err)
+	}
+
+	// Get from service, sharing the call with concurrent misses
+	return loadShared(ctx, s, cacheKey, s.ttl, func(ctx context.Context) (*models.TenureSummary, error) {
+		return s.service.GetTenureSummary(ctx)
+	})
+}
+
+// statsTTL caps how long the headline stats are cached. They combine several
+// sections, so writes to any of them only show up once the entry expires.
+const statsTTL = time.Minute
+
+// GetStats retrieves the headline stats, with brief caching
+func (s *CachedResumeService) GetStats(ctx context.Context) (*models.Stats, error) {
+	cacheKey := "stats"
+	var stats models.Stats
+
+	// Try to get from cache first
+	err := s.cache.Get(ctx, cacheKey, &stats)
+	s.trackLookup(ctx, err)
+	if err == nil {
+		return &stats, nil
+	}
+
+	// If not in cache or error, get from service
+	if err != cache.ErrCacheMiss {
+		fmt.Printf("Cache error for stats: %v\n", err)
+	}
+
+	// Get from service, sharing the call with concurrent misses
+	ttl := s.ttl
+	if ttl == 0 || ttl > statsTTL {
+		ttl = statsTTL
+	}
+	return loadShared(ctx, s, cacheKey, ttl, func(ctx context.Context) (*models.Stats, error) {
+		return s.service.GetStats(ctx)
+	})
+}
+
+// metaTTL caps how long the section timestamps are cached. Clients poll them
+// to decide whether to refetch, so they must catch up with writes quickly.
+const metaTTL = 5 * time.Second
+
+// GetMeta retrieves when each section was last updated, with very brief caching
+func (s *CachedResumeService) GetMeta(ctx context.Context) (*models.Meta, error) {
+	cacheKey := "meta"
+	var meta models.Meta
+
+	// Try to get from cache first
+	err := s.cache.Get(ctx, cacheKey, &meta)
+	s.trackLookup(ctx, err)
+	if err == nil {
+		return &meta, nil
+	}
+
+	// If not in cache or error, get from service
+	if err != cache.ErrCacheMiss {
+		fmt.Printf("Cache error for meta: %v\n", err)
+	}
+
+	// Get from service, sharing the call with concurrent misses
+	ttl := s.ttl
+	if ttl == 0 || ttl > metaTTL {
+		ttl = metaTTL
+	}
+	return loadShared(ctx, s, cacheKey, ttl, func(ctx context.Context) (*models.Meta, error) {
+		return s.service.GetMeta(ctx)
+	})
+}
+
+// GetCompleteness retrieves the completeness score, cached as briefly as the
+// headline stats since it also spans every section
+func (s *CachedResumeService) GetCompleteness(ctx context.Context) (*models.Completeness, error) {
+	cacheKey := "completeness"
+	var completeness models.Completeness
+
+	// Try to get from cache first
+	err := s.cache.Get(ctx, cacheKey, &completeness)
+	s.trackLookup(ctx, err)
+	if err == nil {
+		return &completeness, nil
+	}
+
+	// If not in cache or error, get from service
+	if err != cache.ErrCacheMiss {
+		fmt.Printf("Cache error for completeness: %v\n", err)
+	}
+
+	// Get from service, sharing the call with concurrent misses
+	ttl := s.ttl
+	if ttl == 0 || ttl > statsTTL {
+		ttl = statsTTL
+	}
+	return loadShared(ctx, s, cacheKey, ttl, func(ctx context.Context) (*models.Completeness, error) {
+		return s.service.GetCompleteness(ctx)
+	})
+}
+
+// GetSkillLevels counts skills per proficiency level, with caching
+func (s *CachedResumeService) GetSkillLevels(ctx context.Context) (*models.SkillLevelHistogram, error) {
+	cacheKey := "skills:levels"
+	var histogram models.SkillLevelHistogram
+
+	// Try to get from cache first
+	err := s.cache.Get(ctx, cacheKey, &histogram)
+	s.trackLookup(ctx, err)
+	if err == nil {
+		return &histogram, nil
 	}
 
-	// Store in cache for future requests
-	if err := s.cache.Set(ctx, cacheKey, experiences, s.ttl); err != nil {
-		fmt.Printf("Failed to cache experiences: %v\n", err)
+	// If not in cache or error, get from service
+	if err != cache.ErrCacheMiss {
+		fmt.Printf("Cache error for skill levels: %v\n", err)
 	}
 
-	return experiences, nil
+	// Get from service, sharing the call with concurrent misses
+	return loadShared(ctx, s, cacheKey, s.ttl, func(ctx context.Context) (*models.SkillLevelHistogram, error) {
+		return s.service.GetSkillLevels(ctx)
+	})
 }
 
 // GetSkills retrieves skills with optional filtering, with caching
 func (s *CachedResumeService) GetSkills(ctx context.Context, filters repository.SkillFilters) ([]*models.Skill, error) {
 	// Create a cache key based on the filters
-	cacheKey := fmt.Sprintf("skills:%v:%v:%v:%v",
-		filters.Category, filters.Featured, filters.Limit, filters.Offset)
+	cacheKey := fmt.Sprintf("skills:%v:%v:%v:%v:%v:%v:%v",
+		filters.Category, filters.Categories, filters.Level, boolValue(filters.Featured), filters.Fallback, filters.Limit, filters.Offset)
 
 	var skills []*models.Skill
 
 	// Try to get from cache first
 	err := s.cache.Get(ctx, cacheKey, &skills)
+	s.trackLookup(ctx, err)
 	if err == nil {
 		return skills, nil
 	}
@@ -111,30 +425,23 @@ func (s *CachedResumeService) GetSkills(ctx context.Context, filters repository.
 		fmt.Printf("Cache error for skills: %v\n", err)
 	}
 
-	// Get from service
-	skills, err = s.service.GetSkills(ctx, filters)
-	if err != nil {
-		return nil, err
-	}
-
-	// Store in cache for future requests
-	if err := s.cache.Set(ctx, cacheKey, skills, s.ttl); err != nil {
-		fmt.Printf("Failed to cache skills: %v\n", err)
-	}
-
-	return skills, nil
+	// Get from service, sharing the call with concurrent misses
+	return loadShared(ctx, s, cacheKey, s.ttl, func(ctx context.Context) ([]*models.Skill, error) {
+		return s.service.GetSkills(ctx, filters)
+	})
 }
 
 // GetAchievements retrieves achievements with optional filtering, with caching
 func (s *CachedResumeService) GetAchievements(ctx context.Context, filters repository.AchievementFilters) ([]*models.Achievement, error) {
 	// Create a cache key based on the filters
-	cacheKey := fmt.Sprintf("achievements:%v:%v:%v:%v:%v",
-		filters.Year, filters.Category, filters.Featured, filters.Limit, filters.Offset)
+	cacheKey := fmt.Sprintf("achievements:%v:%v:%v:%v:%v:%v:%v:%v",
+		intValue(filters.Year), intValue(filters.YearFrom), intValue(filters.YearTo), filters.Category, boolValue(filters.Featured), filters.Fallback, filters.Limit, filters.Offset)
 
 	var achievements []*models.Achievement
 
 	// Try to get from cache first
 	err := s.cache.Get(ctx, cacheKey, &achievements)
+	s.trackLookup(ctx, err)
 	if err == nil {
 		return achievements, nil
 	}
@@ -144,30 +451,23 @@ func (s *CachedResumeService) GetAchievements(ctx context.Context, filters repos
 		fmt.Printf("Cache error for achievements: %v\n", err)
 	}
 
-	// Get from service
-	achievements, err = s.service.GetAchievements(ctx, filters)
-	if err != nil {
-		return nil, err
-	}
-
-	// Store in cache for future requests
-	if err := s.cache.Set(ctx, cacheKey, achievements, s.ttl); err != nil {
-		fmt.Printf("Failed to cache achievements: %v\n", err)
-	}
-
-	return achievements, nil
+	// Get from service, sharing the call with concurrent misses
+	return loadShared(ctx, s, cacheKey, s.ttl, func(ctx context.Context) ([]*models.Achievement, error) {
+		return s.service.GetAchievements(ctx, filters)
+	})
 }
 
 // GetEducation retrieves education entries with optional filtering, with caching
 func (s *CachedResumeService) GetEducation(ctx context.Context, filters repository.EducationFilters) ([]*models.Education, error) {
 	// Create a cache key based on the filters
-	cacheKey := fmt.Sprintf("education:%v:%v:%v:%v",
-		filters.Type, filters.Status, filters.Limit, filters.Offset)
+	cacheKey := fmt.Sprintf("education:%v:%v:%v:%v:%v:%v:%v",
+		filters.Type, filters.Institution, filters.Status, boolValue(filters.Featured), filters.Fallback, filters.Limit, filters.Offset)
 
 	var education []*models.Education
 
 	// Try to get from cache first
 	err := s.cache.Get(ctx, cacheKey, &education)
+	s.trackLookup(ctx, err)
 	if err == nil {
 		return education, nil
 	}
@@ -177,30 +477,73 @@ func (s *CachedResumeService) GetEducation(ctx context.Context, filters reposito
 		fmt.Printf("Cache error for education: %v\n", err)
 	}
 
-	// Get from service
-	education, err = s.service.GetEducation(ctx, filters)
-	if err != nil {
-		return nil, err
+	// Get from service, sharing the call with concurrent misses
+	return loadShared(ctx, s, cacheKey, s.ttl, func(ctx context.Context) ([]*models.Education, error) {
+		return s.service.GetEducation(ctx, filters)
+	})
+}
+
+// GetInstitutions lists the distinct institutions with their number of entries, with caching
+func (s *CachedResumeService) GetInstitutions(ctx context.Context) ([]*models.InstitutionCount, error) {
+	cacheKey := "education:institutions"
+	var institutions []*models.InstitutionCount
+
+	// Try to get from cache first
+	err := s.cache.Get(ctx, cacheKey, &institutions)
+	s.trackLookup(ctx, err)
+	if err == nil {
+		return institutions, nil
 	}
 
-	// Store in cache for future requests
-	if err := s.cache.Set(ctx, cacheKey, education, s.ttl); err != nil {
-		fmt.Printf("Failed to cache education: %v\n", err)
+	// If not in cache or error, get from service
+	if err != cache.ErrCacheMiss {
+		fmt.Printf("Cache error for institutions: %v\n", err)
 	}
 
-	return education, nil
+	// Get from service, sharing the call with concurrent misses
+	return loadShared(ctx, s, cacheKey, s.ttl, func(ctx context.Context) ([]*models.InstitutionCount, error) {
+		return s.service.GetInstitutions(ctx)
+	})
+}
+
+// GetEducationByCredentialID retrieves the certification with the given
+// credential ID, with caching
+func (s *CachedResumeService) GetEducationByCredentialID(ctx context.Context, credentialID string) (*models.Education, error) {
+	cacheKey := "education:credential:" + credentialID
+	var education models.Education
+
+	// Try to get from cache first
+	err := s.cache.Get(ctx, cacheKey, &education)
+	s.trackLookup(ctx, err)
+	if err == nil {
+		return &education, nil
+	}
+
+	// If not in cache or error, get from service
+	if err != cache.ErrCacheMiss {
+		fmt.Printf("Cache error for credential: %v\n", err)
+	}
+
+	// Get from service, sharing the call with concurrent misses
+	return loadShared(ctx, s, cacheKey, s.ttl, func(ctx context.Context) (*models.Education, error) {
+		return s.service.GetEducationByCredentialID(ctx, credentialID)
+	})
 }
 
 // GetProjects retrieves projects with optional filtering, with caching
 func (s *CachedResumeService) GetProjects(ctx context.Context, filters repository.ProjectFilters) ([]*models.Project, error) {
 	// Create a cache key based on the filters
-	cacheKey := fmt.Sprintf("projects:%v:%v:%v:%v:%v",
-		filters.Status, filters.Technology, filters.Featured, filters.Limit, filters.Offset)
+	cacheKey := fmt.Sprintf("projects:%v:%v:%v:%v:%v:%v:%v:%v:%v:%v:%v:%v",
+		filters.Status, filters.Technology, boolValue(filters.Featured), boolValue(filters.Ongoing),
+		boolValue(filters.HasDemo), boolValue(filters.HasRepo),
+		stringValue(filters.StartedAfter), stringValue(filters.StartedBefore), stringValue(filters.ActiveDuring),
+		filters.Fallback, filters.Limit, filters.Offset)
 
 	var projects []*models.Project
 
 	// Try to get from cache first
 	err := s.cache.Get(ctx, cacheKey, &projects)
+	s.trackLookup(ctx, err)
 	if err == nil {
 		return projects, nil
 	}
@@ -210,16 +553,151 @@ func (s *CachedResumeService) GetProjects(ctx context.Context, filters repositor
 		fmt.Printf("Cache error for projects: %v\n", err)
 	}
 
-	// Get from service
-	projects, err = s.service.GetProjects(ctx, filters)
-	if err != nil {
-		return nil, err
+	// Get from service, sharing the call with concurrent misses
+	return loadShared(ctx, s, cacheKey, s.ttl, func(ctx context.Context) ([]*models.Project, error) {
+		return s.service.GetProjects(ctx, filters)
+	})
+}
+
+// Search finds entries containing term. Search terms are too varied to cache
+// usefully, so this passes straight through to the service.
+func (s *CachedResumeService) Search(ctx context.Context, term string) (*models.SearchResults, error) {
+	return s.service.Search(ctx, term)
+}
+
+// GetSimilarProjects retrieves the projects sharing the most technologies with
+// a project, with caching
+func (s *CachedResumeService) GetSimilarProjects(ctx context.Context, id int, limit int) ([]*models.SimilarProject, error) {
+	cacheKey := fmt.Sprintf("projects:similar:%d:%d", id, limit)
+	var projects []*models.SimilarProject
+
+	// Try to get from cache first
+	err := s.cache.Get(ctx, cacheKey, &projects)
+	s.trackLookup(ctx, err)
+	if err == nil {
+		return projects, nil
+	}
+
+	// If not in cache or error, get from service
+	if err != cache.ErrCacheMiss {
+		fmt.Printf("Cache error for similar projects: %v\n", err)
+	}
+
+	// Get from service, sharing the call with concurrent misses
+	return loadShared(ctx, s, cacheKey, s.ttl, func(ctx context.Context) ([]*models.SimilarProject, error) {
+		return s.service.GetSimilarProjects(ctx, id, limit)
+	})
+}
+
+// GetSkillProjects retrieves the projects using a skill, with caching. The
+// entries share the project prefix, so project writes invalidate them.
+func (s *CachedResumeService) GetSkillProjects(ctx context.Context, name string) ([]*models.Project, error) {
+	cacheKey := "projects:skill:" + strings.ToLower(name)
+	var projects []*models.Project
+
+	// Try to get from cache first
+	err := s.cache.Get(ctx, cacheKey, &projects)
+	s.trackLookup(ctx, err)
+	if err == nil {
+		return projects, nil
+	}
+
+	// If not in cache or error, get from service
+	if err != cache.ErrCacheMiss {
+		fmt.Printf("Cache error for skill projects: %v\n", err)
+	}
+
+	// Get from service, sharing the call with concurrent misses
+	return loadShared(ctx, s, cacheKey, s.ttl, func(ctx context.Context) ([]*models.Project, error) {
+		return s.service.GetSkillProjects(ctx, name)
+	})
+}
+
+// GetFullResume retrieves the profile and every resume section, with caching
+func (s *CachedResumeService) GetFullResume(ctx context.Context) (*models.Resume, error) {
+	cacheKey := "resume"
+	var resume models.Resume
+
+	// Try to get from cache first
+	err := s.cache.Get(ctx, cacheKey, &resume)
+	s.trackLookup(ctx, err)
+	if err == nil {
+		return &resume, nil
+	}
+
+	// If not in cache or error, get from service
+	if err != cache.ErrCacheMiss {
+		fmt.Printf("Cache error for resume: %v\n", err)
+	}
+
+	// Get from service, sharing the call with concurrent misses
+	return loadShared(ctx, s, cacheKey, s.ttl, func(ctx context.Context) (*models.Resume, error) {
+		return s.service.GetFullResume(ctx)
+	})
+}
+
+// recentTTL caps how long the recently updated feed is cached, since it is
+// meant to reflect changes quickly
+const recentTTL = time.Minute
+
+// GetRecentlyUpdated retrieves the most recently updated items, with brief caching
+func (s *CachedResumeService) GetRecentlyUpdated(ctx context.Context, limit int) ([]*models.RecentItem, error) {
+	cacheKey := fmt.Sprintf("recent:%d", limit)
+
+	var items []*models.RecentItem
+
+	// Try to get from cache first
+	err := s.cache.Get(ctx, cacheKey, &items)
+	s.trackLookup(ctx, err)
+	if err == nil {
+		return items, nil
+	}
+
+	// If not in cache or error, get from service
+	if err != cache.ErrCacheMiss {
+		fmt.Printf("Cache error for recent items: %v\n", err)
+	}
+
+	// Get from service, sharing the call with concurrent misses
+	ttl := s.ttl
+	if ttl == 0 || ttl > recentTTL {
+		ttl = recentTTL
+	}
+	return loadShared(ctx, s, cacheKey, ttl, func(ctx context.Context) ([]*models.RecentItem, error) {
+		return s.service.GetRecentlyUpdated(ctx, limit)
+	})
+}
+
+// RenameSkillCategory renames a skill category and invalidates the cached
+// entries that include skills
+func (s *CachedResumeService) RenameSkillCategory(ctx context.Context, from, to string) (int64, error) {
+	renamed, err := s.service.RenameSkillCategory(ctx, from, to)
+	if err != nil || renamed == 0 {
+		return renamed, err
+	}
+
+	for _, entity := range []string{"skills", "resume", "recent", "meta"} {
+		if _, err := s.cache.DeletePrefix(ctx, cacheKeyPrefixes[entity]); err != nil {
+			fmt.Printf("Failed to invalidate %s cache: %v\n", entity, err)
+		}
+	}
+
+	return renamed, nil
+}
+
+// DeleteAllProjects deletes every project and invalidates the cached entries
+// derived from them, including project listings under every filter
+func (s *CachedResumeService) DeleteAllProjects(ctx context.Context) (int64, error) {
+	deleted, err := s.service.DeleteAllProjects(ctx)
+	if err != nil || deleted == 0 {
+		return deleted, err
 	}
 
-	// Store in cache for future requests
-	if err := s.cache.Set(ctx, cacheKey, projects, s.ttl); err != nil {
-		fmt.Printf("Failed to cache projects: %v\n", err)
+	for _, entity := range []string{"projects", "resume", "recent", "meta", "stats", "completeness"} {
+		if _, err := s.cache.DeletePrefix(ctx, cacheKeyPrefixes[entity]); err != nil {
+			fmt.Printf("Failed to invalidate %s cache: %v\n", entity, err)
+		}
 	}
 
-	return projects, nil
+	return deleted, nil
 }
@@ -0,0 +1,371 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/npmulder/resume-api/internal/cache"
+	"github.com/npmulder/resume-api/internal/models"
+	"github.com/npmulder/resume-api/internal/repository"
+)
+
+// memoryCache is an in-memory cache that ignores TTLs
+type memoryCache struct {
+	cache.NoOpCache
+	mu    sync.Mutex
+	items map[string][]byte
+}
+
+func newMemoryCache() *memoryCache {
+	return &memoryCache{items: make(map[string][]byte)}
+}
+
+func (c *memoryCache) Get(ctx context.Context, key string, dest interface{}) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	data, ok := c.items[key]
+	if !ok {
+		return cache.ErrCacheMiss
+	}
+	return json.Unmarshal(data, dest)
+}
+
+func (c *memoryCache) Set(ctx context.Context, key string, value interface{}, ttl time.Duration) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.items[key] = data
+	return nil
+}
+
+func (c *memoryCache) Delete(ctx context.Context, key string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.items, key)
+	return nil
+}
+
+func (c *memoryCache) DeletePrefix(ctx context.Context, prefix string) (int64, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var deleted int64
+	for key := range c.items {
+		if strings.HasPrefix(key, prefix) {
+			delete(c.items, key)
+			deleted++
+		}
+	}
+	return deleted, nil
+}
+
+// countingResumeService counts GetProfile calls and blocks each call until
+// release is closed or ctx is done, so concurrent callers overlap
+type countingResumeService struct {
+	ResumeService
+	calls   atomic.Int32
+	release chan struct{}
+}
+
+func (s *countingResumeService) GetProfile(ctx context.Context) (*models.Profile, error) {
+	s.calls.Add(1)
+	select {
+	case <-s.release:
+		return &models.Profile{ID: 1, Name: "John Doe"}, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func TestCachedResumeService_GetProfile_CoalescesConcurrentMisses(t *testing.T) {
+	const callers = 20
+
+	underlying := &countingResumeService{release: make(chan struct{})}
+	service := NewCachedResumeService(underlying, cache.NewNoOpCache(), time.Minute, 0)
+
+	var wg sync.WaitGroup
+	results := make([]*models.Profile, callers)
+	errs := make([]error, callers)
+	for i := 0; i < callers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i], errs[i] = service.GetProfile(context.Background())
+		}(i)
+	}
+
+	// Give every caller time to join the in-flight request before releasing it
+	require.Eventually(t, func() bool { return underlying.calls.Load() == 1 }, time.Second, time.Millisecond)
+	time.Sleep(50 * time.Millisecond)
+	close(underlying.release)
+	wg.Wait()
+
+	assert.Equal(t, int32(1), underlying.calls.Load())
+	for i := 0; i < callers; i++ {
+		require.NoError(t, errs[i])
+		assert.Equal(t, "John Doe", results[i].Name)
+	}
+}
+
+func TestCachedResumeService_GetProfile_SharedLoadOutlivesCanceledCaller(t *testing.T) {
+	underlying := &countingResumeService{release: make(chan struct{})}
+	service := NewCachedResumeService(underlying, cache.NewNoOpCache(), time.Minute, 0)
+
+	// The first caller starts the shared load and then gives up
+	leaderCtx, cancel := context.WithCancel(context.Background())
+	leaderErr := make(chan error, 1)
+	go func() {
+		_, err := service.GetProfile(leaderCtx)
+		leaderErr <- err
+	}()
+	require.Eventually(t, func() bool { return underlying.calls.Load() == 1 }, time.Second, time.Millisecond)
+
+	type result struct {
+		profile *models.Profile
+		err     error
+	}
+	follower := make(chan result, 1)
+	go func() {
+		profile, err := service.GetProfile(context.Background())
+		follower <- result{profile, err}
+	}()
+
+	// Give the second caller time to join the in-flight load
+	time.Sleep(50 * time.Millisecond)
+	cancel()
+	assert.ErrorIs(t, <-leaderErr, context.Canceled)
+
+	// The load keeps running for the caller still waiting
+	close(underlying.release)
+	res := <-follower
+	require.NoError(t, res.err)
+	assert.Equal(t, "John Doe", res.profile.Name)
+	assert.Equal(t, int32(1), underlying.calls.Load())
+}
+
+func TestCachedResumeService_GetProfile_CachesNotFound(t *testing.T) {
+	mockProfileRepo := new(MockProfileRepository)
+	repos := repository.Repositories{Profile: mockProfileRepo}
+	ctx := context.Background()
+
+	mockProfileRepo.On("GetProfile", mock.Anything).Return(nil, repository.ErrNotFound).Once()
+
+	service := NewCachedResumeService(NewResumeService(repos), newMemoryCache(), time.Minute, 30*time.Second)
+
+	for i := 0; i < 3; i++ {
+		profile, err := service.GetProfile(ctx)
+		assert.ErrorIs(t, err, repository.ErrNotFound)
+		assert.Nil(t, profile)
+	}
+	mockProfileRepo.AssertNumberOfCalls(t, "GetProfile", 1)
+
+	// Creating the profile invalidates the cached not-found state
+	created := &models.Profile{Name: "John Doe"}
+	mockProfileRepo.On("CreateProfile", mock.Anything, created).Return(nil).Once()
+	mockProfileRepo.On("GetProfile", mock.Anything).Return(created, nil).Once()
+
+	require.NoError(t, service.CreateProfile(ctx, created))
+
+	profile, err := service.GetProfile(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "John Doe", profile.Name)
+	mockProfileRepo.AssertExpectations(t)
+}
+
+func TestCachedResumeService_UpdateProfile_InvalidatesCompleteness(t *testing.T) {
+	mockProfileRepo := new(MockProfileRepository)
+	repos := repository.Repositories{Profile: mockProfileRepo}
+	ctx := context.Background()
+
+	memory := newMemoryCache()
+	require.NoError(t, memory.Set(ctx, "completeness", models.Completeness{Score: 50}, time.Minute))
+
+	profile := &models.Profile{ID: 1, Name: "John Doe"}
+	mockProfileRepo.On("UpdateProfile", mock.Anything, profile).Return(nil).Once()
+
+	service := NewCachedResumeService(NewResumeService(repos), memory, time.Minute, 0)
+	require.NoError(t, service.UpdateProfile(ctx, profile))
+
+	var completeness models.Completeness
+	assert.ErrorIs(t, memory.Get(ctx, "completeness", &completeness), cache.ErrCacheMiss)
+	mockProfileRepo.AssertExpectations(t)
+}
+
+func TestCachedResumeService_GetSkills_KeysOnFeaturedValue(t *testing.T) {
+	mockSkillRepo := new(MockSkillRepository)
+	repos := repository.Repositories{Skill: mockSkillRepo}
+	ctx := context.Background()
+
+	mockSkillRepo.On("GetSkills", mock.Anything, mock.Anything).Return([]*models.Skill{{ID: 1, Name: "Go", IsFeatured: true}}, nil).Once()
+
+	service := NewCachedResumeService(NewResumeService(repos), newMemoryCache(), time.Minute, 0)
+
+	// Separately allocated flags with the same value share a cache entry
+	for i := 0; i < 2; i++ {
+		featured := true
+		skills, err := service.GetSkills(ctx, repository.SkillFilters{Featured: &featured})
+		require.NoError(t, err)
+		assert.Len(t, skills, 1)
+	}
+	mockSkillRepo.AssertExpectations(t)
+}
+
+func TestCachedResumeService_GetSkills_KeysOnLevel(t *testing.T) {
+	mockSkillRepo := new(MockSkillRepository)
+	repos := repository.Repositories{Skill: mockSkillRepo}
+	ctx := context.Background()
+
+	all := repository.SkillFilters{}
+	experts := repository.SkillFilters{Level: "expert"}
+	mockSkillRepo.On("GetSkills", mock.Anything, all).Return([]*models.Skill{{ID: 1, Name: "Go"}, {ID: 2, Name: "Rust"}}, nil).Once()
+	mockSkillRepo.On("GetSkills", mock.Anything, experts).Return([]*models.Skill{{ID: 1, Name: "Go"}}, nil).Once()
+
+	service := NewCachedResumeService(NewResumeService(repos), newMemoryCache(), time.Minute, 0)
+
+	skills, err := service.GetSkills(ctx, all)
+	require.NoError(t, err)
+	assert.Len(t, skills, 2)
+
+	// The filtered list isn't served from the unfiltered entry
+	skills, err = service.GetSkills(ctx, experts)
+	require.NoError(t, err)
+	assert.Len(t, skills, 1)
+	mockSkillRepo.AssertExpectations(t)
+}
+
+func TestCachedResumeService_GetEducation_KeysOnInstitution(t *testing.T) {
+	mockEducationRepo := new(MockEducationRepository)
+	repos := repository.Repositories{Education: mockEducationRepo}
+	ctx := context.Background()
+
+	all := repository.EducationFilters{}
+	mit := repository.EducationFilters{Institution: "MIT"}
+	mockEducationRepo.On("GetEducation", mock.Anything, all).Return([]*models.Education{{ID: 1, Institution: "MIT"}, {ID: 2, Institution: "AWS"}}, nil).Once()
+	mockEducationRepo.On("GetEducation", mock.Anything, mit).Return([]*models.Education{{ID: 1, Institution: "MIT"}}, nil).Once()
+
+	service := NewCachedResumeService(NewResumeService(repos), newMemoryCache(), time.Minute, 0)
+
+	education, err := service.GetEducation(ctx, all)
+	require.NoError(t, err)
+	assert.Len(t, education, 2)
+
+	// The filtered list isn't served from the unfiltered entry
+	education, err = service.GetEducation(ctx, mit)
+	require.NoError(t, err)
+	assert.Len(t, education, 1)
+	mockEducationRepo.AssertExpectations(t)
+}
+
+func TestCachedResumeService_RenameSkillCategory_InvalidatesSkills(t *testing.T) {
+	mockSkillRepo := new(MockSkillRepository)
+	repos := repository.Repositories{Skill: mockSkillRepo}
+	ctx := context.Background()
+
+	filters := repository.SkillFilters{}
+	mockSkillRepo.On("GetSkills", mock.Anything, filters).Return([]*models.Skill{{ID: 1, Category: "Programming Languages", Name: "Go"}}, nil).Once()
+
+	service := NewCachedResumeService(NewResumeService(repos), newMemoryCache(), time.Minute, 0)
+
+	// The second read is served from the cache
+	for i := 0; i < 2; i++ {
+		skills, err := service.GetSkills(ctx, filters)
+		require.NoError(t, err)
+		assert.Equal(t, "Programming Languages", skills[0].Category)
+	}
+
+	mockSkillRepo.On("RenameSkillCategory", mock.Anything, "Programming Languages", "Languages").Return(int64(1), nil).Once()
+	mockSkillRepo.On("GetSkills", mock.Anything, filters).Return([]*models.Skill{{ID: 1, Category: "Languages", Name: "Go"}}, nil).Once()
+
+	renamed, err := service.RenameSkillCategory(ctx, "Programming Languages", "Languages")
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), renamed)
+
+	skills, err := service.GetSkills(ctx, filters)
+	require.NoError(t, err)
+	assert.Equal(t, "Languages", skills[0].Category)
+	mockSkillRepo.AssertExpectations(t)
+}
+
+func TestCachedResumeService_DeleteAllProjects_InvalidatesProjects(t *testing.T) {
+	mockProjectRepo := new(MockProjectRepository)
+	mockSkillRepo := new(MockSkillRepository)
+	repos := repository.Repositories{Project: mockProjectRepo, Skill: mockSkillRepo}
+	ctx := context.Background()
+
+	filters := repository.ProjectFilters{}
+	skillFilters := repository.ProjectFilters{Technology: "Go"}
+	mockProjectRepo.On("GetProjects", mock.Anything, filters).Return([]*models.Project{{ID: 1, Name: "Resume API"}}, nil).Once()
+	mockSkillRepo.On("GetSkillByName", mock.Anything, "Go").Return(&models.Skill{ID: 4, Name: "Go"}, nil).Once()
+	mockProjectRepo.On("GetProjects", mock.Anything, skillFilters).Return([]*models.Project{{ID: 1, Name: "Resume API"}}, nil).Once()
+
+	service := NewCachedResumeService(NewResumeService(repos), newMemoryCache(), time.Minute, 0)
+
+	// The second reads are served from the cache
+	for i := 0; i < 2; i++ {
+		projects, err := service.GetProjects(ctx, filters)
+		require.NoError(t, err)
+		assert.Len(t, projects, 1)
+
+		projects, err = service.GetSkillProjects(ctx, "Go")
+		require.NoError(t, err)
+		assert.Len(t, projects, 1)
+	}
+
+	mockProjectRepo.On("DeleteAllProjects", mock.Anything).Return(int64(1), nil).Once()
+	mockProjectRepo.On("GetProjects", mock.Anything, filters).Return([]*models.Project{}, nil).Once()
+	mockSkillRepo.On("GetSkillByName", mock.Anything, "Go").Return(&models.Skill{ID: 4, Name: "Go"}, nil).Once()
+	mockProjectRepo.On("GetProjects", mock.Anything, skillFilters).Return([]*models.Project{}, nil).Once()
+
+	deleted, err := service.DeleteAllProjects(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), deleted)
+
+	// The deleted projects are no longer served from the cache
+	projects, err := service.GetProjects(ctx, filters)
+	require.NoError(t, err)
+	assert.Empty(t, projects)
+
+	projects, err = service.GetSkillProjects(ctx, "Go")
+	require.NoError(t, err)
+	assert.Empty(t, projects)
+	mockProjectRepo.AssertExpectations(t)
+	mockSkillRepo.AssertExpectations(t)
+}
+
+func TestCachedResumeService_GetInstitutions_CachedUnderEducationPrefix(t *testing.T) {
+	mockEducationRepo := new(MockEducationRepository)
+	repos := repository.Repositories{Education: mockEducationRepo}
+	ctx := context.Background()
+
+	mockEducationRepo.On("GetInstitutions", mock.Anything).Return([]*models.InstitutionCount{{Institution: "AWS", Count: 2}}, nil).Once()
+
+	memCache := newMemoryCache()
+	service := NewCachedResumeService(NewResumeService(repos), memCache, time.Minute, 0)
+
+	// The second read is served from the cache
+	for i := 0; i < 2; i++ {
+		institutions, err := service.GetInstitutions(ctx)
+		require.NoError(t, err)
+		require.Len(t, institutions, 1)
+		assert.Equal(t, 2, institutions[0].Count)
+	}
+	mockEducationRepo.AssertExpectations(t)
+
+	// Invalidating education entries drops the institution counts too
+	prefix, ok := CacheKeyPrefix("education")
+	require.True(t, ok)
+	deleted, err := memCache.DeletePrefix(ctx, prefix)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), deleted)
+}
@@ -3,6 +3,7 @@ package services
 
 import (
 	"context"
+	"time"
 
 	"github.com/npmulder/resume-api/internal/models"
 	"github.com/npmulder/resume-api/internal/repository"
@@ -12,9 +13,28 @@ import (
 // It orchestrates calls to the repository layer and implements business rules.
 type ResumeService interface {
 	GetProfile(ctx context.Context) (*models.Profile, error)
+	CreateProfile(ctx context.Context, profile *models.Profile) error
+	UpdateProfile(ctx context.Context, profile *models.Profile) error
+	GetProfileHistory(ctx context.Context) ([]*models.ProfileVersion, error)
+	GetProfileDiff(ctx context.Context, from, to time.Time) (*models.ProfileDiff, error)
 	GetExperiences(ctx context.Context, filters repository.ExperienceFilters) ([]*models.Experience, error)
+	GetTenureSummary(ctx context.Context) (*models.TenureSummary, error)
+	GetStats(ctx context.Context) (*models.Stats, error)
+	GetMeta(ctx context.Context) (*models.Meta, error)
+	GetCompleteness(ctx context.Context) (*models.Completeness, error)
 	GetSkills(ctx context.Context, filters repository.SkillFilters) ([]*models.Skill, error)
+	GetSkillLevels(ctx context.Context) (*models.SkillLevelHistogram, error)
+	GetSkillProjects(ctx context.Context, name string) ([]*models.Project, error)
+	RenameSkillCategory(ctx context.Context, from, to string) (int64, error)
 	GetAchievements(ctx context.Context, filters repository.AchievementFilters) ([]*models.Achievement, error)
 	GetEducation(ctx context.Context, filters repository.EducationFilters) ([]*models.Education, error)
+	GetInstitutions(ctx context.Context) ([]*models.InstitutionCount, error)
+	GetEducationByCredentialID(ctx context.Context, credentialID string) (*models.Education, error)
 	GetProjects(ctx context.Context, filters repository.ProjectFilters) ([]*models.Project, error)
+	GetSimilarProjects(ctx context.Context, id int, limit int) ([]*models.SimilarProject, error)
+	GetFullResume(ctx context.Context) (*models.Resume, error)
+	GetChangesSince(ctx context.Context, since time.Time) (*models.ResumeChanges, error)
+	Search(ctx context.Context, term string) (*models.SearchResults, error)
+	GetRecentlyUpdated(ctx context.Context, limit int) ([]*models.RecentItem, error)
+	DeleteAllProjects(ctx context.Context) (int64, error)
 }
@@ -0,0 +1,77 @@
+package services
+
+import (
+	"context"
+
+	"github.com/npmulder/resume-api/internal/models"
+	"github.com/npmulder/resume-api/internal/webhook"
+)
+
+// Notifier receives an event after every successful write
+type Notifier interface {
+	Notify(event webhook.Event)
+}
+
+// NotifyingResumeService is a decorator for ResumeService that reports
+// successful writes to a Notifier. Reads are passed through unchanged.
+type NotifyingResumeService struct {
+	ResumeService
+	notifier Notifier
+}
+
+// NewNotifyingResumeService creates a resume service that notifies about writes.
+// Wrap the cached service with it so receivers never read stale cache entries.
+func NewNotifyingResumeService(service ResumeService, notifier Notifier) ResumeService {
+	return &NotifyingResumeService{
+		ResumeService: service,
+		notifier:      notifier,
+	}
+}
+
+// CreateProfile creates the profile and reports the creation
+func (s *NotifyingResumeService) CreateProfile(ctx context.Context, profile *models.Profile) error {
+	if err := s.ResumeService.CreateProfile(ctx, profile); err != nil {
+		return err
+	}
+
+	s.notifier.Notify(webhook.Event{Entity: "profile", Action: webhook.ActionCreated, ID: profile.ID})
+	return nil
+}
+
+// UpdateProfile updates the profile and reports the update
+func (s *NotifyingResumeService) UpdateProfile(ctx context.Context, profile *models.Profile) error {
+	if err := s.ResumeService.UpdateProfile(ctx, profile); err != nil {
+		return err
+	}
+
+	s.notifier.Notify(webhook.Event{Entity: "profile", Action: webhook.ActionUpdated, ID: profile.ID})
+	return nil
+}
+
+// RenameSkillCategory renames a skill category and reports the update when
+// any skills were changed
+func (s *NotifyingResumeService) RenameSkillCategory(ctx context.Context, from, to string) (int64, error) {
+	renamed, err := s.ResumeService.RenameSkillCategory(ctx, from, to)
+	if err != nil {
+		return 0, err
+	}
+
+	if renamed > 0 {
+		s.notifier.Notify(webhook.Event{Entity: "skills", Action: webhook.ActionUpdated})
+	}
+	return renamed, nil
+}
+
+// DeleteAllProjects deletes every project and reports the deletion when any
+// rows were removed
+func (s *NotifyingResumeService) DeleteAllProjects(ctx context.Context) (int64, error) {
+	deleted, err := s.ResumeService.DeleteAllProjects(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	if deleted > 0 {
+		s.notifier.Notify(webhook.Event{Entity: "projects", Action: webhook.ActionDeleted})
+	}
+	return deleted, nil
+}
@@ -0,0 +1,90 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/npmulder/resume-api/internal/models"
+	"github.com/npmulder/resume-api/internal/repository"
+	"github.com/npmulder/resume-api/internal/webhook"
+)
+
+// recordingNotifier keeps every event it is notified about
+type recordingNotifier struct {
+	events []webhook.Event
+}
+
+func (n *recordingNotifier) Notify(event webhook.Event) {
+	n.events = append(n.events, event)
+}
+
+func TestNotifyingResumeService(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("notifies after a successful update", func(t *testing.T) {
+		mockProfileRepo := new(MockProfileRepository)
+		notifier := &recordingNotifier{}
+		service := NewNotifyingResumeService(NewResumeService(repository.Repositories{Profile: mockProfileRepo}), notifier)
+
+		profile := &models.Profile{ID: 1, Name: "John Doe"}
+		mockProfileRepo.On("UpdateProfile", ctx, profile).Return(nil)
+
+		require.NoError(t, service.UpdateProfile(ctx, profile))
+		require.Len(t, notifier.events, 1)
+		assert.Equal(t, "profile", notifier.events[0].Entity)
+		assert.Equal(t, webhook.ActionUpdated, notifier.events[0].Action)
+		assert.Equal(t, 1, notifier.events[0].ID)
+	})
+
+	t.Run("does not notify when the write fails", func(t *testing.T) {
+		mockProfileRepo := new(MockProfileRepository)
+		notifier := &recordingNotifier{}
+		service := NewNotifyingResumeService(NewResumeService(repository.Repositories{Profile: mockProfileRepo}), notifier)
+
+		profile := &models.Profile{ID: 1, Name: "John Doe"}
+		mockProfileRepo.On("UpdateProfile", ctx, profile).Return(errors.New("database error"))
+
+		assert.Error(t, service.UpdateProfile(ctx, profile))
+		assert.Empty(t, notifier.events)
+	})
+
+	t.Run("notifies when projects are deleted", func(t *testing.T) {
+		mockProjectRepo := new(MockProjectRepository)
+		notifier := &recordingNotifier{}
+		service := NewNotifyingResumeService(NewResumeService(repository.Repositories{Project: mockProjectRepo}), notifier)
+
+		mockProjectRepo.On("DeleteAllProjects", ctx).Return(int64(2), nil).Once()
+		mockProjectRepo.On("DeleteAllProjects", ctx).Return(int64(0), nil).Once()
+
+		_, err := service.DeleteAllProjects(ctx)
+		require.NoError(t, err)
+		_, err = service.DeleteAllProjects(ctx)
+		require.NoError(t, err)
+
+		// Deleting nothing is not a change
+		require.Len(t, notifier.events, 1)
+		assert.Equal(t, webhook.Event{Entity: "projects", Action: webhook.ActionDeleted}, notifier.events[0])
+	})
+
+	t.Run("notifies when a skill category is renamed", func(t *testing.T) {
+		mockSkillRepo := new(MockSkillRepository)
+		notifier := &recordingNotifier{}
+		service := NewNotifyingResumeService(NewResumeService(repository.Repositories{Skill: mockSkillRepo}), notifier)
+
+		mockSkillRepo.On("RenameSkillCategory", ctx, "Programming Languages", "Languages").Return(int64(4), nil).Once()
+		mockSkillRepo.On("RenameSkillCategory", ctx, "Tools", "Tooling").Return(int64(0), nil).Once()
+
+		_, err := service.RenameSkillCategory(ctx, "Programming Languages", "Languages")
+		require.NoError(t, err)
+		_, err = service.RenameSkillCategory(ctx, "Tools", "Tooling")
+		require.NoError(t, err)
+
+		// Renaming an empty category is not a change
+		require.Len(t, notifier.events, 1)
+		assert.Equal(t, webhook.Event{Entity: "skills", Action: webhook.ActionUpdated}, notifier.events[0])
+	})
+}
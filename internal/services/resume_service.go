@@ -3,11 +3,28 @@ package services
 
 import (
 	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
 
 	"github.com/npmulder/resume-api/internal/models"
 	"github.com/npmulder/resume-api/internal/repository"
 )
 
+// featuredFallbackLimit is the number of recent entries returned by the
+// featured fallback when the request doesn't specify a limit.
+const featuredFallbackLimit = 3
+
+// defaultSimilarLimit is the number of similar projects returned when the
+// request doesn't specify a limit.
+const defaultSimilarLimit = 5
+
+// defaultRecentLimit is the number of items returned by the recently updated
+// feed when the request doesn't specify a limit.
+const defaultRecentLimit = 10
+
 // resumeService is the implementation of the ResumeService interface.
 // It uses the repository interfaces to access the data layer.
 type resumeService struct {
@@ -27,27 +44,445 @@ func (s *resumeService) GetProfile(ctx context.Context) (*models.Profile, error)
 	return s.repos.Profile.GetProfile(ctx)
 }
 
+// CreateProfile creates the user's profile.
+func (s *resumeService) CreateProfile(ctx context.Context, profile *models.Profile) error {
+	return s.repos.Profile.CreateProfile(ctx, profile)
+}
+
+// UpdateProfile updates the user's profile.
+func (s *resumeService) UpdateProfile(ctx context.Context, profile *models.Profile) error {
+	return s.repos.Profile.UpdateProfile(ctx, profile)
+}
+
+// GetProfileHistory retrieves past versions of the user's profile, newest first.
+func (s *resumeService) GetProfileHistory(ctx context.Context) ([]*models.ProfileVersion, error) {
+	return s.repos.Profile.GetProfileHistory(ctx)
+}
+
+// GetProfileDiff compares the profile as it was at from with the profile as
+// it was at to. It returns repository.ErrNotFound when no profile exists.
+func (s *resumeService) GetProfileDiff(ctx context.Context, from, to time.Time) (*models.ProfileDiff, error) {
+	current, err := s.repos.Profile.GetProfile(ctx)
+	if err != nil {
+		return nil, err
+	}
+	history, err := s.repos.Profile.GetProfileHistory(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.ProfileDiff{
+		From:    from,
+		To:      to,
+		Changes: models.DiffProfiles(profileAt(current, history, from), profileAt(current, history, to)),
+	}, nil
+}
+
+// profileAt returns the profile as it was at the given time: the current
+// profile from its last update on, otherwise the history version in effect
+// then. It returns nil before the earliest known version was written.
+func profileAt(current *models.Profile, history []*models.ProfileVersion, at time.Time) *models.Profile {
+	if !at.Before(current.UpdatedAt) {
+		return current
+	}
+	for _, version := range history {
+		if !at.Before(version.UpdatedAt) && at.Before(version.ReplacedAt) {
+			return version.Profile()
+		}
+	}
+	return nil
+}
+
 // GetExperiences retrieves work experiences with optional filtering.
 func (s *resumeService) GetExperiences(ctx context.Context, filters repository.ExperienceFilters) ([]*models.Experience, error) {
 	return s.repos.Experience.GetExperiences(ctx, filters)
 }
 
+// GetTenureSummary aggregates the months worked overall and per company
+// across all work experiences, treating current roles as ending now.
+func (s *resumeService) GetTenureSummary(ctx context.Context) (*models.TenureSummary, error) {
+	experiences, err := s.repos.Experience.GetExperiences(ctx, repository.ExperienceFilters{})
+	if err != nil {
+		return nil, err
+	}
+	return summarizeTenure(experiences, time.Now()), nil
+}
+
+// GetStats combines headline figures from several sections: years of
+// experience since the earliest role and the number of skills, projects and
+// certifications.
+func (s *resumeService) GetStats(ctx context.Context) (*models.Stats, error) {
+	experiences, err := s.repos.Experience.GetExperiences(ctx, repository.ExperienceFilters{})
+	if err != nil {
+		return nil, err
+	}
+	skills, err := s.repos.Skill.GetSkills(ctx, repository.SkillFilters{})
+	if err != nil {
+		return nil, err
+	}
+	projects, err := s.repos.Project.GetProjects(ctx, repository.ProjectFilters{})
+	if err != nil {
+		return nil, err
+	}
+	certifications, err := s.repos.Education.GetEducation(ctx, repository.EducationFilters{Type: models.EducationTypeCertification})
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.Stats{
+		YearsExperience: models.YearsOfExperience(experiences, time.Now()),
+		Skills:          len(skills),
+		Projects:        len(projects),
+		Certifications:  len(certifications),
+	}, nil
+}
+
+// GetMeta collects when each section was last updated and derives a
+// combined etag from the timestamps.
+func (s *resumeService) GetMeta(ctx context.Context) (*models.Meta, error) {
+	var meta models.Meta
+	sections := []struct {
+		get func(context.Context) (*time.Time, error)
+		dst **time.Time
+	}{
+		{s.repos.Profile.GetMaxUpdatedAt, &meta.ProfileUpdatedAt},
+		{s.repos.Experience.GetMaxUpdatedAt, &meta.ExperiencesUpdatedAt},
+		{s.repos.Skill.GetMaxUpdatedAt, &meta.SkillsUpdatedAt},
+		{s.repos.Achievement.GetMaxUpdatedAt, &meta.AchievementsUpdatedAt},
+		{s.repos.Education.GetMaxUpdatedAt, &meta.EducationUpdatedAt},
+		{s.repos.Project.GetMaxUpdatedAt, &meta.ProjectsUpdatedAt},
+	}
+
+	hash := sha256.New()
+	for _, section := range sections {
+		updatedAt, err := section.get(ctx)
+		if err != nil {
+			return nil, err
+		}
+		*section.dst = updatedAt
+
+		if updatedAt != nil {
+			fmt.Fprintf(hash, "%d;", updatedAt.UnixNano())
+		} else {
+			fmt.Fprint(hash, "-;")
+		}
+	}
+	meta.ETag = fmt.Sprintf(`"%x"`, hash.Sum(nil)[:8])
+
+	return &meta, nil
+}
+
+// GetCompleteness scores the resume by which optional profile fields are set
+// and which sections have at least one entry, listing the missing items. A
+// missing profile counts as one with every optional field empty.
+func (s *resumeService) GetCompleteness(ctx context.Context) (*models.Completeness, error) {
+	profile, err := s.repos.Profile.GetProfile(ctx)
+	if errors.Is(err, repository.ErrNotFound) {
+		profile, err = &models.Profile{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	experiences, err := s.repos.Experience.GetExperiences(ctx, repository.ExperienceFilters{Limit: 1})
+	if err != nil {
+		return nil, err
+	}
+	skills, err := s.repos.Skill.GetSkills(ctx, repository.SkillFilters{Limit: 1})
+	if err != nil {
+		return nil, err
+	}
+	achievements, err := s.repos.Achievement.GetAchievements(ctx, repository.AchievementFilters{Limit: 1})
+	if err != nil {
+		return nil, err
+	}
+	education, err := s.repos.Education.GetEducation(ctx, repository.EducationFilters{Limit: 1})
+	if err != nil {
+		return nil, err
+	}
+	projects, err := s.repos.Project.GetProjects(ctx, repository.ProjectFilters{Limit: 1})
+	if err != nil {
+		return nil, err
+	}
+
+	items := []struct {
+		name    string
+		present bool
+	}{
+		{"summary", stringValue(profile.Summary) != ""},
+		{"location", stringValue(profile.Location) != ""},
+		{"phone", stringValue(profile.Phone) != ""},
+		{"links", stringValue(profile.LinkedIn) != "" || stringValue(profile.GitHub) != ""},
+		{"experiences", len(experiences) > 0},
+		{"skills", len(skills) > 0},
+		{"achievements", len(achievements) > 0},
+		{"education", len(education) > 0},
+		{"projects", len(projects) > 0},
+	}
+
+	completeness := &models.Completeness{Missing: []string{}}
+	present := 0
+	for _, item := range items {
+		if item.present {
+			present++
+		} else {
+			completeness.Missing = append(completeness.Missing, item.name)
+		}
+	}
+	completeness.Score = present * 100 / len(items)
+
+	return completeness, nil
+}
+
 // GetSkills retrieves skills with optional filtering.
+// When no featured skills exist and the recent fallback is requested,
+// the most recent skills are returned instead.
 func (s *resumeService) GetSkills(ctx context.Context, filters repository.SkillFilters) ([]*models.Skill, error) {
+	skills, err := s.repos.Skill.GetSkills(ctx, filters)
+	if err != nil || !useFeaturedFallback(filters.Featured, filters.Fallback, filters.Offset, len(skills)) {
+		return skills, err
+	}
+
+	filters.Featured, filters.Sort = nil, repository.SortRecent
+	filters.Limit, filters.Offset = fallbackLimit(filters.Limit), 0
 	return s.repos.Skill.GetSkills(ctx, filters)
 }
 
+// GetSkillLevels counts skills per proficiency level.
+func (s *resumeService) GetSkillLevels(ctx context.Context) (*models.SkillLevelHistogram, error) {
+	return s.repos.Skill.GetSkillLevelHistogram(ctx)
+}
+
 // GetAchievements retrieves achievements with optional filtering.
+// When no featured achievements exist and the recent fallback is requested,
+// the most recent achievements are returned instead.
 func (s *resumeService) GetAchievements(ctx context.Context, filters repository.AchievementFilters) ([]*models.Achievement, error) {
+	achievements, err := s.repos.Achievement.GetAchievements(ctx, filters)
+	if err != nil || !useFeaturedFallback(filters.Featured, filters.Fallback, filters.Offset, len(achievements)) {
+		return achievements, err
+	}
+
+	filters.Featured, filters.Sort = nil, repository.SortRecent
+	filters.Limit, filters.Offset = fallbackLimit(filters.Limit), 0
 	return s.repos.Achievement.GetAchievements(ctx, filters)
 }
 
 // GetEducation retrieves education entries with optional filtering.
+// When no featured entries exist and the recent fallback is requested,
+// the most recent entries are returned instead.
 func (s *resumeService) GetEducation(ctx context.Context, filters repository.EducationFilters) ([]*models.Education, error) {
+	education, err := s.repos.Education.GetEducation(ctx, filters)
+	if err != nil || !useFeaturedFallback(filters.Featured, filters.Fallback, filters.Offset, len(education)) {
+		return education, err
+	}
+
+	filters.Featured, filters.Sort = nil, repository.SortRecent
+	filters.Limit, filters.Offset = fallbackLimit(filters.Limit), 0
 	return s.repos.Education.GetEducation(ctx, filters)
 }
 
+// GetInstitutions lists the distinct institutions with their number of entries.
+func (s *resumeService) GetInstitutions(ctx context.Context) ([]*models.InstitutionCount, error) {
+	return s.repos.Education.GetInstitutions(ctx)
+}
+
+// GetEducationByCredentialID retrieves the certification with the given credential ID.
+func (s *resumeService) GetEducationByCredentialID(ctx context.Context, credentialID string) (*models.Education, error) {
+	return s.repos.Education.GetEducationByCredentialID(ctx, credentialID)
+}
+
 // GetProjects retrieves projects with optional filtering.
+// When no featured projects exist and the recent fallback is requested,
+// the most recent projects are returned instead.
 func (s *resumeService) GetProjects(ctx context.Context, filters repository.ProjectFilters) ([]*models.Project, error) {
+	projects, err := s.repos.Project.GetProjects(ctx, filters)
+	if err != nil || !useFeaturedFallback(filters.Featured, filters.Fallback, filters.Offset, len(projects)) {
+		return projects, err
+	}
+
+	filters.Featured, filters.Sort = nil, repository.SortRecent
+	filters.Limit, filters.Offset = fallbackLimit(filters.Limit), 0
 	return s.repos.Project.GetProjects(ctx, filters)
 }
+
+// GetSimilarProjects retrieves the projects sharing the most technologies with
+// the project with the given id.
+func (s *resumeService) GetSimilarProjects(ctx context.Context, id int, limit int) ([]*models.SimilarProject, error) {
+	if limit <= 0 {
+		limit = defaultSimilarLimit
+	}
+	return s.repos.Project.GetSimilarProjects(ctx, id, limit)
+}
+
+// GetSkillProjects retrieves the projects whose technologies include the
+// skill with the given name. It returns repository.ErrNotFound when no such
+// skill exists.
+func (s *resumeService) GetSkillProjects(ctx context.Context, name string) ([]*models.Project, error) {
+	skill, err := s.repos.Skill.GetSkillByName(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	return s.repos.Project.GetProjects(ctx, repository.ProjectFilters{Technology: skill.Name})
+}
+
+// Search finds entries in every section containing term.
+// The repository caps the number of results and flags truncation.
+func (s *resumeService) Search(ctx context.Context, term string) (*models.SearchResults, error) {
+	return s.repos.Search.Search(ctx, term)
+}
+
+// GetFullResume retrieves the profile together with every resume section.
+// It returns repository.ErrNotFound when no profile exists.
+func (s *resumeService) GetFullResume(ctx context.Context) (*models.Resume, error) {
+	var resume models.Resume
+	var err error
+
+	if resume.Profile, err = s.repos.Profile.GetProfile(ctx); err != nil {
+		return nil, err
+	}
+	if resume.Experiences, err = s.repos.Experience.GetExperiences(ctx, repository.ExperienceFilters{}); err != nil {
+		return nil, err
+	}
+	if resume.Skills, err = s.repos.Skill.GetSkills(ctx, repository.SkillFilters{}); err != nil {
+		return nil, err
+	}
+	if resume.Achievements, err = s.repos.Achievement.GetAchievements(ctx, repository.AchievementFilters{}); err != nil {
+		return nil, err
+	}
+	if resume.Education, err = s.repos.Education.GetEducation(ctx, repository.EducationFilters{}); err != nil {
+		return nil, err
+	}
+	if resume.Projects, err = s.repos.Project.GetProjects(ctx, repository.ProjectFilters{}); err != nil {
+		return nil, err
+	}
+
+	return &resume, nil
+}
+
+// GetChangesSince retrieves the entries updated after since across all
+// sections. A missing profile is treated as unchanged rather than an error.
+func (s *resumeService) GetChangesSince(ctx context.Context, since time.Time) (*models.ResumeChanges, error) {
+	changes := &models.ResumeChanges{Since: since}
+	updatedSince := since.UTC()
+
+	profile, err := s.repos.Profile.GetProfile(ctx)
+	if err != nil && !errors.Is(err, repository.ErrNotFound) {
+		return nil, err
+	}
+	if profile != nil && profile.UpdatedAt.After(since) {
+		changes.Profile = profile
+	}
+
+	if changes.Experiences, err = s.repos.Experience.GetExperiences(ctx, repository.ExperienceFilters{UpdatedSince: &updatedSince}); err != nil {
+		return nil, err
+	}
+	if changes.Skills, err = s.repos.Skill.GetSkills(ctx, repository.SkillFilters{UpdatedSince: &updatedSince}); err != nil {
+		return nil, err
+	}
+	if changes.Achievements, err = s.repos.Achievement.GetAchievements(ctx, repository.AchievementFilters{UpdatedSince: &updatedSince}); err != nil {
+		return nil, err
+	}
+	if changes.Education, err = s.repos.Education.GetEducation(ctx, repository.EducationFilters{UpdatedSince: &updatedSince}); err != nil {
+		return nil, err
+	}
+	if changes.Projects, err = s.repos.Project.GetProjects(ctx, repository.ProjectFilters{UpdatedSince: &updatedSince}); err != nil {
+		return nil, err
+	}
+
+	return changes, nil
+}
+
+// GetRecentlyUpdated returns the most recently updated items across all sections,
+// newest first. Each section is small, so entries are fetched per repository and
+// merged here rather than with a cross-table query.
+func (s *resumeService) GetRecentlyUpdated(ctx context.Context, limit int) ([]*models.RecentItem, error) {
+	if limit <= 0 {
+		limit = defaultRecentLimit
+	}
+
+	items := []*models.RecentItem{}
+
+	profile, err := s.repos.Profile.GetProfile(ctx)
+	if err != nil && !errors.Is(err, repository.ErrNotFound) {
+		return nil, err
+	}
+	if profile != nil {
+		items = append(items, &models.RecentItem{Type: models.RecentItemTypeProfile, ID: profile.ID, Title: profile.Name, UpdatedAt: profile.UpdatedAt})
+	}
+
+	experiences, err := s.repos.Experience.GetExperiences(ctx, repository.ExperienceFilters{})
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range experiences {
+		items = append(items, &models.RecentItem{Type: models.RecentItemTypeExperience, ID: e.ID, Title: e.Position + " at " + e.Company, UpdatedAt: e.UpdatedAt})
+	}
+
+	skills, err := s.repos.Skill.GetSkills(ctx, repository.SkillFilters{})
+	if err != nil {
+		return nil, err
+	}
+	for _, sk := range skills {
+		items = append(items, &models.RecentItem{Type: models.RecentItemTypeSkill, ID: sk.ID, Title: sk.Name, UpdatedAt: sk.UpdatedAt})
+	}
+
+	achievements, err := s.repos.Achievement.GetAchievements(ctx, repository.AchievementFilters{})
+	if err != nil {
+		return nil, err
+	}
+	for _, a := range achievements {
+		items = append(items, &models.RecentItem{Type: models.RecentItemTypeAchievement, ID: a.ID, Title: a.Title, UpdatedAt: a.UpdatedAt})
+	}
+
+	education, err := s.repos.Education.GetEducation(ctx, repository.EducationFilters{})
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range education {
+		items = append(items, &models.RecentItem{Type: models.RecentItemTypeEducation, ID: e.ID, Title: e.DegreeOrCertification, UpdatedAt: e.UpdatedAt})
+	}
+
+	projects, err := s.repos.Project.GetProjects(ctx, repository.ProjectFilters{})
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range projects {
+		items = append(items, &models.RecentItem{Type: models.RecentItemTypeProject, ID: p.ID, Title: p.Name, UpdatedAt: p.UpdatedAt})
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].UpdatedAt.After(items[j].UpdatedAt)
+	})
+	if len(items) > limit {
+		items = items[:limit]
+	}
+
+	return items, nil
+}
+
+// RenameSkillCategory moves every skill in category from to category to and
+// returns the number of skills changed.
+func (s *resumeService) RenameSkillCategory(ctx context.Context, from, to string) (int64, error) {
+	return s.repos.Skill.RenameSkillCategory(ctx, from, to)
+}
+
+// DeleteAllProjects deletes every project and returns the number deleted.
+func (s *resumeService) DeleteAllProjects(ctx context.Context) (int64, error) {
+	return s.repos.Project.DeleteAllProjects(ctx)
+}
+
+// useFeaturedFallback reports whether an empty featured-only result should be
+// replaced with the most recent entries. Only the first page falls back: an
+// empty page past it means the featured entries ran out, not that there are
+// none. The fallback query drops the featured filter and orders by
+// repository.SortRecent.
+func useFeaturedFallback(featured *bool, fallback string, offset, count int) bool {
+	return count == 0 && offset == 0 && featured != nil && *featured && fallback == repository.FallbackRecent
+}
+
+// fallbackLimit returns the number of entries the featured fallback should return.
+func fallbackLimit(limit int) int {
+	if limit > 0 {
+		return limit
+	}
+	return featuredFallbackLimit
+}
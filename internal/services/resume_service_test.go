@@ -4,9 +4,11 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 
 	"github.com/npmulder/resume-api/internal/models"
 	"github.com/npmulder/resume-api/internal/repository"
@@ -18,6 +20,12 @@ type MockProfileRepository struct {
 	mock.Mock
 }
 
+func (m *MockProfileRepository) GetMaxUpdatedAt(ctx context.Context) (*time.Time, error) {
+	args := m.Called(ctx)
+	updatedAt, _ := args.Get(0).(*time.Time)
+	return updatedAt, args.Error(1)
+}
+
 func (m *MockProfileRepository) GetProfile(ctx context.Context) (*models.Profile, error) {
 	args := m.Called(ctx)
 	profile, _ := args.Get(0).(*models.Profile)
@@ -28,6 +36,12 @@ func (m *MockProfileRepository) UpdateProfile(ctx context.Context, profile *mode
 	return m.Called(ctx, profile).Error(0)
 }
 
+func (m *MockProfileRepository) GetProfileHistory(ctx context.Context) ([]*models.ProfileVersion, error) {
+	args := m.Called(ctx)
+	versions, _ := args.Get(0).([]*models.ProfileVersion)
+	return versions, args.Error(1)
+}
+
 func (m *MockProfileRepository) CreateProfile(ctx context.Context, profile *models.Profile) error {
 	return m.Called(ctx, profile).Error(0)
 }
@@ -36,6 +50,12 @@ type MockExperienceRepository struct {
 	mock.Mock
 }
 
+func (m *MockExperienceRepository) GetMaxUpdatedAt(ctx context.Context) (*time.Time, error) {
+	args := m.Called(ctx)
+	updatedAt, _ := args.Get(0).(*time.Time)
+	return updatedAt, args.Error(1)
+}
+
 func (m *MockExperienceRepository) GetExperiences(ctx context.Context, filters repository.ExperienceFilters) ([]*models.Experience, error) {
 	args := m.Called(ctx, filters)
 	experiences, _ := args.Get(0).([]*models.Experience)
@@ -64,6 +84,12 @@ type MockSkillRepository struct {
 	mock.Mock
 }
 
+func (m *MockSkillRepository) GetMaxUpdatedAt(ctx context.Context) (*time.Time, error) {
+	args := m.Called(ctx)
+	updatedAt, _ := args.Get(0).(*time.Time)
+	return updatedAt, args.Error(1)
+}
+
 func (m *MockSkillRepository) GetSkills(ctx context.Context, filters repository.SkillFilters) ([]*models.Skill, error) {
 	args := m.Called(ctx, filters)
 	skills, _ := args.Get(0).([]*models.Skill)
@@ -76,12 +102,24 @@ func (m *MockSkillRepository) GetSkillsByCategory(ctx context.Context, category
 	return skills, args.Error(1)
 }
 
-func (m *MockSkillRepository) GetFeaturedSkills(ctx context.Context) ([]*models.Skill, error) {
-	args := m.Called(ctx)
+func (m *MockSkillRepository) GetSkillByName(ctx context.Context, name string) (*models.Skill, error) {
+	args := m.Called(ctx, name)
+	skill, _ := args.Get(0).(*models.Skill)
+	return skill, args.Error(1)
+}
+
+func (m *MockSkillRepository) GetFeaturedSkills(ctx context.Context, filters repository.SkillFilters) ([]*models.Skill, error) {
+	args := m.Called(ctx, filters)
 	skills, _ := args.Get(0).([]*models.Skill)
 	return skills, args.Error(1)
 }
 
+func (m *MockSkillRepository) GetSkillLevelHistogram(ctx context.Context) (*models.SkillLevelHistogram, error) {
+	args := m.Called(ctx)
+	histogram, _ := args.Get(0).(*models.SkillLevelHistogram)
+	return histogram, args.Error(1)
+}
+
 func (m *MockSkillRepository) CreateSkill(ctx context.Context, skill *models.Skill) error {
 	return m.Called(ctx, skill).Error(0)
 }
@@ -94,18 +132,29 @@ func (m *MockSkillRepository) DeleteSkill(ctx context.Context, id int) error {
 	return m.Called(ctx, id).Error(0)
 }
 
+func (m *MockSkillRepository) RenameSkillCategory(ctx context.Context, from, to string) (int64, error) {
+	args := m.Called(ctx, from, to)
+	return args.Get(0).(int64), args.Error(1)
+}
+
 type MockAchievementRepository struct {
 	mock.Mock
 }
 
+func (m *MockAchievementRepository) GetMaxUpdatedAt(ctx context.Context) (*time.Time, error) {
+	args := m.Called(ctx)
+	updatedAt, _ := args.Get(0).(*time.Time)
+	return updatedAt, args.Error(1)
+}
+
 func (m *MockAchievementRepository) GetAchievements(ctx context.Context, filters repository.AchievementFilters) ([]*models.Achievement, error) {
 	args := m.Called(ctx, filters)
 	achievements, _ := args.Get(0).([]*models.Achievement)
 	return achievements, args.Error(1)
 }
 
-func (m *MockAchievementRepository) GetFeaturedAchievements(ctx context.Context) ([]*models.Achievement, error) {
-	args := m.Called(ctx)
+func (m *MockAchievementRepository) GetFeaturedAchievements(ctx context.Context, filters repository.AchievementFilters) ([]*models.Achievement, error) {
+	args := m.Called(ctx, filters)
 	achievements, _ := args.Get(0).([]*models.Achievement)
 	return achievements, args.Error(1)
 }
@@ -126,6 +175,12 @@ type MockEducationRepository struct {
 	mock.Mock
 }
 
+func (m *MockEducationRepository) GetMaxUpdatedAt(ctx context.Context) (*time.Time, error) {
+	args := m.Called(ctx)
+	updatedAt, _ := args.Get(0).(*time.Time)
+	return updatedAt, args.Error(1)
+}
+
 func (m *MockEducationRepository) GetEducation(ctx context.Context, filters repository.EducationFilters) ([]*models.Education, error) {
 	args := m.Called(ctx, filters)
 	education, _ := args.Get(0).([]*models.Education)
@@ -138,12 +193,24 @@ func (m *MockEducationRepository) GetEducationByType(ctx context.Context, eduTyp
 	return education, args.Error(1)
 }
 
-func (m *MockEducationRepository) GetFeaturedEducation(ctx context.Context) ([]*models.Education, error) {
-	args := m.Called(ctx)
+func (m *MockEducationRepository) GetFeaturedEducation(ctx context.Context, filters repository.EducationFilters) ([]*models.Education, error) {
+	args := m.Called(ctx, filters)
 	education, _ := args.Get(0).([]*models.Education)
 	return education, args.Error(1)
 }
 
+func (m *MockEducationRepository) GetInstitutions(ctx context.Context) ([]*models.InstitutionCount, error) {
+	args := m.Called(ctx)
+	institutions, _ := args.Get(0).([]*models.InstitutionCount)
+	return institutions, args.Error(1)
+}
+
+func (m *MockEducationRepository) GetEducationByCredentialID(ctx context.Context, credentialID string) (*models.Education, error) {
+	args := m.Called(ctx, credentialID)
+	education, _ := args.Get(0).(*models.Education)
+	return education, args.Error(1)
+}
+
 func (m *MockEducationRepository) CreateEducation(ctx context.Context, education *models.Education) error {
 	return m.Called(ctx, education).Error(0)
 }
@@ -160,6 +227,12 @@ type MockProjectRepository struct {
 	mock.Mock
 }
 
+func (m *MockProjectRepository) GetMaxUpdatedAt(ctx context.Context) (*time.Time, error) {
+	args := m.Called(ctx)
+	updatedAt, _ := args.Get(0).(*time.Time)
+	return updatedAt, args.Error(1)
+}
+
 func (m *MockProjectRepository) GetProjects(ctx context.Context, filters repository.ProjectFilters) ([]*models.Project, error) {
 	args := m.Called(ctx, filters)
 	projects, _ := args.Get(0).([]*models.Project)
@@ -172,12 +245,18 @@ func (m *MockProjectRepository) GetProjectByID(ctx context.Context, id int) (*mo
 	return project, args.Error(1)
 }
 
-func (m *MockProjectRepository) GetFeaturedProjects(ctx context.Context) ([]*models.Project, error) {
-	args := m.Called(ctx)
+func (m *MockProjectRepository) GetFeaturedProjects(ctx context.Context, filters repository.ProjectFilters) ([]*models.Project, error) {
+	args := m.Called(ctx, filters)
 	projects, _ := args.Get(0).([]*models.Project)
 	return projects, args.Error(1)
 }
 
+func (m *MockProjectRepository) GetSimilarProjects(ctx context.Context, id int, limit int) ([]*models.SimilarProject, error) {
+	args := m.Called(ctx, id, limit)
+	projects, _ := args.Get(0).([]*models.SimilarProject)
+	return projects, args.Error(1)
+}
+
 func (m *MockProjectRepository) CreateProject(ctx context.Context, project *models.Project) error {
 	return m.Called(ctx, project).Error(0)
 }
@@ -190,6 +269,16 @@ func (m *MockProjectRepository) DeleteProject(ctx context.Context, id int) error
 	return m.Called(ctx, id).Error(0)
 }
 
+func (m *MockProjectRepository) UpsertProject(ctx context.Context, project *models.Project) (bool, error) {
+	args := m.Called(ctx, project)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockProjectRepository) DeleteAllProjects(ctx context.Context) (int64, error) {
+	args := m.Called(ctx)
+	return args.Get(0).(int64), args.Error(1)
+}
+
 func TestResumeService(t *testing.T) {
 	ctx := context.Background()
 
@@ -224,6 +313,55 @@ func TestResumeService(t *testing.T) {
 		mockProfileRepo.AssertExpectations(t)
 	})
 
+	t.Run("GetProfileDiff_Success", func(t *testing.T) {
+		mockProfileRepo := new(MockProfileRepository)
+		mockRepos := repository.Repositories{Profile: mockProfileRepo}
+		service := NewResumeService(mockRepos)
+
+		// Engineer until March, Senior Engineer until June, then Staff Engineer
+		march := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+		june := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+		github := "github.com/johndoe"
+		current := &models.Profile{ID: 1, Name: "John Doe", Title: "Staff Engineer", Email: "john@example.com", GitHub: &github, UpdatedAt: june}
+		history := []*models.ProfileVersion{
+			{ProfileID: 1, Name: "John Doe", Title: "Senior Engineer", Email: "john@example.com", UpdatedAt: march, ReplacedAt: june},
+			{ProfileID: 1, Name: "John Doe", Title: "Engineer", Email: "john@example.com", UpdatedAt: march.AddDate(-1, 0, 0), ReplacedAt: march},
+		}
+		mockProfileRepo.On("GetProfile", ctx).Return(current, nil)
+		mockProfileRepo.On("GetProfileHistory", ctx).Return(history, nil)
+
+		from, to := march.AddDate(0, -1, 0), june.AddDate(0, 1, 0)
+		diff, err := service.GetProfileDiff(ctx, from, to)
+
+		require.NoError(t, err)
+		assert.Equal(t, from, diff.From)
+		assert.Equal(t, to, diff.To)
+		oldTitle, newTitle := "Engineer", "Staff Engineer"
+		assert.Equal(t, []models.ProfileFieldChange{
+			{Field: "title", Old: &oldTitle, New: &newTitle},
+			{Field: "github", Old: nil, New: &github},
+		}, diff.Changes)
+
+		// Between two points within the same version nothing changed
+		diff, err = service.GetProfileDiff(ctx, march, march.AddDate(0, 1, 0))
+		require.NoError(t, err)
+		assert.Empty(t, diff.Changes)
+		mockProfileRepo.AssertExpectations(t)
+	})
+
+	t.Run("GetProfileDiff_NoProfile", func(t *testing.T) {
+		mockProfileRepo := new(MockProfileRepository)
+		mockRepos := repository.Repositories{Profile: mockProfileRepo}
+		service := NewResumeService(mockRepos)
+
+		mockProfileRepo.On("GetProfile", ctx).Return(nil, repository.ErrNotFound)
+
+		diff, err := service.GetProfileDiff(ctx, time.Now().Add(-time.Hour), time.Now())
+
+		assert.ErrorIs(t, err, repository.ErrNotFound)
+		assert.Nil(t, diff)
+	})
+
 	t.Run("GetExperiences_Success", func(t *testing.T) {
 		mockExperienceRepo := new(MockExperienceRepository)
 		mockRepos := repository.Repositories{Experience: mockExperienceRepo}
@@ -304,4 +442,395 @@ func TestResumeService(t *testing.T) {
 		assert.Equal(t, expectedProjects, projects)
 		mockProjectRepo.AssertExpectations(t)
 	})
+
+	t.Run("GetProjects_FeaturedPresent", func(t *testing.T) {
+		mockProjectRepo := new(MockProjectRepository)
+		mockRepos := repository.Repositories{Project: mockProjectRepo}
+		service := NewResumeService(mockRepos)
+
+		featured := true
+		filters := repository.ProjectFilters{Featured: &featured, Fallback: repository.FallbackRecent}
+		expectedProjects := []*models.Project{{ID: 1, Name: "Featured Project", IsFeatured: true}}
+		mockProjectRepo.On("GetProjects", ctx, filters).Return(expectedProjects, nil).Once()
+
+		projects, err := service.GetProjects(ctx, filters)
+
+		assert.NoError(t, err)
+		assert.Equal(t, expectedProjects, projects)
+		mockProjectRepo.AssertExpectations(t)
+		mockProjectRepo.AssertNumberOfCalls(t, "GetProjects", 1)
+	})
+
+	t.Run("GetProjects_FeaturedFallbackRecent", func(t *testing.T) {
+		mockProjectRepo := new(MockProjectRepository)
+		mockRepos := repository.Repositories{Project: mockProjectRepo}
+		service := NewResumeService(mockRepos)
+
+		featured := true
+		filters := repository.ProjectFilters{Featured: &featured, Fallback: repository.FallbackRecent}
+		fallbackFilters := repository.ProjectFilters{Fallback: repository.FallbackRecent, Limit: featuredFallbackLimit, Sort: repository.SortRecent}
+		expectedProjects := []*models.Project{{ID: 2, Name: "Recent Project"}}
+		mockProjectRepo.On("GetProjects", ctx, filters).Return([]*models.Project{}, nil).Once()
+		mockProjectRepo.On("GetProjects", ctx, fallbackFilters).Return(expectedProjects, nil).Once()
+
+		projects, err := service.GetProjects(ctx, filters)
+
+		assert.NoError(t, err)
+		assert.Equal(t, expectedProjects, projects)
+		mockProjectRepo.AssertExpectations(t)
+	})
+
+	t.Run("GetProjects_FeaturedEmptyWithoutFallback", func(t *testing.T) {
+		mockProjectRepo := new(MockProjectRepository)
+		mockRepos := repository.Repositories{Project: mockProjectRepo}
+		service := NewResumeService(mockRepos)
+
+		featured := true
+		filters := repository.ProjectFilters{Featured: &featured}
+		mockProjectRepo.On("GetProjects", ctx, filters).Return([]*models.Project{}, nil).Once()
+
+		projects, err := service.GetProjects(ctx, filters)
+
+		assert.NoError(t, err)
+		assert.Empty(t, projects)
+		mockProjectRepo.AssertNumberOfCalls(t, "GetProjects", 1)
+	})
+
+	t.Run("GetSkillProjects_Success", func(t *testing.T) {
+		mockSkillRepo := new(MockSkillRepository)
+		mockProjectRepo := new(MockProjectRepository)
+		mockRepos := repository.Repositories{Skill: mockSkillRepo, Project: mockProjectRepo}
+		service := NewResumeService(mockRepos)
+
+		// The lookup ignores case, and projects are matched on the stored name
+		mockSkillRepo.On("GetSkillByName", ctx, "go").Return(&models.Skill{ID: 1, Name: "Go"}, nil)
+		expectedProjects := []*models.Project{{ID: 1, Name: "Test Project", Technologies: []string{"Go"}}}
+		mockProjectRepo.On("GetProjects", ctx, repository.ProjectFilters{Technology: "Go"}).Return(expectedProjects, nil)
+
+		projects, err := service.GetSkillProjects(ctx, "go")
+
+		assert.NoError(t, err)
+		assert.Equal(t, expectedProjects, projects)
+		mockSkillRepo.AssertExpectations(t)
+		mockProjectRepo.AssertExpectations(t)
+	})
+
+	t.Run("GetSkillProjects_UnknownSkill", func(t *testing.T) {
+		mockSkillRepo := new(MockSkillRepository)
+		mockProjectRepo := new(MockProjectRepository)
+		mockRepos := repository.Repositories{Skill: mockSkillRepo, Project: mockProjectRepo}
+		service := NewResumeService(mockRepos)
+
+		mockSkillRepo.On("GetSkillByName", ctx, "COBOL").Return(nil, repository.ErrNotFound)
+
+		projects, err := service.GetSkillProjects(ctx, "COBOL")
+
+		assert.ErrorIs(t, err, repository.ErrNotFound)
+		assert.Nil(t, projects)
+		mockProjectRepo.AssertNotCalled(t, "GetProjects", mock.Anything, mock.Anything)
+	})
+
+	t.Run("GetSkills_FeaturedFallbackRecent", func(t *testing.T) {
+		mockSkillRepo := new(MockSkillRepository)
+		mockRepos := repository.Repositories{Skill: mockSkillRepo}
+		service := NewResumeService(mockRepos)
+
+		featured := true
+		filters := repository.SkillFilters{Featured: &featured, Fallback: repository.FallbackRecent, Limit: 5}
+		fallbackFilters := repository.SkillFilters{Fallback: repository.FallbackRecent, Limit: 5, Sort: repository.SortRecent}
+		expectedSkills := []*models.Skill{{ID: 1, Name: "Go"}}
+		mockSkillRepo.On("GetSkills", ctx, filters).Return(nil, nil).Once()
+		mockSkillRepo.On("GetSkills", ctx, fallbackFilters).Return(expectedSkills, nil).Once()
+
+		skills, err := service.GetSkills(ctx, filters)
+
+		assert.NoError(t, err)
+		assert.Equal(t, expectedSkills, skills)
+		mockSkillRepo.AssertExpectations(t)
+	})
+
+	t.Run("GetSkills_FeaturedEmptyPastFirstPage", func(t *testing.T) {
+		mockSkillRepo := new(MockSkillRepository)
+		mockRepos := repository.Repositories{Skill: mockSkillRepo}
+		service := NewResumeService(mockRepos)
+
+		// Paging past the last featured skill doesn't fall back to recent ones
+		featured := true
+		filters := repository.SkillFilters{Featured: &featured, Fallback: repository.FallbackRecent, Limit: 5, Offset: 10}
+		mockSkillRepo.On("GetSkills", ctx, filters).Return([]*models.Skill{}, nil).Once()
+
+		skills, err := service.GetSkills(ctx, filters)
+
+		assert.NoError(t, err)
+		assert.Empty(t, skills)
+		mockSkillRepo.AssertExpectations(t)
+	})
+
+	t.Run("GetStats_Success", func(t *testing.T) {
+		mockExperienceRepo := new(MockExperienceRepository)
+		mockSkillRepo := new(MockSkillRepository)
+		mockProjectRepo := new(MockProjectRepository)
+		mockEducationRepo := new(MockEducationRepository)
+		mockRepos := repository.Repositories{
+			Experience: mockExperienceRepo,
+			Skill:      mockSkillRepo,
+			Project:    mockProjectRepo,
+			Education:  mockEducationRepo,
+		}
+		service := NewResumeService(mockRepos)
+
+		now := time.Now()
+		experiences := []*models.Experience{
+			{ID: 1, StartDate: now.AddDate(-2, 0, 0)},
+			{ID: 2, StartDate: now.AddDate(-6, -1, 0)}, // Earliest role
+		}
+		certifications := []*models.Education{
+			{ID: 1, Type: models.EducationTypeCertification},
+			{ID: 2, Type: models.EducationTypeCertification},
+		}
+		mockExperienceRepo.On("GetExperiences", ctx, repository.ExperienceFilters{}).Return(experiences, nil)
+		mockSkillRepo.On("GetSkills", ctx, repository.SkillFilters{}).Return([]*models.Skill{{ID: 1}, {ID: 2}, {ID: 3}}, nil)
+		mockProjectRepo.On("GetProjects", ctx, repository.ProjectFilters{}).Return([]*models.Project{{ID: 1}}, nil)
+		mockEducationRepo.On("GetEducation", ctx, repository.EducationFilters{Type: models.EducationTypeCertification}).Return(certifications, nil)
+
+		stats, err := service.GetStats(ctx)
+
+		assert.NoError(t, err)
+		assert.Equal(t, &models.Stats{YearsExperience: 6, Skills: 3, Projects: 1, Certifications: 2}, stats)
+		mockExperienceRepo.AssertExpectations(t)
+		mockSkillRepo.AssertExpectations(t)
+		mockProjectRepo.AssertExpectations(t)
+		mockEducationRepo.AssertExpectations(t)
+	})
+
+	t.Run("GetStats_NoData", func(t *testing.T) {
+		mockExperienceRepo := new(MockExperienceRepository)
+		mockSkillRepo := new(MockSkillRepository)
+		mockProjectRepo := new(MockProjectRepository)
+		mockEducationRepo := new(MockEducationRepository)
+		mockRepos := repository.Repositories{
+			Experience: mockExperienceRepo,
+			Skill:      mockSkillRepo,
+			Project:    mockProjectRepo,
+			Education:  mockEducationRepo,
+		}
+		service := NewResumeService(mockRepos)
+
+		mockExperienceRepo.On("GetExperiences", ctx, mock.Anything).Return([]*models.Experience{}, nil)
+		mockSkillRepo.On("GetSkills", ctx, mock.Anything).Return([]*models.Skill{}, nil)
+		mockProjectRepo.On("GetProjects", ctx, mock.Anything).Return([]*models.Project{}, nil)
+		mockEducationRepo.On("GetEducation", ctx, mock.Anything).Return([]*models.Education{}, nil)
+
+		stats, err := service.GetStats(ctx)
+
+		assert.NoError(t, err)
+		assert.Equal(t, &models.Stats{}, stats)
+	})
+
+	t.Run("GetStats_Error", func(t *testing.T) {
+		mockExperienceRepo := new(MockExperienceRepository)
+		mockSkillRepo := new(MockSkillRepository)
+		mockRepos := repository.Repositories{Experience: mockExperienceRepo, Skill: mockSkillRepo}
+		service := NewResumeService(mockRepos)
+
+		expectedError := errors.New("database error")
+		mockExperienceRepo.On("GetExperiences", ctx, mock.Anything).Return([]*models.Experience{}, nil)
+		mockSkillRepo.On("GetSkills", ctx, mock.Anything).Return(nil, expectedError)
+
+		stats, err := service.GetStats(ctx)
+
+		assert.Equal(t, expectedError, err)
+		assert.Nil(t, stats)
+	})
+
+	t.Run("GetMeta_Success", func(t *testing.T) {
+		mockProfileRepo := new(MockProfileRepository)
+		mockExperienceRepo := new(MockExperienceRepository)
+		mockSkillRepo := new(MockSkillRepository)
+		mockAchievementRepo := new(MockAchievementRepository)
+		mockEducationRepo := new(MockEducationRepository)
+		mockProjectRepo := new(MockProjectRepository)
+		mockRepos := repository.Repositories{
+			Profile:     mockProfileRepo,
+			Experience:  mockExperienceRepo,
+			Skill:       mockSkillRepo,
+			Achievement: mockAchievementRepo,
+			Education:   mockEducationRepo,
+			Project:     mockProjectRepo,
+		}
+		service := NewResumeService(mockRepos)
+
+		profileUpdated := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+		skillsUpdated := time.Date(2024, 5, 10, 8, 30, 0, 0, time.UTC)
+		mockProfileRepo.On("GetMaxUpdatedAt", ctx).Return(&profileUpdated, nil)
+		mockExperienceRepo.On("GetMaxUpdatedAt", ctx).Return(nil, nil)
+		mockSkillRepo.On("GetMaxUpdatedAt", ctx).Return(&skillsUpdated, nil).Once()
+		mockAchievementRepo.On("GetMaxUpdatedAt", ctx).Return(nil, nil)
+		mockEducationRepo.On("GetMaxUpdatedAt", ctx).Return(nil, nil)
+		mockProjectRepo.On("GetMaxUpdatedAt", ctx).Return(nil, nil)
+
+		meta, err := service.GetMeta(ctx)
+
+		require.NoError(t, err)
+		assert.Equal(t, &profileUpdated, meta.ProfileUpdatedAt)
+		assert.Equal(t, &skillsUpdated, meta.SkillsUpdatedAt)
+		assert.Nil(t, meta.ExperiencesUpdatedAt)
+		assert.Nil(t, meta.ProjectsUpdatedAt)
+		assert.NotEmpty(t, meta.ETag)
+
+		// The etag is stable while nothing changes and moves with any section
+		mockSkillRepo.On("GetMaxUpdatedAt", ctx).Return(&skillsUpdated, nil).Once()
+		unchanged, err := service.GetMeta(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, meta.ETag, unchanged.ETag)
+
+		skillsUpdatedAgain := skillsUpdated.Add(time.Second)
+		mockSkillRepo.On("GetMaxUpdatedAt", ctx).Return(&skillsUpdatedAgain, nil).Once()
+		changed, err := service.GetMeta(ctx)
+		require.NoError(t, err)
+		assert.NotEqual(t, meta.ETag, changed.ETag)
+	})
+
+	t.Run("GetMeta_Error", func(t *testing.T) {
+		mockProfileRepo := new(MockProfileRepository)
+		mockRepos := repository.Repositories{
+			Profile:     mockProfileRepo,
+			Experience:  new(MockExperienceRepository),
+			Skill:       new(MockSkillRepository),
+			Achievement: new(MockAchievementRepository),
+			Education:   new(MockEducationRepository),
+			Project:     new(MockProjectRepository),
+		}
+		service := NewResumeService(mockRepos)
+
+		expectedError := errors.New("database error")
+		mockProfileRepo.On("GetMaxUpdatedAt", ctx).Return(nil, expectedError)
+
+		meta, err := service.GetMeta(ctx)
+
+		assert.Equal(t, expectedError, err)
+		assert.Nil(t, meta)
+	})
+
+	t.Run("GetCompleteness_Sparse", func(t *testing.T) {
+		mockProfileRepo := new(MockProfileRepository)
+		mockExperienceRepo := new(MockExperienceRepository)
+		mockSkillRepo := new(MockSkillRepository)
+		mockAchievementRepo := new(MockAchievementRepository)
+		mockEducationRepo := new(MockEducationRepository)
+		mockProjectRepo := new(MockProjectRepository)
+		mockRepos := repository.Repositories{
+			Profile:     mockProfileRepo,
+			Experience:  mockExperienceRepo,
+			Skill:       mockSkillRepo,
+			Achievement: mockAchievementRepo,
+			Education:   mockEducationRepo,
+			Project:     mockProjectRepo,
+		}
+		service := NewResumeService(mockRepos)
+
+		empty := ""
+		github := "https://github.com/johndoe"
+		profile := &models.Profile{ID: 1, Name: "John Doe", Summary: &empty, GitHub: &github}
+		mockProfileRepo.On("GetProfile", ctx).Return(profile, nil)
+		mockExperienceRepo.On("GetExperiences", ctx, repository.ExperienceFilters{Limit: 1}).Return([]*models.Experience{{ID: 1}}, nil)
+		mockSkillRepo.On("GetSkills", ctx, repository.SkillFilters{Limit: 1}).Return([]*models.Skill{}, nil)
+		mockAchievementRepo.On("GetAchievements", ctx, repository.AchievementFilters{Limit: 1}).Return([]*models.Achievement{}, nil)
+		mockEducationRepo.On("GetEducation", ctx, repository.EducationFilters{Limit: 1}).Return([]*models.Education{}, nil)
+		mockProjectRepo.On("GetProjects", ctx, repository.ProjectFilters{Limit: 1}).Return([]*models.Project{}, nil)
+
+		completeness, err := service.GetCompleteness(ctx)
+
+		require.NoError(t, err)
+		assert.Equal(t, &models.Completeness{
+			Score:   22,
+			Missing: []string{"summary", "location", "phone", "skills", "achievements", "education", "projects"},
+		}, completeness)
+		mockProfileRepo.AssertExpectations(t)
+		mockExperienceRepo.AssertExpectations(t)
+		mockSkillRepo.AssertExpectations(t)
+		mockAchievementRepo.AssertExpectations(t)
+		mockEducationRepo.AssertExpectations(t)
+		mockProjectRepo.AssertExpectations(t)
+	})
+
+	t.Run("GetCompleteness_Complete", func(t *testing.T) {
+		mockProfileRepo := new(MockProfileRepository)
+		mockExperienceRepo := new(MockExperienceRepository)
+		mockSkillRepo := new(MockSkillRepository)
+		mockAchievementRepo := new(MockAchievementRepository)
+		mockEducationRepo := new(MockEducationRepository)
+		mockProjectRepo := new(MockProjectRepository)
+		mockRepos := repository.Repositories{
+			Profile:     mockProfileRepo,
+			Experience:  mockExperienceRepo,
+			Skill:       mockSkillRepo,
+			Achievement: mockAchievementRepo,
+			Education:   mockEducationRepo,
+			Project:     mockProjectRepo,
+		}
+		service := NewResumeService(mockRepos)
+
+		summary := "Backend engineer"
+		location := "Amsterdam"
+		phone := "+31 6 12345678"
+		linkedIn := "https://linkedin.com/in/johndoe"
+		profile := &models.Profile{ID: 1, Name: "John Doe", Summary: &summary, Location: &location, Phone: &phone, LinkedIn: &linkedIn}
+		mockProfileRepo.On("GetProfile", ctx).Return(profile, nil)
+		mockExperienceRepo.On("GetExperiences", ctx, mock.Anything).Return([]*models.Experience{{ID: 1}}, nil)
+		mockSkillRepo.On("GetSkills", ctx, mock.Anything).Return([]*models.Skill{{ID: 1}}, nil)
+		mockAchievementRepo.On("GetAchievements", ctx, mock.Anything).Return([]*models.Achievement{{ID: 1}}, nil)
+		mockEducationRepo.On("GetEducation", ctx, mock.Anything).Return([]*models.Education{{ID: 1}}, nil)
+		mockProjectRepo.On("GetProjects", ctx, mock.Anything).Return([]*models.Project{{ID: 1}}, nil)
+
+		completeness, err := service.GetCompleteness(ctx)
+
+		require.NoError(t, err)
+		assert.Equal(t, &models.Completeness{Score: 100, Missing: []string{}}, completeness)
+	})
+
+	t.Run("GetCompleteness_NoProfile", func(t *testing.T) {
+		mockProfileRepo := new(MockProfileRepository)
+		mockExperienceRepo := new(MockExperienceRepository)
+		mockSkillRepo := new(MockSkillRepository)
+		mockAchievementRepo := new(MockAchievementRepository)
+		mockEducationRepo := new(MockEducationRepository)
+		mockProjectRepo := new(MockProjectRepository)
+		mockRepos := repository.Repositories{
+			Profile:     mockProfileRepo,
+			Experience:  mockExperienceRepo,
+			Skill:       mockSkillRepo,
+			Achievement: mockAchievementRepo,
+			Education:   mockEducationRepo,
+			Project:     mockProjectRepo,
+		}
+		service := NewResumeService(mockRepos)
+
+		mockProfileRepo.On("GetProfile", ctx).Return(nil, repository.ErrNotFound)
+		mockExperienceRepo.On("GetExperiences", ctx, mock.Anything).Return([]*models.Experience{}, nil)
+		mockSkillRepo.On("GetSkills", ctx, mock.Anything).Return([]*models.Skill{}, nil)
+		mockAchievementRepo.On("GetAchievements", ctx, mock.Anything).Return([]*models.Achievement{}, nil)
+		mockEducationRepo.On("GetEducation", ctx, mock.Anything).Return([]*models.Education{}, nil)
+		mockProjectRepo.On("GetProjects", ctx, mock.Anything).Return([]*models.Project{}, nil)
+
+		completeness, err := service.GetCompleteness(ctx)
+
+		require.NoError(t, err)
+		assert.Equal(t, 0, completeness.Score)
+		assert.Len(t, completeness.Missing, 9)
+	})
+
+	t.Run("GetCompleteness_Error", func(t *testing.T) {
+		mockProfileRepo := new(MockProfileRepository)
+		mockRepos := repository.Repositories{Profile: mockProfileRepo}
+		service := NewResumeService(mockRepos)
+
+		expectedError := errors.New("database error")
+		mockProfileRepo.On("GetProfile", ctx).Return(nil, expectedError)
+
+		completeness, err := service.GetCompleteness(ctx)
+
+		assert.Equal(t, expectedError, err)
+		assert.Nil(t, completeness)
+	})
 }
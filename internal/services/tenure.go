@@ -0,0 +1,82 @@
+package services
+
+import (
+	"sort"
+	"time"
+
+	"github.com/npmulder/resume-api/internal/models"
+)
+
+// monthSpan is an inclusive range of calendar months, indexed as year*12 + month
+type monthSpan struct {
+	start, end int
+}
+
+// monthIndex converts a date to its calendar month index
+func monthIndex(t time.Time) int {
+	return t.Year()*12 + int(t.Month()) - 1
+}
+
+// countMonths returns the number of distinct calendar months covered by spans
+func countMonths(spans []monthSpan) int {
+	sort.Slice(spans, func(i, j int) bool { return spans[i].start < spans[j].start })
+
+	total := 0
+	current := -1
+	for _, span := range spans {
+		if span.start <= current {
+			if span.end > current {
+				total += span.end - current
+				current = span.end
+			}
+			continue
+		}
+		total += span.end - span.start + 1
+		current = span.end
+	}
+	return total
+}
+
+// summarizeTenure computes the tenure summary for the given experiences.
+// Roles are counted in whole calendar months, inclusive of the start and end
+// month, and current roles run until now.
+func summarizeTenure(experiences []*models.Experience, now time.Time) *models.TenureSummary {
+	var all []monthSpan
+	byCompany := make(map[string][]monthSpan)
+	for _, e := range experiences {
+		end := now
+		if e.EndDate != nil {
+			end = *e.EndDate
+		}
+		span := monthSpan{start: monthIndex(e.StartDate), end: monthIndex(end)}
+		if span.end < span.start {
+			continue
+		}
+		all = append(all, span)
+		byCompany[e.Company] = append(byCompany[e.Company], span)
+	}
+
+	summary := &models.TenureSummary{
+		TotalMonths: countMonths(all),
+		Companies:   make([]*models.CompanyTenure, 0, len(byCompany)),
+	}
+
+	companyMonths := 0
+	for company, spans := range byCompany {
+		months := countMonths(spans)
+		companyMonths += months
+		summary.Companies = append(summary.Companies, &models.CompanyTenure{Company: company, Months: months})
+	}
+	sort.Slice(summary.Companies, func(i, j int) bool {
+		if summary.Companies[i].Months != summary.Companies[j].Months {
+			return summary.Companies[i].Months > summary.Companies[j].Months
+		}
+		return summary.Companies[i].Company < summary.Companies[j].Company
+	})
+
+	if len(summary.Companies) > 0 {
+		summary.AverageTenureMonths = float64(companyMonths) / float64(len(summary.Companies))
+	}
+
+	return summary
+}
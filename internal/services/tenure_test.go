@@ -0,0 +1,75 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/npmulder/resume-api/internal/models"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func datePtr(year int, month time.Month, day int) *time.Time {
+	t := date(year, month, day)
+	return &t
+}
+
+func TestSummarizeTenure(t *testing.T) {
+	now := date(2024, time.June, 15)
+
+	t.Run("no experiences", func(t *testing.T) {
+		summary := summarizeTenure(nil, now)
+		assert.Equal(t, 0, summary.TotalMonths)
+		assert.Equal(t, 0.0, summary.AverageTenureMonths)
+		assert.Empty(t, summary.Companies)
+	})
+
+	t.Run("single month role", func(t *testing.T) {
+		experiences := []*models.Experience{
+			{Company: "Acme", StartDate: date(2020, time.March, 1), EndDate: datePtr(2020, time.March, 31)},
+		}
+
+		summary := summarizeTenure(experiences, now)
+		assert.Equal(t, 1, summary.TotalMonths)
+		assert.Equal(t, 1.0, summary.AverageTenureMonths)
+		require.Len(t, summary.Companies, 1)
+		assert.Equal(t, &models.CompanyTenure{Company: "Acme", Months: 1}, summary.Companies[0])
+	})
+
+	t.Run("current role runs until now", func(t *testing.T) {
+		experiences := []*models.Experience{
+			{Company: "Acme", StartDate: date(2024, time.January, 10)},
+		}
+
+		summary := summarizeTenure(experiences, now)
+		assert.Equal(t, 6, summary.TotalMonths)
+		require.Len(t, summary.Companies, 1)
+		assert.Equal(t, 6, summary.Companies[0].Months)
+	})
+
+	t.Run("overlapping roles", func(t *testing.T) {
+		experiences := []*models.Experience{
+			// Two roles at the same company overlapping by three months
+			{Company: "Acme", StartDate: date(2018, time.January, 1), EndDate: datePtr(2018, time.December, 31)},
+			{Company: "Acme", StartDate: date(2018, time.October, 1), EndDate: datePtr(2019, time.June, 30)},
+			// A side role overlapping the Acme roles, plus a later current role
+			{Company: "Side Gig", StartDate: date(2019, time.January, 1), EndDate: datePtr(2019, time.December, 31)},
+			{Company: "Globex", StartDate: date(2023, time.July, 1)},
+		}
+
+		summary := summarizeTenure(experiences, now)
+
+		// 2018-01..2019-12 (24) + 2023-07..2024-06 (12)
+		assert.Equal(t, 36, summary.TotalMonths)
+		require.Len(t, summary.Companies, 3)
+		assert.Equal(t, &models.CompanyTenure{Company: "Acme", Months: 18}, summary.Companies[0])
+		assert.Equal(t, &models.CompanyTenure{Company: "Globex", Months: 12}, summary.Companies[1])
+		assert.Equal(t, &models.CompanyTenure{Company: "Side Gig", Months: 12}, summary.Companies[2])
+		assert.Equal(t, 14.0, summary.AverageTenureMonths)
+	})
+}
@@ -3,14 +3,45 @@ package utils
 import (
 	"context"
 	"errors"
+	"html/template"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/render"
 	"github.com/npmulder/resume-api/internal/models"
 	"github.com/npmulder/resume-api/internal/repository"
 )
 
-// ErrorResponse sends a standardized error response to the client
+// MIMEYAMLStandard is the registered YAML media type; gin only knows the
+// older application/x-yaml
+const MIMEYAMLStandard = "application/yaml"
+
+// errorFormats are the media types errors can be rendered as, in the order
+// used when the Accept header allows several
+var errorFormats = []string{gin.MIMEJSON, gin.MIMEYAML, MIMEYAMLStandard, gin.MIMEHTML}
+
+// errorPage renders an APIError for clients that asked for HTML
+var errorPage = template.Must(template.New("error").Funcs(template.FuncMap{
+	"statusText": http.StatusText,
+}).Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>{{.Status}} {{statusText .Status}}</title>
+</head>
+<body>
+<h1>{{.Status}} {{statusText .Status}}</h1>
+<p>{{.Message}}</p>
+{{- with .Details}}
+<pre>{{.}}</pre>
+{{- end}}
+<p><small>{{.Code}}{{with .RequestID}} &middot; request {{.}}{{end}}</small></p>
+</body>
+</html>
+`))
+
+// ErrorResponse sends a standardized error response to the client, as JSON
+// unless the Accept header prefers YAML or HTML
 func ErrorResponse(c *gin.Context, status int, message string, opts ...models.APIErrorOption) {
 	// Add request path to the error
 	pathOpt := models.WithPath(c.Request.URL.Path)
@@ -26,10 +57,26 @@ func ErrorResponse(c *gin.Context, status int, message string, opts ...models.AP
 	apiError := models.NewAPIError(status, message, opts...)
 
 	// Send the response
-	c.JSON(status, apiError)
+	respondError(c, status, apiError)
 	c.Abort()
 }
 
+// respondError writes apiError in the format negotiated from the Accept
+// header, falling back to JSON when none of errorFormats is acceptable
+func respondError(c *gin.Context, status int, apiError *models.APIError) {
+	switch format := c.NegotiateFormat(errorFormats...); format {
+	case gin.MIMEYAML, MIMEYAMLStandard:
+		// gin only sets its own YAML content type when none is present, so
+		// the client gets back the type it asked for
+		c.Header("Content-Type", format+"; charset=utf-8")
+		c.YAML(status, apiError)
+	case gin.MIMEHTML:
+		c.Render(status, render.HTML{Template: errorPage, Data: apiError})
+	default:
+		Respond(c, status, apiError)
+	}
+}
+
 // HandleError handles common error types and returns an appropriate response
 func HandleError(c *gin.Context, err error) {
 	var repoErr *repository.RepositoryError
@@ -39,6 +86,23 @@ func HandleError(c *gin.Context, err error) {
 		ErrorResponse(c, http.StatusNotFound, "The requested resource was not found", 
 			models.WithCode(models.ErrCodeNotFound))
 
+	case errors.Is(err, repository.ErrConflict):
+		// Handle writes clashing with existing data
+		ErrorResponse(c, http.StatusConflict, "The change conflicts with existing data",
+			models.WithCode(models.ErrCodeConflict), models.WithDetails(err.Error()))
+
+	case errors.Is(err, repository.ErrPreconditionFailed):
+		// Handle conditional writes based on an outdated version
+		PreconditionFailed(c, "The resource has changed since it was read")
+
+	case errors.Is(err, models.ErrInvalidAchievementCategory):
+		// Handle invalid input rejected before reaching the database
+		BadRequest(c, "Invalid achievement category", err.Error())
+
+	case errors.Is(err, models.ErrTooManyItems):
+		// Handle list fields over their configured limits
+		BadRequest(c, "Too many items in a list field", err.Error())
+
 	case errors.As(err, &repoErr):
 		// Handle repository errors
 		ErrorResponse(c, http.StatusInternalServerError, "An error occurred while accessing the data",
@@ -107,3 +171,37 @@ func TooManyRequests(c *gin.Context, message string) {
 func ServiceUnavailable(c *gin.Context, message string) {
 	ErrorResponse(c, http.StatusServiceUnavailable, message, models.WithCode(models.ErrCodeServiceUnavailable))
 }
+
+// UnsupportedMediaType returns an unsupported media type error response
+func UnsupportedMediaType(c *gin.Context, message string) {
+	ErrorResponse(c, http.StatusUnsupportedMediaType, message, models.WithCode(models.ErrCodeUnsupportedMediaType))
+}
+
+// PreconditionFailed returns a precondition failed error response
+func PreconditionFailed(c *gin.Context, message string) {
+	ErrorResponse(c, http.StatusPreconditionFailed, message, models.WithCode(models.ErrCodePreconditionFailed))
+}
+
+// PreconditionRequired returns a precondition required error response
+func PreconditionRequired(c *gin.Context, message string) {
+	ErrorResponse(c, http.StatusPreconditionRequired, message, models.WithCode(models.ErrCodePreconditionRequired))
+}
+
+// URITooLong returns a URI too long error response
+func URITooLong(c *gin.Context, message string) {
+	ErrorResponse(c, http.StatusRequestURITooLong, message, models.WithCode(models.ErrCodeURITooLong))
+}
+
+// BadGateway returns a bad gateway error response, for failures of an upstream service
+func BadGateway(c *gin.Context, message string, details any) {
+	opts := []models.APIErrorOption{models.WithCode(models.ErrCodeBadGateway)}
+	if details != nil {
+		opts = append(opts, models.WithDetails(details))
+	}
+	ErrorResponse(c, http.StatusBadGateway, message, opts...)
+}
+
+// MethodNotAllowed returns a method not allowed error response
+func MethodNotAllowed(c *gin.Context, message string) {
+	ErrorResponse(c, http.StatusMethodNotAllowed, message, models.WithCode(models.ErrCodeMethodNotAllowed))
+}
@@ -0,0 +1,134 @@
+package utils
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+
+	"github.com/npmulder/resume-api/internal/models"
+	"github.com/npmulder/resume-api/internal/repository"
+)
+
+func TestHandleError(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name     string
+		err      error
+		expected int
+	}{
+		{
+			name:     "not found",
+			err:      repository.ErrNotFound,
+			expected: http.StatusNotFound,
+		},
+		{
+			name:     "not found wrapped in a repository error",
+			err:      repository.NewRepositoryError("get", "project", fmt.Errorf("project with id %d %w", 999, repository.ErrNotFound)),
+			expected: http.StatusNotFound,
+		},
+		{
+			name:     "list field over its limit",
+			err:      repository.NewRepositoryError("create", "project", fmt.Errorf("%w: technologies has 31 items, the maximum is 30", models.ErrTooManyItems)),
+			expected: http.StatusBadRequest,
+		},
+		{
+			name:     "update of an outdated version",
+			err:      repository.NewRepositoryError("update", "profile", repository.ErrPreconditionFailed),
+			expected: http.StatusPreconditionFailed,
+		},
+		{
+			name:     "other repository error",
+			err:      repository.NewRepositoryError("get", "project", errors.New("connection refused")),
+			expected: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest(http.MethodGet, "/api/v1/projects/999", nil)
+
+			HandleError(c, tt.err)
+
+			assert.Equal(t, tt.expected, w.Code)
+		})
+	}
+}
+
+func TestErrorResponseNegotiatesContentType(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	serve := func(accept string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodGet, "/api/v1/resume.html", nil)
+		if accept != "" {
+			c.Request.Header.Set("Accept", accept)
+		}
+		c.Set("RequestID", "req-123")
+
+		NotFound(c, "Profile <not> found")
+		return w
+	}
+
+	t.Run("json", func(t *testing.T) {
+		w := serve("application/json")
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Equal(t, "application/json; charset=utf-8", w.Header().Get("Content-Type"))
+		var apiError models.APIError
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &apiError))
+		assert.Equal(t, "Profile <not> found", apiError.Message)
+		assert.Equal(t, models.ErrCodeNotFound, apiError.Code)
+	})
+
+	t.Run("json without accept header", func(t *testing.T) {
+		w := serve("")
+
+		assert.Equal(t, "application/json; charset=utf-8", w.Header().Get("Content-Type"))
+	})
+
+	t.Run("json for unsupported types", func(t *testing.T) {
+		w := serve("application/xml")
+
+		assert.Equal(t, "application/json; charset=utf-8", w.Header().Get("Content-Type"))
+	})
+
+	for _, accept := range []string{"application/yaml", "application/x-yaml"} {
+		t.Run("yaml as "+accept, func(t *testing.T) {
+			w := serve(accept)
+
+			assert.Equal(t, http.StatusNotFound, w.Code)
+			assert.Equal(t, accept+"; charset=utf-8", w.Header().Get("Content-Type"))
+			var body map[string]any
+			require.NoError(t, yaml.Unmarshal(w.Body.Bytes(), &body))
+			assert.Equal(t, 404, body["status"])
+			assert.Equal(t, models.ErrCodeNotFound, body["code"])
+			assert.Equal(t, "Profile <not> found", body["message"])
+			assert.Equal(t, "req-123", body["request_id"])
+			assert.Equal(t, "/api/v1/resume.html", body["path"])
+		})
+	}
+
+	t.Run("html", func(t *testing.T) {
+		// A typical browser Accept header
+		w := serve("text/html,application/xhtml+xml,application/xml;q=0.9,*/*;q=0.8")
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Equal(t, "text/html; charset=utf-8", w.Header().Get("Content-Type"))
+		body := w.Body.String()
+		assert.Contains(t, body, "<title>404 Not Found</title>")
+		assert.Contains(t, body, "Profile &lt;not&gt; found")
+		assert.Contains(t, body, "req-123")
+	})
+}
@@ -0,0 +1,54 @@
+package utils
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ETag returns a strong entity tag for a resource derived from its id and
+// last update time, so it changes whenever the resource is written
+func ETag(id int, updatedAt time.Time) string {
+	return fmt.Sprintf(`"%d-%x"`, id, updatedAt.UnixNano())
+}
+
+// ETagMatches reports whether an If-Match header value matches etag.
+// The header may list several tags or be "*", which matches any current
+// resource. Weak tags never match, as If-Match requires strong comparison.
+func ETagMatches(ifMatch, etag string) bool {
+	for _, candidate := range strings.Split(ifMatch, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || candidate == etag {
+			return true
+		}
+	}
+	return false
+}
+
+// IfMatchVersion returns the update time encoded by the first strong tag in
+// an If-Match header value that was issued by ETag for the resource with the
+// given id, so a write can be made conditional on that version. For "*" it
+// returns the zero time, meaning any stored version. It reports false when no
+// tag can match the resource.
+func IfMatchVersion(ifMatch string, id int) (time.Time, bool) {
+	for _, candidate := range strings.Split(ifMatch, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" {
+			return time.Time{}, true
+		}
+		if len(candidate) < 2 || candidate[0] != '"' || candidate[len(candidate)-1] != '"' {
+			continue
+		}
+		tagID, version, found := strings.Cut(candidate[1:len(candidate)-1], "-")
+		if !found || tagID != strconv.Itoa(id) {
+			continue
+		}
+		nanos, err := strconv.ParseInt(version, 16, 64)
+		if err != nil {
+			continue
+		}
+		return time.Unix(0, nanos).UTC(), true
+	}
+	return time.Time{}, false
+}
@@ -0,0 +1,53 @@
+package utils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestETag(t *testing.T) {
+	updatedAt := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	etag := ETag(1, updatedAt)
+	assert.Equal(t, etag, ETag(1, updatedAt), "the same version has the same tag")
+	assert.NotEqual(t, etag, ETag(1, updatedAt.Add(time.Microsecond)), "an update changes the tag")
+	assert.NotEqual(t, etag, ETag(2, updatedAt), "different resources have different tags")
+	assert.True(t, len(etag) > 2 && etag[0] == '"' && etag[len(etag)-1] == '"', "tag is quoted")
+}
+
+func TestETagMatches(t *testing.T) {
+	etag := ETag(1, time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC))
+
+	assert.True(t, ETagMatches(etag, etag))
+	assert.True(t, ETagMatches(`"stale", `+etag, etag))
+	assert.True(t, ETagMatches("*", etag))
+	assert.False(t, ETagMatches(`"stale"`, etag))
+	assert.False(t, ETagMatches("W/"+etag, etag), "weak tags never match")
+	assert.False(t, ETagMatches("", etag))
+}
+
+func TestIfMatchVersion(t *testing.T) {
+	updatedAt := time.Date(2024, 5, 1, 12, 0, 0, 123456000, time.UTC)
+	etag := ETag(1, updatedAt)
+
+	version, ok := IfMatchVersion(etag, 1)
+	assert.True(t, ok)
+	assert.True(t, version.Equal(updatedAt), "the tag round-trips to its version")
+
+	version, ok = IfMatchVersion(`"stale", `+etag, 1)
+	assert.True(t, ok)
+	assert.True(t, version.Equal(updatedAt))
+
+	version, ok = IfMatchVersion("*", 1)
+	assert.True(t, ok)
+	assert.True(t, version.IsZero(), "* matches any version")
+
+	_, ok = IfMatchVersion(etag, 2)
+	assert.False(t, ok, "tags for other resources never match")
+	_, ok = IfMatchVersion("W/"+etag, 1)
+	assert.False(t, ok, "weak tags never match")
+	_, ok = IfMatchVersion(`"stale"`, 1)
+	assert.False(t, ok)
+}
@@ -0,0 +1,83 @@
+package utils
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// FieldsQueryParam is the query parameter holding a sparse fieldset,
+// a comma-separated list of JSON field names to include in the response
+const FieldsQueryParam = "fields"
+
+// ParseFields splits a comma-separated sparse fieldset into field names,
+// ignoring surrounding whitespace and empty entries
+func ParseFields(raw string) []string {
+	var fields []string
+	for _, field := range strings.Split(raw, ",") {
+		if field = strings.TrimSpace(field); field != "" {
+			fields = append(fields, field)
+		}
+	}
+	return fields
+}
+
+// ProjectFields marshals v to JSON and keeps only the requested top-level keys
+// of each object. Slices are projected element by element. When no fields are
+// requested v is returned unchanged; unknown field names are ignored.
+func ProjectFields(v interface{}, fields []string) (interface{}, error) {
+	if len(fields) == 0 {
+		return v, nil
+	}
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var decoded interface{}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return nil, err
+	}
+
+	keep := make(map[string]struct{}, len(fields))
+	for _, field := range fields {
+		keep[field] = struct{}{}
+	}
+
+	switch value := decoded.(type) {
+	case []interface{}:
+		for _, item := range value {
+			pruneObject(item, keep)
+		}
+	default:
+		pruneObject(value, keep)
+	}
+
+	return decoded, nil
+}
+
+// pruneObject removes the keys of a decoded JSON object that are not in keep
+func pruneObject(v interface{}, keep map[string]struct{}) {
+	object, ok := v.(map[string]interface{})
+	if !ok {
+		return
+	}
+	for key := range object {
+		if _, ok := keep[key]; !ok {
+			delete(object, key)
+		}
+	}
+}
+
+// JSONWithFields sends v as JSON, applying the sparse fieldset from the
+// request's fields query parameter when one is given
+func JSONWithFields(c *gin.Context, status int, v interface{}) {
+	projected, err := ProjectFields(v, ParseFields(c.Query(FieldsQueryParam)))
+	if err != nil {
+		HandleError(c, err)
+		return
+	}
+	Respond(c, status, projected)
+}
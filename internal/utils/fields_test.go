@@ -0,0 +1,70 @@
+package utils
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/npmulder/resume-api/internal/models"
+)
+
+func TestParseFields(t *testing.T) {
+	assert.Nil(t, ParseFields(""))
+	assert.Equal(t, []string{"name", "category"}, ParseFields(" name, ,category,"))
+}
+
+func TestProjectFields(t *testing.T) {
+	level := "expert"
+	skills := []*models.Skill{
+		{ID: 1, Category: "Languages", Name: "Go", Level: &level, IsFeatured: true},
+		{ID: 2, Category: "Databases", Name: "PostgreSQL"},
+	}
+
+	t.Run("no fields returns the value unchanged", func(t *testing.T) {
+		projected, err := ProjectFields(skills, nil)
+		require.NoError(t, err)
+		assert.Equal(t, skills, projected)
+	})
+
+	t.Run("slice of objects", func(t *testing.T) {
+		projected, err := ProjectFields(skills, []string{"name", "category", "unknown"})
+		require.NoError(t, err)
+
+		items, ok := projected.([]interface{})
+		require.True(t, ok)
+		require.Len(t, items, 2)
+		assert.Equal(t, map[string]interface{}{"name": "Go", "category": "Languages"}, items[0])
+		assert.Equal(t, map[string]interface{}{"name": "PostgreSQL", "category": "Databases"}, items[1])
+	})
+
+	t.Run("single object", func(t *testing.T) {
+		projected, err := ProjectFields(skills[0], []string{"id", "level"})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]interface{}{"id": float64(1), "level": "expert"}, projected)
+	})
+}
+
+func TestJSONWithFields(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+	router.GET("/skills", func(c *gin.Context) {
+		JSONWithFields(c, http.StatusOK, []*models.Skill{{ID: 1, Category: "Languages", Name: "Go"}})
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/skills?fields=name", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response []map[string]interface{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	require.Len(t, response, 1)
+	assert.Equal(t, map[string]interface{}{"name": "Go"}, response[0])
+}
@@ -0,0 +1,46 @@
+package utils
+
+import (
+	"reflect"
+	"strings"
+)
+
+// QueryParamNames returns the query parameter names Gin binds to the struct v.
+// Like Gin, it uses the form tag, falls back to the field name for untagged
+// fields and descends into untagged embedded structs. Names bound to several
+// fields are listed once.
+func QueryParamNames(v any) []string {
+	t := reflect.TypeOf(v)
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return nil
+	}
+
+	var names []string
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name, _, _ := strings.Cut(field.Tag.Get("form"), ",")
+		switch {
+		case name == "-":
+			continue
+		case name != "":
+			names = append(names, name)
+		case field.Anonymous:
+			names = append(names, QueryParamNames(reflect.Zero(field.Type).Interface())...)
+		case field.IsExported():
+			names = append(names, field.Name)
+		}
+	}
+
+	unique := names[:0]
+	seen := make(map[string]bool, len(names))
+	for _, name := range names {
+		if !seen[name] {
+			seen[name] = true
+			unique = append(unique, name)
+		}
+	}
+	return unique
+}
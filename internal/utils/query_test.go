@@ -0,0 +1,29 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQueryParamNames(t *testing.T) {
+	type paging struct {
+		Limit  int `form:"limit"`
+		Offset int `form:"offset"`
+	}
+	type query struct {
+		paging
+		Status   string `form:"status" binding:"omitempty"`
+		Featured *bool  `form:"featured,default=false"`
+		Ignored  string `form:"-"`
+		Untagged string
+		internal string
+		// Statuses binds the same parameter as Status
+		Statuses []string `form:"status"`
+	}
+
+	expected := []string{"limit", "offset", "status", "featured", "Untagged"}
+	assert.Equal(t, expected, QueryParamNames(query{}))
+	assert.Equal(t, expected, QueryParamNames(&query{}))
+	assert.Nil(t, QueryParamNames("not a struct"))
+}
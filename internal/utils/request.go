@@ -3,7 +3,10 @@ package utils
 import (
 	"fmt"
 	"math/rand"
+	"strings"
 	"time"
+
+	"github.com/gin-gonic/gin"
 )
 
 // GenerateRequestID generates a unique request ID
@@ -19,3 +22,37 @@ func GenerateRequestID() string {
 	// Combine them into a string
 	return fmt.Sprintf("%d-%06x", timestamp, randomPart)
 }
+
+// BaseURL returns the external base URL of the API for building absolute links.
+// A configured public base URL always wins; otherwise the URL is derived from the
+// X-Forwarded-Proto and X-Forwarded-Host headers set by a reverse proxy, falling
+// back to the request's own scheme and Host header. The result has no trailing slash.
+func BaseURL(c *gin.Context, publicBaseURL string) string {
+	if publicBaseURL != "" {
+		return strings.TrimRight(publicBaseURL, "/")
+	}
+
+	scheme := "http"
+	if c.Request.TLS != nil {
+		scheme = "https"
+	}
+	if proto := firstHeaderValue(c.GetHeader("X-Forwarded-Proto")); proto != "" {
+		scheme = proto
+	}
+
+	host := c.Request.Host
+	if forwardedHost := firstHeaderValue(c.GetHeader("X-Forwarded-Host")); forwardedHost != "" {
+		host = forwardedHost
+	}
+
+	return scheme + "://" + host
+}
+
+// firstHeaderValue returns the first entry of a comma-separated header value,
+// which is the one set by the proxy closest to the client
+func firstHeaderValue(value string) string {
+	if idx := strings.Index(value, ","); idx != -1 {
+		value = value[:idx]
+	}
+	return strings.TrimSpace(value)
+}
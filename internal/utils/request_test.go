@@ -0,0 +1,64 @@
+package utils
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBaseURL(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name          string
+		publicBaseURL string
+		headers       map[string]string
+		expected      string
+	}{
+		{
+			name:     "request host without forwarding headers",
+			expected: "http://api.internal:8080",
+		},
+		{
+			name: "forwarding headers",
+			headers: map[string]string{
+				"X-Forwarded-Proto": "https",
+				"X-Forwarded-Host":  "resume.example.com",
+			},
+			expected: "https://resume.example.com",
+		},
+		{
+			name: "multiple proxies use the first value",
+			headers: map[string]string{
+				"X-Forwarded-Proto": "https, http",
+				"X-Forwarded-Host":  "resume.example.com, proxy.internal",
+			},
+			expected: "https://resume.example.com",
+		},
+		{
+			name:          "configured public base URL wins",
+			publicBaseURL: "https://cv.example.com/",
+			headers: map[string]string{
+				"X-Forwarded-Proto": "http",
+				"X-Forwarded-Host":  "resume.example.com",
+			},
+			expected: "https://cv.example.com",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest(http.MethodGet, "http://api.internal:8080/api/v1/profile", nil)
+			for key, value := range tt.headers {
+				c.Request.Header.Set(key, value)
+			}
+
+			assert.Equal(t, tt.expected, BaseURL(c, tt.publicBaseURL))
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package utils
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// PrettyQueryParam is the query parameter that switches a response between
+// indented and compact JSON, e.g. ?pretty=true
+const PrettyQueryParam = "pretty"
+
+// PrettyJSONKey is the context key holding the default for PrettyQueryParam,
+// set by middleware when indented output is configured
+const PrettyJSONKey = "PrettyJSON"
+
+// Respond sends v as JSON. The output is indented when the request's pretty
+// query parameter is true, or when it is absent and indentation is enabled in
+// the context; compact output is the default.
+func Respond(c *gin.Context, status int, v any) {
+	if prettyJSON(c) {
+		c.IndentedJSON(status, v)
+		return
+	}
+	c.JSON(status, v)
+}
+
+// prettyJSON reports whether the response should be indented. An invalid
+// pretty value falls back to the context default.
+func prettyJSON(c *gin.Context) bool {
+	if raw, ok := c.GetQuery(PrettyQueryParam); ok {
+		if pretty, err := strconv.ParseBool(raw); err == nil {
+			return pretty
+		}
+	}
+	return c.GetBool(PrettyJSONKey)
+}
@@ -0,0 +1,55 @@
+package utils
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRespond(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	// serve sends a small object through Respond, with indentation enabled
+	// in the context when prettyDefault is set
+	serve := func(target string, prettyDefault bool) string {
+		router := gin.New()
+		router.GET("/profile", func(c *gin.Context) {
+			if prettyDefault {
+				c.Set(PrettyJSONKey, true)
+			}
+			Respond(c, http.StatusOK, gin.H{"name": "Jane"})
+		})
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, target, nil))
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Header().Get("Content-Type"), "application/json")
+		return w.Body.String()
+	}
+
+	const compact = `{"name":"Jane"}`
+	const indented = "{\n    \"name\": \"Jane\"\n}"
+
+	t.Run("compact by default", func(t *testing.T) {
+		assert.Equal(t, compact, serve("/profile", false))
+	})
+
+	t.Run("indented when requested", func(t *testing.T) {
+		assert.Equal(t, indented, serve("/profile?pretty=true", false))
+	})
+
+	t.Run("indented when enabled in the context", func(t *testing.T) {
+		assert.Equal(t, indented, serve("/profile", true))
+	})
+
+	t.Run("query parameter overrides the context", func(t *testing.T) {
+		assert.Equal(t, compact, serve("/profile?pretty=false", true))
+	})
+
+	t.Run("invalid value falls back to the default", func(t *testing.T) {
+		assert.Equal(t, compact, serve("/profile?pretty=maybe", false))
+	})
+}
@@ -0,0 +1,263 @@
+// Package webhook notifies external endpoints when resume data changes
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/npmulder/resume-api/internal/config"
+	"github.com/npmulder/resume-api/internal/models"
+)
+
+// Actions reported in change events
+const (
+	ActionCreated = "created"
+	ActionUpdated = "updated"
+	ActionDeleted = "deleted"
+)
+
+// failureRecordTimeout bounds how long recording a failed delivery may take,
+// since workers have no request context to inherit a deadline from
+const failureRecordTimeout = 5 * time.Second
+
+// abandonTimeout bounds how long Close waits, once its context is done, for
+// workers to record the deliveries they abandon
+const abandonTimeout = 5 * time.Second
+
+// FailureStore keeps events whose delivery failed after every retry so they
+// can be replayed later
+type FailureStore interface {
+	RecordFailure(ctx context.Context, failure *models.WebhookFailure) error
+}
+
+// Event describes a successful write to resume data
+type Event struct {
+	Entity    string    `json:"entity"`
+	Action    string    `json:"action"`
+	ID        int       `json:"id,omitempty"` // Zero for writes affecting several rows
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// Dispatcher delivers events to the configured URLs from a bounded queue
+// drained by a fixed pool of workers, so slow endpoints never block callers
+type Dispatcher struct {
+	urls         []string
+	client       *http.Client
+	maxRetries   int
+	retryBackoff time.Duration
+	logger       *slog.Logger
+	failures     FailureStore
+
+	queue     chan Event
+	done      chan struct{}
+	wg        sync.WaitGroup
+	closeOnce sync.Once
+	// ctx is canceled once Close stops waiting, aborting in-flight
+	// deliveries and retry backoffs
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+// Option configures a Dispatcher
+type Option func(*Dispatcher)
+
+// WithFailureStore records events that exhaust their retries in store instead
+// of only logging them
+func WithFailureStore(store FailureStore) Option {
+	return func(d *Dispatcher) {
+		d.failures = store
+	}
+}
+
+// New creates a dispatcher and starts its workers. A dispatcher without URLs
+// accepts events and discards them.
+func New(cfg *config.WebhookConfig, logger *slog.Logger, opts ...Option) *Dispatcher {
+	d := &Dispatcher{
+		urls:         cfg.URLs,
+		client:       &http.Client{Timeout: cfg.Timeout},
+		maxRetries:   cfg.MaxRetries,
+		retryBackoff: cfg.RetryBackoff,
+		logger:       logger,
+		done:         make(chan struct{}),
+	}
+	for _, opt := range opts {
+		opt(d)
+	}
+	if len(d.urls) == 0 {
+		return d
+	}
+
+	d.ctx, d.cancel = context.WithCancel(context.Background())
+	d.queue = make(chan Event, max(cfg.QueueSize, 1))
+	for i := 0; i < max(cfg.Workers, 1); i++ {
+		d.wg.Add(1)
+		go d.work()
+	}
+	return d
+}
+
+// Notify queues event for delivery without waiting for it to be sent. The
+// event is dropped when the queue is full or the dispatcher is closed.
+func (d *Dispatcher) Notify(event Event) {
+	if d == nil || d.queue == nil {
+		return
+	}
+	if event.Timestamp.IsZero() {
+		event.Timestamp = time.Now().UTC()
+	}
+
+	select {
+	case <-d.done:
+		return
+	default:
+	}
+
+	select {
+	case d.queue <- event:
+	default:
+		d.logger.Warn("webhook queue full, dropping event", "entity", event.Entity, "action", event.Action)
+	}
+}
+
+// Close stops accepting events and waits for queued ones to be delivered
+// until ctx is done. Deliveries still pending then are abandoned, and Close
+// waits up to abandonTimeout more for them to be recorded as failures.
+func (d *Dispatcher) Close(ctx context.Context) error {
+	if d == nil || d.queue == nil {
+		return nil
+	}
+	d.closeOnce.Do(func() { close(d.done) })
+
+	finished := make(chan struct{})
+	go func() {
+		d.wg.Wait()
+		close(finished)
+	}()
+
+	select {
+	case <-finished:
+		d.cancel()
+		return nil
+	case <-ctx.Done():
+		d.cancel()
+		timer := time.NewTimer(abandonTimeout)
+		defer timer.Stop()
+		select {
+		case <-finished:
+		case <-timer.C:
+			d.logger.Warn("webhook workers still running after close, failures may not be recorded")
+		}
+		return ctx.Err()
+	}
+}
+
+// work delivers queued events until the dispatcher is closed and the queue is empty
+func (d *Dispatcher) work() {
+	defer d.wg.Done()
+	for {
+		select {
+		case event := <-d.queue:
+			d.dispatch(event)
+		case <-d.done:
+			for {
+				select {
+				case event := <-d.queue:
+					d.dispatch(event)
+				default:
+					return
+				}
+			}
+		}
+	}
+}
+
+// dispatch sends event to every URL, retrying each with exponential backoff
+func (d *Dispatcher) dispatch(event Event) {
+	body, err := json.Marshal(event)
+	if err != nil {
+		d.logger.Error("failed to encode webhook event", "error", err)
+		return
+	}
+
+	for _, url := range d.urls {
+		backoff := d.retryBackoff
+		for attempt := 0; ; attempt++ {
+			err := d.post(d.ctx, url, body)
+			if err == nil {
+				break
+			}
+			if attempt >= d.maxRetries || !d.wait(backoff) {
+				d.logger.Error("webhook delivery failed",
+					"entity", event.Entity, "action", event.Action, "attempts", attempt+1, "error", err)
+				d.recordFailure(url, body, attempt+1, err)
+				break
+			}
+			backoff *= 2
+		}
+	}
+}
+
+// wait pauses for backoff before a retry, returning false when the
+// dispatcher is canceled first
+func (d *Dispatcher) wait(backoff time.Duration) bool {
+	timer := time.NewTimer(backoff)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return true
+	case <-d.ctx.Done():
+		return false
+	}
+}
+
+// recordFailure hands an undeliverable event to the failure store, if any
+func (d *Dispatcher) recordFailure(url string, body []byte, attempts int, deliveryErr error) {
+	if d.failures == nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), failureRecordTimeout)
+	defer cancel()
+
+	failure := &models.WebhookFailure{
+		URL:      url,
+		Payload:  body,
+		Error:    deliveryErr.Error(),
+		Attempts: attempts,
+	}
+	if err := d.failures.RecordFailure(ctx, failure); err != nil {
+		d.logger.Error("failed to record webhook failure", "url", url, "error", err)
+	}
+}
+
+// Replay sends a previously failed payload to url once, without retries
+func (d *Dispatcher) Replay(ctx context.Context, url string, payload []byte) error {
+	return d.post(ctx, url, payload)
+}
+
+// post delivers a single encoded event, treating non-2xx responses as failures
+func (d *Dispatcher) post(ctx context.Context, url string, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,272 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/npmulder/resume-api/internal/config"
+	"github.com/npmulder/resume-api/internal/models"
+)
+
+// memoryFailureStore records failures in memory
+type memoryFailureStore struct {
+	mu       sync.Mutex
+	failures []*models.WebhookFailure
+}
+
+func (s *memoryFailureStore) RecordFailure(ctx context.Context, failure *models.WebhookFailure) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.failures = append(s.failures, failure)
+	return nil
+}
+
+func newTestDispatcher(urls ...string) *Dispatcher {
+	return newTestDispatcherWithOptions(urls)
+}
+
+func newTestDispatcherWithOptions(urls []string, opts ...Option) *Dispatcher {
+	return New(&config.WebhookConfig{
+		URLs:         urls,
+		Timeout:      time.Second,
+		MaxRetries:   2,
+		RetryBackoff: time.Millisecond,
+		QueueSize:    10,
+		Workers:      1,
+	}, slog.New(slog.NewTextHandler(io.Discard, nil)), opts...)
+}
+
+func TestDispatcherDeliversEvent(t *testing.T) {
+	received := make(chan Event, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+
+		var event Event
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&event))
+		received <- event
+	}))
+	defer server.Close()
+
+	dispatcher := newTestDispatcher(server.URL)
+	timestamp := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	dispatcher.Notify(Event{Entity: "profile", Action: ActionUpdated, ID: 1, Timestamp: timestamp})
+
+	select {
+	case event := <-received:
+		assert.Equal(t, Event{Entity: "profile", Action: ActionUpdated, ID: 1, Timestamp: timestamp}, event)
+	case <-time.After(2 * time.Second):
+		t.Fatal("webhook was not delivered")
+	}
+	require.NoError(t, dispatcher.Close(context.Background()))
+}
+
+func TestDispatcherRetriesFailedDelivery(t *testing.T) {
+	var attempts atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if attempts.Add(1) < 3 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	dispatcher := newTestDispatcher(server.URL)
+	dispatcher.Notify(Event{Entity: "projects", Action: ActionDeleted})
+	require.NoError(t, dispatcher.Close(context.Background()))
+
+	// Two failures followed by a success
+	assert.Equal(t, int32(3), attempts.Load())
+}
+
+func TestDispatcherGivesUpAfterMaxRetries(t *testing.T) {
+	var attempts atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts.Add(1)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	dispatcher := newTestDispatcher(server.URL)
+	dispatcher.Notify(Event{Entity: "profile", Action: ActionUpdated, ID: 1})
+	require.NoError(t, dispatcher.Close(context.Background()))
+
+	// The first attempt plus MaxRetries
+	assert.Equal(t, int32(3), attempts.Load())
+}
+
+func TestDispatcherRecordsFailureAfterMaxRetries(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	store := &memoryFailureStore{}
+	dispatcher := newTestDispatcherWithOptions([]string{server.URL}, WithFailureStore(store))
+	dispatcher.Notify(Event{Entity: "profile", Action: ActionUpdated, ID: 1})
+	require.NoError(t, dispatcher.Close(context.Background()))
+
+	require.Len(t, store.failures, 1)
+	failure := store.failures[0]
+	assert.Equal(t, server.URL, failure.URL)
+	assert.Equal(t, 3, failure.Attempts)
+	assert.Equal(t, "unexpected status 500", failure.Error)
+
+	var event Event
+	require.NoError(t, json.Unmarshal(failure.Payload, &event))
+	assert.Equal(t, "profile", event.Entity)
+	assert.Equal(t, ActionUpdated, event.Action)
+	assert.Equal(t, 1, event.ID)
+}
+
+func TestDispatcherCloseAbandonsRetryBackoff(t *testing.T) {
+	var attempts atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts.Add(1)
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	store := &memoryFailureStore{}
+	dispatcher := New(&config.WebhookConfig{
+		URLs:         []string{server.URL},
+		Timeout:      time.Second,
+		MaxRetries:   3,
+		RetryBackoff: time.Hour,
+		QueueSize:    10,
+		Workers:      1,
+	}, slog.New(slog.NewTextHandler(io.Discard, nil)), WithFailureStore(store))
+	dispatcher.Notify(Event{Entity: "skills", Action: ActionCreated, ID: 4})
+	require.Eventually(t, func() bool { return attempts.Load() == 1 }, 2*time.Second, 10*time.Millisecond)
+
+	// Close gives up on the hour-long backoff at its deadline
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, dispatcher.Close(ctx), context.DeadlineExceeded)
+
+	// The abandoned event is recorded for replay without another attempt
+	require.Eventually(t, func() bool {
+		store.mu.Lock()
+		defer store.mu.Unlock()
+		return len(store.failures) == 1
+	}, 2*time.Second, 10*time.Millisecond)
+	assert.Equal(t, 1, store.failures[0].Attempts)
+	assert.Equal(t, int32(1), attempts.Load())
+}
+
+func TestDispatcherCloseRecordsAbandonedEventsBeforeReturning(t *testing.T) {
+	var attempts atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts.Add(1)
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	store := &memoryFailureStore{}
+	dispatcher := New(&config.WebhookConfig{
+		URLs:         []string{server.URL},
+		Timeout:      time.Second,
+		MaxRetries:   3,
+		RetryBackoff: time.Hour,
+		QueueSize:    10,
+		Workers:      1,
+	}, slog.New(slog.NewTextHandler(io.Discard, nil)), WithFailureStore(store))
+	dispatcher.Notify(Event{Entity: "skills", Action: ActionCreated, ID: 4})
+	dispatcher.Notify(Event{Entity: "skills", Action: ActionCreated, ID: 5})
+	require.Eventually(t, func() bool { return attempts.Load() == 1 }, 2*time.Second, 10*time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, dispatcher.Close(ctx), context.DeadlineExceeded)
+
+	// Both the event in backoff and the one still queued are recorded by the
+	// time Close returns
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	assert.Len(t, store.failures, 2)
+}
+
+func TestDispatcherDoesNotRecordDeliveredEvents(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	store := &memoryFailureStore{}
+	dispatcher := newTestDispatcherWithOptions([]string{server.URL}, WithFailureStore(store))
+	dispatcher.Notify(Event{Entity: "skills", Action: ActionCreated, ID: 4})
+	require.NoError(t, dispatcher.Close(context.Background()))
+
+	assert.Empty(t, store.failures)
+}
+
+func TestDispatcherReplay(t *testing.T) {
+	var status atomic.Int32
+	status.Store(http.StatusNoContent)
+	received := make(chan string, 2)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		body, _ := io.ReadAll(r.Body)
+		received <- string(body)
+		w.WriteHeader(int(status.Load()))
+	}))
+	defer server.Close()
+
+	// Replay works even when the URL is no longer configured
+	dispatcher := newTestDispatcher()
+	payload := []byte(`{"entity":"profile","action":"updated"}`)
+
+	require.NoError(t, dispatcher.Replay(context.Background(), server.URL, payload))
+	assert.Equal(t, string(payload), <-received)
+
+	status.Store(http.StatusServiceUnavailable)
+	assert.EqualError(t, dispatcher.Replay(context.Background(), server.URL, payload), "unexpected status 503")
+}
+
+func TestDispatcherDoesNotBlockWhenQueueIsFull(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	defer server.Close()
+	defer close(release)
+
+	dispatcher := New(&config.WebhookConfig{
+		URLs:      []string{server.URL},
+		Timeout:   5 * time.Second,
+		QueueSize: 1,
+		Workers:   1,
+	}, slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+	done := make(chan struct{})
+	go func() {
+		for i := 0; i < 10; i++ {
+			dispatcher.Notify(Event{Entity: "profile", Action: ActionUpdated, ID: i})
+		}
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Notify blocked on a slow webhook")
+	}
+}
+
+func TestDispatcherWithoutURLs(t *testing.T) {
+	dispatcher := newTestDispatcher()
+	dispatcher.Notify(Event{Entity: "profile", Action: ActionUpdated})
+	assert.NoError(t, dispatcher.Close(context.Background()))
+}
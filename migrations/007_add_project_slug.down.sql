@@ -0,0 +1,3 @@
+-- Remove project slugs
+ALTER TABLE projects DROP CONSTRAINT IF EXISTS uq_projects_slug;
+ALTER TABLE projects DROP COLUMN IF EXISTS slug;
@@ -0,0 +1,24 @@
+-- Add a URL-friendly slug to projects so imports can upsert by a stable key
+ALTER TABLE projects ADD COLUMN slug VARCHAR(255);
+
+-- Backfill slugs from project names, suffixing duplicates with the project id.
+-- Names without any ASCII letters or digits become project-<id>.
+UPDATE projects p
+SET slug = CASE
+        WHEN s.base = '' THEN 'project-' || p.id
+        WHEN s.rn = 1 THEN s.base
+        ELSE s.base || '-' || p.id
+    END
+FROM (
+    SELECT id,
+           trim(both '-' from regexp_replace(lower(name), '[^a-z0-9]+', '-', 'g')) AS base,
+           ROW_NUMBER() OVER (
+               PARTITION BY trim(both '-' from regexp_replace(lower(name), '[^a-z0-9]+', '-', 'g'))
+               ORDER BY id
+           ) AS rn
+    FROM projects
+) s
+WHERE p.id = s.id;
+
+ALTER TABLE projects ALTER COLUMN slug SET NOT NULL;
+ALTER TABLE projects ADD CONSTRAINT uq_projects_slug UNIQUE (slug);
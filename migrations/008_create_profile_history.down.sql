@@ -0,0 +1,2 @@
+-- Drop profile history table
+DROP TABLE IF EXISTS profile_history;
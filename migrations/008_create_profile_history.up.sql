@@ -0,0 +1,18 @@
+-- Keep a snapshot of every profile version replaced by an update
+CREATE TABLE profile_history (
+    id SERIAL PRIMARY KEY,
+    profile_id INTEGER NOT NULL REFERENCES profiles(id) ON DELETE CASCADE,
+    name VARCHAR(255) NOT NULL,
+    title VARCHAR(255) NOT NULL,
+    email VARCHAR(255) NOT NULL,
+    phone VARCHAR(50),
+    location VARCHAR(255),
+    linkedin VARCHAR(255),
+    github VARCHAR(255),
+    summary TEXT,
+    updated_at TIMESTAMP NOT NULL, -- When this version was written
+    replaced_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP -- When this version was replaced
+);
+
+-- Create index for listing a profile's versions newest first
+CREATE INDEX idx_profile_history_profile_id ON profile_history(profile_id, replaced_at DESC);
@@ -0,0 +1,2 @@
+-- Remove case-insensitive skill name uniqueness
+DROP INDEX IF EXISTS idx_skills_category_lower_name;
@@ -0,0 +1,24 @@
+-- Merge skills whose names differ only in case within a category, so the
+-- index below can be created on existing data. The oldest row is kept, taking
+-- the highest years of experience and staying featured if any duplicate was.
+UPDATE skills keeper
+SET years_experience = merged.years_experience,
+    is_featured = merged.is_featured
+FROM (
+    SELECT MIN(id) AS id,
+           MAX(years_experience) AS years_experience,
+           BOOL_OR(COALESCE(is_featured, FALSE)) AS is_featured
+    FROM skills
+    GROUP BY category, LOWER(name)
+    HAVING COUNT(*) > 1
+) merged
+WHERE keeper.id = merged.id;
+
+DELETE FROM skills duplicate
+USING skills keeper
+WHERE keeper.category = duplicate.category
+  AND LOWER(keeper.name) = LOWER(duplicate.name)
+  AND keeper.id < duplicate.id;
+
+-- Reject skills whose names differ only in case within a category, e.g. "Go" and "go"
+CREATE UNIQUE INDEX idx_skills_category_lower_name ON skills(category, LOWER(name));
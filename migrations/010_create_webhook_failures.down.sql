@@ -0,0 +1,2 @@
+-- Drop webhook failures table
+DROP TABLE IF EXISTS webhook_failures;
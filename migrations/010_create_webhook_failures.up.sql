@@ -0,0 +1,13 @@
+-- Keep webhook events whose delivery failed after every retry, so they can be
+-- inspected and replayed instead of being dropped
+CREATE TABLE webhook_failures (
+    id SERIAL PRIMARY KEY,
+    url TEXT NOT NULL,
+    payload JSONB NOT NULL, -- The encoded event, sent again on replay
+    error TEXT NOT NULL, -- Error of the last attempt
+    attempts INTEGER NOT NULL,
+    failed_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Create index for listing failures newest first
+CREATE INDEX idx_webhook_failures_failed_at ON webhook_failures(failed_at DESC);
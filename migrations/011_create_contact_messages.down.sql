@@ -0,0 +1,2 @@
+-- Drop contact messages table
+DROP TABLE IF EXISTS contact_messages;
@@ -0,0 +1,11 @@
+-- Keep every contact form submission, whether or not relaying it succeeded
+CREATE TABLE contact_messages (
+    id SERIAL PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    email VARCHAR(255) NOT NULL,
+    message TEXT NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Create index for listing submissions newest first
+CREATE INDEX idx_contact_messages_created_at ON contact_messages(created_at DESC);
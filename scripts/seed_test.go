@@ -0,0 +1,128 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func strPtr(s string) *string {
+	return &s
+}
+
+func intPtr(i int) *int {
+	return &i
+}
+
+func TestValidateSeedData(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    SeedData
+		wantErr string
+	}{
+		{
+			name: "valid",
+			data: SeedData{
+				Experiences: []Experience{{Company: "Acme", StartDate: "2020-01-01", EndDate: strPtr("2022-06-30")}},
+				Education:   []Education{{Institution: "University", YearStarted: intPtr(2012), YearCompleted: intPtr(2016)}},
+				Projects:    []Project{{Name: "Resume API", StartDate: "2024-01-01"}, {Name: "Homelab"}},
+			},
+		},
+		{
+			name: "impossible calendar date",
+			data: SeedData{
+				Experiences: []Experience{{Company: "Acme", StartDate: "2023-02-30"}},
+			},
+			wantErr: `experiences[0] (Acme): invalid start_date "2023-02-30"`,
+		},
+		{
+			name: "end before start",
+			data: SeedData{
+				Experiences: []Experience{{Company: "Acme", StartDate: "2022-01-01", EndDate: strPtr("2021-12-31")}},
+			},
+			wantErr: "experiences[0] (Acme): end_date 2021-12-31 is before start_date 2022-01-01",
+		},
+		{
+			name: "education years reversed",
+			data: SeedData{
+				Education: []Education{{Institution: "University", YearStarted: intPtr(2016), YearCompleted: intPtr(2012)}},
+			},
+			wantErr: "education[0] (University): year_completed 2012 is before year_started 2016",
+		},
+		{
+			name: "unknown achievement category",
+			data: SeedData{
+				Achievements: []Achievement{{Title: "Uptime", Category: "performance"}, {Title: "Support", Category: "customer"}},
+			},
+			wantErr: `achievements[1] (Support): invalid achievement category: "customer"`,
+		},
+		{
+			name: "duplicate project slugs",
+			data: SeedData{
+				Projects: []Project{{Name: "Resume API"}, {Name: "resume  api!"}},
+			},
+			wantErr: `projects[1] (resume  api!): slug "resume-api" is already used by Resume API`,
+		},
+		{
+			name: "project name without a slug",
+			data: SeedData{
+				Projects: []Project{{Name: "履歴書"}},
+			},
+			wantErr: `projects[0] (履歴書): name has no letters or digits to build a slug from`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateSeedData(&tt.data)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestLoadSeedData_Example(t *testing.T) {
+	data, err := loadSeedData("seed-data.example.json")
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	printSummary(&buf, data)
+	assert.Contains(t, buf.String(), "profile:")
+	assert.Contains(t, buf.String(), "projects:")
+}
+
+func TestEmitSeedSQL(t *testing.T) {
+	data := &SeedData{
+		Profile: Profile{Name: "Jane O'Brien", Title: "Engineer", Email: "jane@example.com"},
+		Experiences: []Experience{
+			{Company: "Acme", Position: "Developer", StartDate: "2020-01-01", Highlights: []string{"Shipped v2"}, Order: 1},
+		},
+		Skills:   []Skill{{Category: "Languages", Name: "Go", Level: "expert", Order: 1, Featured: true}},
+		Projects: []Project{{Name: "Resume API", Technologies: []string{"Go"}, StartDate: "2024-01-01", Status: "active"}},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, emitSeedSQL(&buf, data))
+	sql := buf.String()
+
+	assert.True(t, strings.HasPrefix(sql, "BEGIN;\n"))
+	assert.True(t, strings.HasSuffix(sql, "COMMIT;\n"))
+	assert.NotContains(t, sql, "$1")
+
+	assert.Contains(t, sql, "INSERT INTO profiles")
+	assert.Contains(t, sql, "'Jane O''Brien', 'Engineer', 'jane@example.com'")
+	assert.Contains(t, sql, "DELETE FROM experiences;")
+	assert.Contains(t, sql, "'Acme', 'Developer', '2020-01-01', NULL, '', '{\"Shipped v2\"}', 1")
+	assert.Contains(t, sql, "'Languages', 'Go', 'expert', 1, true")
+	assert.Contains(t, sql, `DELETE FROM projects WHERE slug <> ALL('{"resume-api"}');`)
+	assert.Contains(t, sql, "'Resume API', 'resume-api'")
+	assert.Contains(t, sql, "ON CONFLICT (slug) DO UPDATE")
+	assert.Contains(t, sql, `'["Go"]'`)
+}